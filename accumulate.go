@@ -0,0 +1,129 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/govalues/decimal"
+)
+
+// AccumulateMul returns the (possibly rounded) sum of n products of
+// amount a and factor e, that is, the result of starting with a zero amount
+// and calling [Amount.FMA] with a and e n times, as in daily interest
+// accrual.
+// If the product a * e * n can be represented exactly, AccumulateMul
+// computes it in a single step, which is orders of magnitude faster than
+// the loop for large n.
+// Otherwise, it performs the same sequence of fused multiply-additions,
+// but skips runs of steps rounded to the same scale, where each step adds
+// the same increment, so the result is always identical to the loop while
+// the number of steps actually performed stays small, even for high-scale
+// factors such as a daily rate of 0.05 / 365.
+//
+// AccumulateMul returns an error if:
+//   - n is negative;
+//   - the integer part of the result has more than ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func AccumulateMul(a Amount, e decimal.Decimal, n int) (Amount, error) {
+	b, err := accumulateMul(a, e, n)
+	if err != nil {
+		return Amount{}, fmt.Errorf("accumulating [%v * %v] %v times: %w", a, e, n, err)
+	}
+	return b, nil
+}
+
+func accumulateMul(a Amount, e decimal.Decimal, n int) (Amount, error) {
+	if n < 0 {
+		return Amount{}, fmt.Errorf("number of steps must not be negative")
+	}
+	b := a.Zero()
+	if n == 0 {
+		return b, nil
+	}
+
+	// Fast path
+	scale := a.Scale() + e.Scale()
+	hi, lo := bits.Mul64(a.Decimal().Coef(), e.Coef())
+	if hi == 0 && scale <= decimal.MaxScale {
+		hi, lo = bits.Mul64(lo, uint64(n))
+		if hi == 0 && lo <= math.MaxInt64 {
+			v := int64(lo)
+			if a.IsNeg() != e.IsNeg() {
+				v = -v
+			}
+			d, err := decimal.New(v, scale)
+			if err == nil {
+				c, err := newAmountSafe(a.Curr(), d)
+				if err == nil {
+					return c, nil
+				}
+			}
+		}
+	}
+
+	// Slow path
+	run := 0
+	for i := 0; i < n; {
+		c, err := a.fma(e, b)
+		if err != nil {
+			return Amount{}, err
+		}
+		i++
+		if c.Scale() != b.Scale() {
+			run = 0
+		} else if run++; run >= 2 {
+			// Amount b was rounded to the same scale as its predecessor,
+			// so all following steps at this scale add the same increment.
+			var k int
+			c, k = skipSteps(b, c, n-i)
+			i += k
+			run = 0
+		}
+		b = c
+	}
+	return b, nil
+}
+
+// skipSteps returns the result of adding the increment c - b to amount c
+// at most n times, as long as the result keeps the scale of amount c,
+// and the number of additions.
+func skipSteps(b, c Amount, n int) (Amount, int) {
+	d, f := b.Decimal(), c.Decimal()
+	q, err := f.Sub(d)
+	if err != nil || q.Scale() != f.Scale() {
+		return c, 0
+	}
+	if q.IsZero() {
+		return c, n
+	}
+	if !f.IsZero() && f.IsNeg() != q.IsNeg() {
+		return c, 0
+	}
+	// The coefficient must stay below 10^19 with a margin for the carry
+	// of rounding, otherwise the scale of the next step is reduced.
+	const maxCoef = 9_999_999_999_999_999_999
+	fc, qc := f.Coef(), q.Coef()
+	if qc > maxCoef/2 || fc > maxCoef-2*qc {
+		return c, 0
+	}
+	k := (maxCoef-fc)/qc - 1
+	if k > uint64(n) {
+		k = uint64(n)
+	}
+	if k == 0 {
+		return c, 0
+	}
+	m, err := decimal.New(int64(k), 0)
+	if err != nil {
+		return c, 0
+	}
+	q, err = q.Mul(m)
+	if err != nil {
+		return c, 0
+	}
+	f, err = f.Add(q)
+	if err != nil || f.Scale() != c.Scale() {
+		return c, 0
+	}
+	return newAmountUnsafe(c.Curr(), f), int(k)
+}
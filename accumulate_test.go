@@ -0,0 +1,133 @@
+package money
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/govalues/decimal"
+)
+
+// accumulateMulLoop is the reference implementation of [AccumulateMul].
+func accumulateMulLoop(a Amount, e decimal.Decimal, n int) (Amount, error) {
+	b := a.Zero()
+	var err error
+	for i := 0; i < n; i++ {
+		b, err = a.FMA(e, b)
+		if err != nil {
+			return Amount{}, err
+		}
+	}
+	return b, nil
+}
+
+func TestAccumulateMul(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, a, e string
+			n          int
+		}{
+			{"USD", "10000", "0.000273972602739726", 31},
+			{"USD", "10000", "0.0002739726", 365},
+			{"USD", "-10000", "0.0002739726", 365},
+			{"USD", "10000", "-0.0002739726", 30},
+			{"USD", "1.23", "1", 1000000},
+			{"USD", "0", "0.05", 10},
+			{"USD", "5", "0", 10},
+			{"USD", "5", "0.5", 0},
+			{"USD", "5", "0.5", 1},
+			{"JPY", "12345", "0.0001", 999},
+			{"USD", "12345678.91", "0.0123456789123456", 365},
+			{"USD", "99999999999999.99", "0.99", 1000},
+			{"USD", "10000.00", "0.000136986301369863", 365},
+			{"USD", "10000.00", "0.000136986301369863", 100000},
+			{"USD", "-10000.00", "0.000136986301369863", 36500},
+			{"USD", "0.01", "0.0000000000000000005", 1000},
+			{"USD", "0.01", "0.000000000000000000015", 1000},
+			{"JPY", "1", "0.00000000000000000025", 100000},
+			{"USD", "123456789.12", "0.00001234567890123", 1000000},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.a)
+			e := decimal.MustParse(tt.e)
+			got, err := AccumulateMul(a, e, tt.n)
+			if err != nil {
+				t.Errorf("AccumulateMul(%q, %v, %v) failed: %v", a, e, tt.n, err)
+				continue
+			}
+			want, err := accumulateMulLoop(a, e, tt.n)
+			if err != nil {
+				t.Errorf("accumulateMulLoop(%q, %v, %v) failed: %v", a, e, tt.n, err)
+				continue
+			}
+			if got != want {
+				t.Errorf("AccumulateMul(%q, %v, %v) = %q, want %q", a, e, tt.n, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr, a, e string
+			n          int
+		}{
+			"negative 1": {"USD", "1", "1", -1},
+			"overflow 1": {"USD", "99999999999999999", "1", 2},
+			"overflow 2": {"USD", "9999999999999999", "0.9999999999999", 100},
+		}
+		for name, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.a)
+			e := decimal.MustParse(tt.e)
+			_, err := AccumulateMul(a, e, tt.n)
+			if err == nil {
+				t.Errorf("AccumulateMul(%q, %v, %v) did not fail: %v", a, e, tt.n, name)
+			}
+		}
+	})
+}
+
+func TestAccumulateMul_random(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 2000; i++ {
+		a, err := NewAmount("USD", rng.Int63n(1_000_000_000)-500_000_000, rng.Intn(5)+2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		e, err := decimal.New(rng.Int63n(1_000_000_000_000), rng.Intn(19)+1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		n := rng.Intn(3000)
+		got, gotErr := AccumulateMul(a, e, n)
+		want, wantErr := accumulateMulLoop(a, e, n)
+		if (gotErr != nil) != (wantErr != nil) || got != want {
+			t.Errorf("AccumulateMul(%q, %v, %v) = %q, %v, want %q, %v", a, e, n, got, gotErr, want, wantErr)
+		}
+	}
+}
+
+func BenchmarkAccumulateMul(b *testing.B) {
+	a := MustParseAmount("USD", "10000")
+	e := decimal.MustParse("0.0002739726")
+	b.Run("loop", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = accumulateMulLoop(a, e, 365)
+		}
+	})
+	b.Run("closed", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = AccumulateMul(a, e, 365)
+		}
+	})
+	// Daily rate of 0.05 / 365 from the documentation
+	e = decimal.MustParse("0.000136986301369863")
+	b.Run("loop_daily", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = accumulateMulLoop(a, e, 365)
+		}
+	})
+	b.Run("closed_daily", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = AccumulateMul(a, e, 365)
+		}
+	})
+}
@@ -0,0 +1,160 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/govalues/decimal"
+)
+
+// AdjustmentKind specifies how an [Adjustment] changes an amount.
+type AdjustmentKind int8
+
+const (
+	// DiscountPercent decreases an amount by a percentage of it.
+	DiscountPercent AdjustmentKind = iota
+	// DiscountFixed decreases an amount by a fixed amount.
+	DiscountFixed
+	// SurchargePercent increases an amount by a percentage of it.
+	SurchargePercent
+	// SurchargeFixed increases an amount by a fixed amount.
+	SurchargeFixed
+)
+
+// String implements the [fmt.Stringer] interface and returns
+// a string representation of the adjustment kind.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (k AdjustmentKind) String() string {
+	switch k {
+	case DiscountPercent:
+		return "percent discount"
+	case DiscountFixed:
+		return "fixed discount"
+	case SurchargePercent:
+		return "percent surcharge"
+	case SurchargeFixed:
+		return "fixed surcharge"
+	default:
+		return fmt.Sprintf("AdjustmentKind(%d)", int8(k))
+	}
+}
+
+// Adjustment represents a single step of a pricing pipeline,
+// such as a discount or a surcharge.
+// See also function [ApplyAdjustments].
+type Adjustment struct {
+	// Kind specifies how the adjustment changes the amount.
+	Kind AdjustmentKind
+	// Rate is the percentage expressed as a fraction, for example, 0.10 for 10%.
+	// It is used only by [DiscountPercent] and [SurchargePercent].
+	Rate decimal.Decimal
+	// Amount is the fixed change.
+	// It is used only by [DiscountFixed] and [SurchargeFixed].
+	Amount Amount
+	// Mode specifies how the change is rounded to the scale of the currency.
+	// It is used only by [DiscountPercent] and [SurchargePercent].
+	Mode RoundingMode
+}
+
+// AdjustmentStep describes the effect of a single [Adjustment].
+type AdjustmentStep struct {
+	Adjustment Adjustment // adjustment applied at this step
+	Change     Amount     // signed change of the amount, negative for discounts
+	Result     Amount     // amount after applying the adjustment
+}
+
+// ApplyAdjustments applies the adjustments to the base amount in order
+// and returns the final amount together with the breakdown of each step.
+// Each percentage is applied to the result of the previous step, and its
+// change is rounded to the scale of the currency using the rounding mode of
+// the adjustment.
+// Discounts never decrease the amount below 0: if a discount is greater than
+// the current amount, the change is limited to the current amount.
+// The final amount always equals the base amount plus the sum of the changes.
+//
+// ApplyAdjustments returns an error if:
+//   - the base amount is negative;
+//   - the kind or the rounding mode of an adjustment is not valid;
+//   - the rate or the fixed amount of an adjustment is negative;
+//   - the fixed amount of an adjustment is denominated in a different currency;
+//   - the rounding mode is [Unnecessary] and the change cannot be represented
+//     at the scale of the currency;
+//   - the integer part of any result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func ApplyAdjustments(base Amount, adjs []Adjustment) (Amount, []AdjustmentStep, error) {
+	res, steps, err := applyAdjustments(base, adjs)
+	if err != nil {
+		return Amount{}, nil, fmt.Errorf("adjusting %v: %w", base, err)
+	}
+	return res, steps, nil
+}
+
+func applyAdjustments(base Amount, adjs []Adjustment) (Amount, []AdjustmentStep, error) {
+	if base.IsNeg() {
+		return Amount{}, nil, fmt.Errorf("amount must not be negative")
+	}
+	res := base
+	steps := make([]AdjustmentStep, len(adjs))
+	for i, adj := range adjs {
+		chg, err := adj.change(res)
+		if err != nil {
+			return Amount{}, nil, fmt.Errorf("step %v (%v): %w", i+1, adj.Kind, err)
+		}
+		// Discounts are limited by the current amount
+		if chg.IsNeg() {
+			switch c, err := chg.CmpAbs(res); {
+			case err != nil:
+				return Amount{}, nil, err
+			case c > 0:
+				chg = res.Neg()
+			}
+		}
+		res, err = res.Add(chg)
+		if err != nil {
+			return Amount{}, nil, fmt.Errorf("step %v (%v): %w", i+1, adj.Kind, err)
+		}
+		steps[i] = AdjustmentStep{Adjustment: adj, Change: chg, Result: res}
+	}
+	return res, steps, nil
+}
+
+// change returns the signed change the adjustment makes to amount a.
+func (adj Adjustment) change(a Amount) (Amount, error) {
+	switch adj.Kind {
+	case DiscountPercent, SurchargePercent:
+		if adj.Rate.IsNeg() {
+			return Amount{}, fmt.Errorf("rate must not be negative")
+		}
+		if !adj.Mode.valid() {
+			return Amount{}, fmt.Errorf("invalid rounding mode %v", adj.Mode)
+		}
+		chg, err := a.Mul(adj.Rate)
+		if err != nil {
+			return Amount{}, err
+		}
+		c, d := chg.Curr(), chg.Decimal()
+		if adj.Mode == Unnecessary && d.MinScale() > c.Scale() {
+			return Amount{}, fmt.Errorf("rounding %v is necessary", chg)
+		}
+		d = roundDecimal(d, c.Scale(), adj.Mode).Pad(c.Scale())
+		chg = newAmountUnsafe(c, d)
+		if adj.Kind == DiscountPercent {
+			chg = chg.Neg()
+		}
+		return chg, nil
+	case DiscountFixed, SurchargeFixed:
+		if !adj.Amount.SameCurr(a) {
+			return Amount{}, mismatchError(a.Curr(), adj.Amount.Curr())
+		}
+		if adj.Amount.IsNeg() {
+			return Amount{}, fmt.Errorf("amount must not be negative")
+		}
+		chg := adj.Amount
+		if adj.Kind == DiscountFixed {
+			chg = chg.Neg()
+		}
+		return chg, nil
+	default:
+		return Amount{}, fmt.Errorf("invalid adjustment kind %v", adj.Kind)
+	}
+}
@@ -0,0 +1,97 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/govalues/decimal"
+)
+
+func TestApplyAdjustments(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		usd := func(s string) Amount { return MustParseAmount("USD", s) }
+		pct := func(k AdjustmentKind, r string, m RoundingMode) Adjustment {
+			return Adjustment{Kind: k, Rate: decimal.MustParse(r), Mode: m}
+		}
+		tests := []struct {
+			base        string
+			adjs        []Adjustment
+			wantChanges []string
+			want        string
+		}{
+			{"100.00", nil, nil, "100.00"},
+			{
+				"100.00",
+				[]Adjustment{
+					pct(DiscountPercent, "0.10", HalfEven),
+					{Kind: DiscountFixed, Amount: usd("5.00")},
+					pct(SurchargePercent, "0.0725", HalfUp),
+				},
+				[]string{"-10.00", "-5.00", "6.16"},
+				"91.16",
+			},
+			{"19.99", []Adjustment{pct(DiscountPercent, "0.15", HalfEven)}, []string{"-3.00"}, "16.99"},
+			{"19.99", []Adjustment{pct(DiscountPercent, "0.15", Down)}, []string{"-2.99"}, "17.00"},
+			{"19.99", []Adjustment{pct(SurchargePercent, "0.15", Up)}, []string{"3.00"}, "22.99"},
+			{"10.00", []Adjustment{pct(DiscountPercent, "0.25", Unnecessary)}, []string{"-2.50"}, "7.50"},
+			{"10.00", []Adjustment{{Kind: DiscountFixed, Amount: usd("15.00")}}, []string{"-10.00"}, "0.00"},
+			{"10.00", []Adjustment{{Kind: SurchargeFixed, Amount: usd("1.5")}}, []string{"1.50"}, "11.50"},
+			{"10.00", []Adjustment{pct(DiscountPercent, "1.5", HalfEven)}, []string{"-10.00"}, "0.00"},
+		}
+		for _, tt := range tests {
+			base := usd(tt.base)
+			got, steps, err := ApplyAdjustments(base, tt.adjs)
+			if err != nil {
+				t.Errorf("ApplyAdjustments(%q, %v) failed: %v", base, tt.adjs, err)
+				continue
+			}
+			want := usd(tt.want)
+			if got != want {
+				t.Errorf("ApplyAdjustments(%q, %v) = %q, want %q", base, tt.adjs, got, want)
+			}
+			if len(steps) != len(tt.wantChanges) {
+				t.Errorf("ApplyAdjustments(%q, %v) returned %v steps, want %v", base, tt.adjs, len(steps), len(tt.wantChanges))
+				continue
+			}
+			res := base
+			for i, step := range steps {
+				wantChange := usd(tt.wantChanges[i])
+				if step.Change != wantChange {
+					t.Errorf("ApplyAdjustments(%q, %v) step %v change = %q, want %q", base, tt.adjs, i+1, step.Change, wantChange)
+				}
+				res, err = res.Add(step.Change)
+				if err != nil {
+					t.Errorf("%q.Add(%q) failed: %v", res, step.Change, err)
+					break
+				}
+				if step.Result != res {
+					t.Errorf("ApplyAdjustments(%q, %v) step %v result = %q, want %q", base, tt.adjs, i+1, step.Result, res)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			base string
+			adj  Adjustment
+		}{
+			"negative base":  {"-1.00", Adjustment{Kind: DiscountPercent, Rate: decimal.MustParse("0.1")}},
+			"negative rate":  {"1.00", Adjustment{Kind: DiscountPercent, Rate: decimal.MustParse("-0.1")}},
+			"negative fixed": {"1.00", Adjustment{Kind: SurchargeFixed, Amount: MustParseAmount("USD", "-1")}},
+			"currency":       {"1.00", Adjustment{Kind: DiscountFixed, Amount: MustParseAmount("EUR", "1")}},
+			"kind":           {"1.00", Adjustment{Kind: AdjustmentKind(-1)}},
+			"mode":           {"1.00", Adjustment{Kind: DiscountPercent, Mode: RoundingMode(-1)}},
+			"unnecessary":    {"1.00", Adjustment{Kind: DiscountPercent, Rate: decimal.MustParse("0.333"), Mode: Unnecessary}},
+			"overflow":       {"90000000000000000", Adjustment{Kind: SurchargeFixed, Amount: MustParseAmount("USD", "90000000000000000")}},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				base := MustParseAmount("USD", tt.base)
+				_, _, err := ApplyAdjustments(base, []Adjustment{tt.adj})
+				if err == nil {
+					t.Errorf("ApplyAdjustments(%q, %v) did not fail", base, tt.adj)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,136 @@
+package money
+
+import (
+	"fmt"
+	"sort"
+)
+
+// SumAbs returns the sum of the absolute values of the amounts,
+// which is the gross total used in settlement reports.
+// See also function [NetSum].
+//
+// SumAbs returns an error if:
+//   - there are no amounts;
+//   - amounts are denominated in different currencies;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func SumAbs(amounts []Amount) (Amount, error) {
+	debits, credits, _, err := netSum(amounts)
+	if err != nil {
+		return Amount{}, fmt.Errorf("computing sum of absolute values: %w", err)
+	}
+	s, err := debits.add(credits)
+	if err != nil {
+		return Amount{}, fmt.Errorf("computing sum of absolute values: %w", err)
+	}
+	return s, nil
+}
+
+// NetSum returns in a single pass over the amounts:
+//   - debits, the sum of the positive amounts;
+//   - credits, the sum of the absolute values of the negative amounts;
+//   - net, the sum of all amounts, which equals debits - credits.
+//
+// See also function [SumAbs].
+//
+// NetSum returns an error if:
+//   - there are no amounts;
+//   - amounts are denominated in different currencies;
+//   - the integer part of any result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func NetSum(amounts []Amount) (debits, credits, net Amount, err error) {
+	debits, credits, net, err = netSum(amounts)
+	if err != nil {
+		return Amount{}, Amount{}, Amount{}, fmt.Errorf("computing net sum: %w", err)
+	}
+	return debits, credits, net, nil
+}
+
+func netSum(amounts []Amount) (debits, credits, net Amount, err error) {
+	if len(amounts) == 0 {
+		return Amount{}, Amount{}, Amount{}, fmt.Errorf("no amounts")
+	}
+	debits = amounts[0].Zero().TrimToCurr()
+	credits, net = debits, debits
+	for _, a := range amounts {
+		if !a.SameCurr(net) {
+			return Amount{}, Amount{}, Amount{}, fmt.Errorf("[%v] and [%v]: %w", amounts[0], a, mismatchError(net.Curr(), a.Curr()))
+		}
+		if a.IsNeg() {
+			credits, err = credits.sub(a)
+		} else {
+			debits, err = debits.add(a)
+		}
+		if err != nil {
+			return Amount{}, Amount{}, Amount{}, err
+		}
+		net, err = net.add(a)
+		if err != nil {
+			return Amount{}, Amount{}, Amount{}, err
+		}
+	}
+	return debits, credits, net, nil
+}
+
+// SumByCurrency returns the sums of the amounts grouped by currency.
+// Since the amounts are grouped, it never fails because of a currency mismatch.
+// See also function [SumByCurrencySorted].
+//
+// SumByCurrency returns an error if the integer part of any sum has more than
+// ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func SumByCurrency(amounts []Amount) (map[Currency]Amount, error) {
+	sums := make(map[Currency]Amount)
+	for _, a := range amounts {
+		c := a.Curr()
+		s, ok := sums[c]
+		if !ok {
+			sums[c] = a
+			continue
+		}
+		s, err := s.add(a)
+		if err != nil {
+			return nil, fmt.Errorf("computing sum by currency: %v: %w", c, err)
+		}
+		sums[c] = s
+	}
+	return sums, nil
+}
+
+// SumByCurrencySorted is like [SumByCurrency] but returns the sums as a slice
+// ordered by currency code, which is suitable for reports that must be
+// reproducible.
+func SumByCurrencySorted(amounts []Amount) ([]Amount, error) {
+	sums, err := SumByCurrency(amounts)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]Amount, 0, len(sums))
+	for _, s := range sums {
+		res = append(res, s)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Curr().Code() < res[j].Curr().Code()
+	})
+	return res, nil
+}
+
+// SumReducer returns the sum of the accumulated amount and amount x.
+// It is intended to be used with function Reduce.
+// See also method [Amount.Add].
+func SumReducer(acc, x Amount) (Amount, error) {
+	return acc.Add(x)
+}
+
+// MinReducer returns the smaller of the accumulated amount and amount x.
+// It is intended to be used with function Reduce.
+// See also method [Amount.Min].
+func MinReducer(acc, x Amount) (Amount, error) {
+	return acc.Min(x)
+}
+
+// MaxReducer returns the larger of the accumulated amount and amount x.
+// It is intended to be used with function Reduce.
+// See also method [Amount.Max].
+func MaxReducer(acc, x Amount) (Amount, error) {
+	return acc.Max(x)
+}
@@ -0,0 +1,122 @@
+package money
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNetSum(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr                    string
+			amounts                 []string
+			wantDebits, wantCredits string
+			wantNet, wantAbs        string
+		}{
+			{"USD", []string{"0"}, "0.00", "0.00", "0.00", "0.00"},
+			{"USD", []string{"1", "2.5"}, "3.50", "0.00", "3.50", "3.50"},
+			{"USD", []string{"-1", "-2.5"}, "0.00", "3.50", "-3.50", "3.50"},
+			{"USD", []string{"10", "-2.5", "0.125", "-7.625"}, "10.125", "10.125", "0.000", "20.250"},
+			{"JPY", []string{"100", "-300"}, "100", "300", "-200", "400"},
+		}
+		for _, tt := range tests {
+			amounts := MustParseAmountSlice(tt.curr, tt.amounts)
+			gotDebits, gotCredits, gotNet, err := NetSum(amounts)
+			if err != nil {
+				t.Errorf("NetSum(%v) failed: %v", amounts, err)
+				continue
+			}
+			wantDebits := MustParseAmount(tt.curr, tt.wantDebits)
+			wantCredits := MustParseAmount(tt.curr, tt.wantCredits)
+			wantNet := MustParseAmount(tt.curr, tt.wantNet)
+			if gotDebits != wantDebits || gotCredits != wantCredits || gotNet != wantNet {
+				t.Errorf("NetSum(%v) = [%q %q %q], want [%q %q %q]", amounts, gotDebits, gotCredits, gotNet, wantDebits, wantCredits, wantNet)
+			}
+			gotAbs, err := SumAbs(amounts)
+			if err != nil {
+				t.Errorf("SumAbs(%v) failed: %v", amounts, err)
+				continue
+			}
+			wantAbs := MustParseAmount(tt.curr, tt.wantAbs)
+			if gotAbs != wantAbs {
+				t.Errorf("SumAbs(%v) = %q, want %q", amounts, gotAbs, wantAbs)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string][]Amount{
+			"empty 1":    nil,
+			"currency 1": {MustParseAmount("USD", "1"), MustParseAmount("EUR", "1")},
+			"overflow 1": {MustParseAmount("USD", "99999999999999999"), MustParseAmount("USD", "1")},
+			"overflow 2": {MustParseAmount("USD", "99999999999999999"), MustParseAmount("USD", "-1")},
+		}
+		for name, amounts := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := SumAbs(amounts)
+				if err == nil {
+					t.Errorf("SumAbs(%v) did not fail", amounts)
+				}
+			})
+		}
+	})
+}
+
+func TestSumByCurrency(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		amounts := []Amount{
+			MustParseAmount("USD", "1.50"),
+			MustParseAmount("EUR", "2"),
+			MustParseAmount("USD", "-0.25"),
+			MustParseAmount("JPY", "100"),
+			MustParseAmount("EUR", "0.005"),
+		}
+		want := []Amount{
+			MustParseAmount("EUR", "2.005"),
+			MustParseAmount("JPY", "100"),
+			MustParseAmount("USD", "1.25"),
+		}
+		gotMap, err := SumByCurrency(amounts)
+		if err != nil {
+			t.Fatalf("SumByCurrency(%v) failed: %v", amounts, err)
+		}
+		if len(gotMap) != len(want) {
+			t.Errorf("SumByCurrency(%v) = %v, want %v", amounts, gotMap, want)
+		}
+		for _, w := range want {
+			if gotMap[w.Curr()] != w {
+				t.Errorf("SumByCurrency(%v)[%v] = %q, want %q", amounts, w.Curr(), gotMap[w.Curr()], w)
+			}
+		}
+		got, err := SumByCurrencySorted(amounts)
+		if err != nil {
+			t.Fatalf("SumByCurrencySorted(%v) failed: %v", amounts, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("SumByCurrencySorted(%v) = %v, want %v", amounts, got, want)
+		}
+		got, err = SumByCurrencySorted(nil)
+		if err != nil {
+			t.Fatalf("SumByCurrencySorted(nil) failed: %v", err)
+		}
+		if len(got) != 0 {
+			t.Errorf("SumByCurrencySorted(nil) = %v, want []", got)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		amounts := []Amount{
+			MustParseAmount("EUR", "1"),
+			MustParseAmount("USD", "99999999999999999"),
+			MustParseAmount("USD", "1"),
+		}
+		_, err := SumByCurrency(amounts)
+		if err == nil {
+			t.Errorf("SumByCurrency(%v) did not fail", amounts)
+		}
+		_, err = SumByCurrencySorted(amounts)
+		if err == nil {
+			t.Errorf("SumByCurrencySorted(%v) did not fail", amounts)
+		}
+	})
+}
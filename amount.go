@@ -1,10 +1,15 @@
 package money
 
 import (
+	"database/sql/driver"
 	"errors"
 	"fmt"
+	"io"
+	"log/slog"
 	"math"
+	"math/big"
 	"strconv"
+	"strings"
 
 	"github.com/govalues/decimal"
 )
@@ -205,6 +210,102 @@ func ParseAmount(curr, amount string) (Amount, error) {
 	return newAmountSafe(c, d)
 }
 
+// ParseAmountRound converts currency and decimal strings to an amount rounded
+// to the specified number of digits after the decimal point using the given
+// rounding mode.
+// Unlike [ParseAmount], which implicitly rounds excess digits using rounding
+// half to even, it gives the caller control over how user input with excess
+// precision is handled: the input can be truncated ([Down]), rounded up ([Up]),
+// or rejected ([Unnecessary]).
+// If the scale of the amount is less than the scale of the currency, the result
+// will be zero-padded to the right.
+// The rounding mode is applied to the exact value of the decimal string,
+// including any digits beyond [decimal.MaxPrec] significant digits.
+//
+// ParseAmountRound returns an error if:
+//   - the currency code is not valid;
+//   - the decimal string is not valid;
+//   - the scale is negative or greater than [decimal.MaxScale];
+//   - the rounding mode is not valid;
+//   - the rounding mode is [Unnecessary] and the decimal string has non-zero
+//     digits beyond the specified scale;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func ParseAmountRound(curr, amount string, scale int, mode RoundingMode) (Amount, error) {
+	// Currency
+	c, err := ParseCurr(curr)
+	if err != nil {
+		return Amount{}, fmt.Errorf("parsing currency: %w", err)
+	}
+	// Rounding
+	if scale < decimal.MinScale || scale > decimal.MaxScale {
+		return Amount{}, fmt.Errorf("parsing amount: scale %v out of range", scale)
+	}
+	if !mode.valid() {
+		return Amount{}, fmt.Errorf("parsing amount: invalid rounding mode %v", mode)
+	}
+	// Decimal
+	d, err := decimal.Parse(amount)
+	if err != nil {
+		return Amount{}, fmt.Errorf("parsing amount: %w", err)
+	}
+	d, err = parseDecimalRound(amount, min(scale, d.Scale()), mode)
+	if err != nil {
+		return Amount{}, fmt.Errorf("parsing amount: %w", err)
+	}
+	// Amount
+	a, err := newAmountSafe(c, d)
+	if err != nil {
+		return Amount{}, fmt.Errorf("parsing amount: %w", err)
+	}
+	return a, nil
+}
+
+// parseDecimalRound converts a decimal string, which must be valid for
+// [decimal.Parse], to a decimal rounded to the specified number of digits
+// after the decimal point using the given rounding mode.
+// Unlike [decimal.Parse], it rounds the exact value of the string, so
+// digits beyond [decimal.MaxPrec] significant digits are not lost.
+func parseDecimalRound(s string, scale int, mode RoundingMode) (decimal.Decimal, error) {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return decimal.Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+	x := new(big.Int).Mul(r.Num(), pow10Big(scale))
+	neg := x.Sign() < 0
+	x.Abs(x)
+	quo, rem := new(big.Int).QuoRem(x, r.Denom(), new(big.Int))
+	if rem.Sign() != 0 {
+		away := false
+		switch mode {
+		case Unnecessary:
+			return decimal.Decimal{}, fmt.Errorf("%q has more than %v digits after the decimal point", s, scale)
+		case Up:
+			away = true
+		case Ceiling:
+			away = !neg
+		case Floor:
+			away = neg
+		case HalfUp, HalfEven:
+			// Comparing the doubled remainder with the denominator
+			// to find the nearest neighbor.
+			switch c := rem.Lsh(rem, 1).Cmp(r.Denom()); {
+			case c > 0:
+				away = true
+			case c == 0:
+				away = mode == HalfUp || quo.Bit(0) != 0
+			}
+		}
+		if away {
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+	if neg {
+		quo.Neg(quo)
+	}
+	return bigDecimalExact(quo, scale)
+}
+
 // MustParseAmount is like [ParseAmount] but panics if any of the strings cannot be parsed.
 // This function simplifies safe initialization of global variables holding amounts.
 func MustParseAmount(curr, amount string) Amount {
@@ -225,7 +326,52 @@ func MustParseAmount(curr, amount string) Amount {
 //
 // [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
 func (a Amount) MinorUnits() (units int64, ok bool) {
-	d := a.RoundToCurr().Decimal()
+	d := a.Round(a.Curr().Scale()).Decimal()
+	return minorUnits(d)
+}
+
+// MinorUnitsMode returns a (possibly rounded) amount in minor units of currency
+// (e.g. cents, pennies, fens).
+// If the scale of the amount is greater than the scale of the currency, then
+// the fractional part is rounded using the given rounding mode.
+// See also methods [Amount.MinorUnits] and [Amount.MinorUnitsExact].
+//
+// If the rounding mode is not valid, the rounding mode is [Unnecessary] and
+// rounding is necessary, or the result cannot be represented as an int64,
+// then false is returned.
+func (a Amount) MinorUnitsMode(mode RoundingMode) (units int64, ok bool) {
+	c, d := a.Curr(), a.Decimal()
+	if !mode.valid() || (mode == Unnecessary && d.MinScale() > c.Scale()) {
+		return 0, false
+	}
+	d = roundDecimal(d, c.Scale(), mode).Pad(c.Scale())
+	return minorUnits(d)
+}
+
+// MinorUnitsExact returns the amount in minor units of currency
+// (e.g. cents, pennies, fens) without any rounding.
+// It is intended for systems, such as card networks, that require exact
+// minor-unit amounts, where silent rounding is dangerous.
+// See also methods [Amount.MinorUnits] and [Amount.MinorUnitsMode].
+//
+// MinorUnitsExact returns an error if:
+//   - the amount has non-zero digits beyond the scale of the currency;
+//   - the result cannot be represented as an int64.
+func (a Amount) MinorUnitsExact() (int64, error) {
+	c, d := a.Curr(), a.Decimal()
+	if d.MinScale() > c.Scale() {
+		return 0, fmt.Errorf("converting %v to minor units: rounding is necessary", a)
+	}
+	units, ok := minorUnits(d.Rescale(c.Scale()))
+	if !ok {
+		return 0, fmt.Errorf("converting %v to minor units: %w", a, errAmountOverflow)
+	}
+	return units, nil
+}
+
+// minorUnits returns the coefficient of a decimal with the scale of the currency
+// as an int64.
+func minorUnits(d decimal.Decimal) (units int64, ok bool) {
 	u := d.Coef()
 	if d.IsNeg() {
 		if u > -math.MinInt64 {
@@ -251,6 +397,28 @@ func (a Amount) Float64() (f float64, ok bool) {
 	return a.Decimal().Float64()
 }
 
+// Float64Lossy returns the nearest binary floating-point number rounded
+// using [rounding half to even] (banker's rounding), and reports whether
+// the conversion lost precision, that is, whether the float cannot be
+// converted back to the same decimal value.
+// It is intended for exporting amounts to metrics and monitoring systems,
+// which only accept floats.
+// See also method [Amount.Float64] and function [ObserveAmount].
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (a Amount) Float64Lossy() (f float64, lossy bool) {
+	d := a.Decimal()
+	f, ok := d.Float64()
+	if !ok {
+		return f, true
+	}
+	e, err := decimal.NewFromFloat64(f)
+	if err != nil {
+		return f, true
+	}
+	return f, d.Cmp(e) != 0
+}
+
 // Int64 returns a pair of integers representing the whole and (possibly
 // rounded) fractional parts of the amount.
 // If given scale is greater than the scale of the amount, then the fractional part
@@ -280,6 +448,49 @@ func (a Amount) Decimal() decimal.Decimal {
 	return a.value
 }
 
+// Components returns the raw representation of the amount, which is equal to
+// (-1)^neg * coef / 10^scale, together with its currency.
+// It is intended for low-level serializers that need to read
+// the representation without going through strings.
+// The components are stable: passing them back to [NewAmount] (when the
+// coefficient fits into int64) reproduces the same amount.
+func (a Amount) Components() (coef uint64, scale int, neg bool, curr Currency) {
+	d := a.Decimal()
+	return d.Coef(), d.Scale(), d.IsNeg(), a.Curr()
+}
+
+// LeadingDigit returns the most significant digit of the amount, which is
+// in the range [1, 9], or 0 if the amount is zero.
+// The sign, the scale, and the currency of the amount are ignored,
+// for example, the leading digit of "USD -0.0345" is 3.
+// This method is useful for fraud analytics based on [Benford's law].
+// See also method [Amount.DigitAt].
+//
+// [Benford's law]: https://en.wikipedia.org/wiki/Benford%27s_law
+func (a Amount) LeadingDigit() int {
+	return a.DigitAt(0)
+}
+
+// DigitAt returns the i-th significant digit of the amount, counting from
+// the most significant one, which has the index 0.
+// The sign, the scale, and the currency of the amount are ignored,
+// for example, the digits of "USD -0.0345" are 3, 4, and 5.
+// If the amount is zero or has no more than i significant digits, including
+// trailing zeros, or i is negative, the result is 0.
+// See also method [Amount.LeadingDigit].
+func (a Amount) DigitAt(i int) int {
+	d := a.Decimal()
+	n := d.Prec() - 1 - i // number of digits to drop
+	if i < 0 || n < 0 {
+		return 0
+	}
+	coef := d.Coef()
+	for ; n > 0; n-- {
+		coef /= 10
+	}
+	return int(coef % 10)
+}
+
 // Sign returns:
 //
 //	-1 if a < 0
@@ -383,7 +594,7 @@ func (a Amount) Add(b Amount) (Amount, error) {
 
 func (a Amount) add(b Amount) (Amount, error) {
 	if !a.SameCurr(b) {
-		return Amount{}, errCurrencyMismatch
+		return Amount{}, mismatchError(a.Curr(), b.Curr())
 	}
 	c, d, e := a.Curr(), a.Decimal(), b.Decimal()
 	d, err := d.AddExact(e, c.Scale())
@@ -393,6 +604,20 @@ func (a Amount) add(b Amount) (Amount, error) {
 	return newAmountSafe(c, d)
 }
 
+// CanAdd returns true if amounts a and b can be added without an error,
+// that is, if they are denominated in the same currency and their sum does
+// not overflow.
+// CanAdd performs the same computation as [Amount.Add], so it is not faster
+// than calling Add and checking the error.
+// See also method [Amount.CanMul].
+func (a Amount) CanAdd(b Amount) bool {
+	if !a.SameCurr(b) {
+		return false
+	}
+	_, err := a.add(b)
+	return err == nil
+}
+
 // Sub returns the (possibly rounded) difference between amounts a and b.
 //
 // Sub returns an error if:
@@ -425,7 +650,7 @@ func (a Amount) SubAbs(b Amount) (Amount, error) {
 
 func (a Amount) sub(b Amount) (Amount, error) {
 	if !a.SameCurr(b) {
-		return Amount{}, errCurrencyMismatch
+		return Amount{}, mismatchError(a.Curr(), b.Curr())
 	}
 	c, d, e := a.Curr(), a.Decimal(), b.Decimal()
 	d, err := d.SubExact(e, c.Scale())
@@ -457,7 +682,7 @@ func (a Amount) FMA(e decimal.Decimal, b Amount) (Amount, error) {
 
 func (a Amount) fma(e decimal.Decimal, b Amount) (Amount, error) {
 	if !a.SameCurr(b) {
-		return Amount{}, errCurrencyMismatch
+		return Amount{}, mismatchError(a.Curr(), b.Curr())
 	}
 	c, d, f := a.Curr(), a.Decimal(), b.Decimal()
 	d, err := d.FMAExact(e, f, c.Scale())
@@ -467,6 +692,41 @@ func (a Amount) fma(e decimal.Decimal, b Amount) (Amount, error) {
 	return newAmountSafe(c, d)
 }
 
+// AddConverted returns the (possibly rounded) sum of amount a and amount b
+// converted to the currency of amount a using exchange rate r.
+// It computes a + r * b without any intermediate rounding, avoiding
+// the double rounding that may occur when [ExchangeRate.Conv] is followed
+// by [Amount.Add].
+// The result is rounded only if it cannot be represented exactly
+// with [decimal.MaxPrec] digits.
+//
+// AddConverted returns an error if:
+//   - the base currency of the exchange rate does not match the currency of amount b;
+//   - the quote currency of the exchange rate does not match the currency of amount a;
+//   - the integer part of the result has more than ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func (a Amount) AddConverted(b Amount, r ExchangeRate) (Amount, error) {
+	c, err := a.addConverted(b, r)
+	if err != nil {
+		return Amount{}, fmt.Errorf("computing [%v + %v * %v]: %w", a, r, b, err)
+	}
+	return c, nil
+}
+
+func (a Amount) addConverted(b Amount, r ExchangeRate) (Amount, error) {
+	if !r.CanConv(b) {
+		return Amount{}, mismatchError(r.Base(), b.Curr())
+	}
+	if r.Quote() != a.Curr() {
+		return Amount{}, mismatchError(a.Curr(), r.Quote())
+	}
+	c, d, e, f := a.Curr(), r.Decimal(), b.Decimal(), a.Decimal()
+	d, err := d.FMAExact(e, f, c.Scale())
+	if err != nil {
+		return Amount{}, err
+	}
+	return newAmountSafe(c, d)
+}
+
 // Mul returns the (possibly rounded) product of amount a and factor e.
 //
 // Mul returns an error if the integer part of the result has more than
@@ -490,6 +750,16 @@ func (a Amount) mul(e decimal.Decimal) (Amount, error) {
 	return newAmountSafe(c, d)
 }
 
+// CanMul returns true if amount a can be multiplied by factor e without
+// an error, that is, if their product does not overflow.
+// CanMul performs the same computation as [Amount.Mul], so it is not faster
+// than calling Mul and checking the error.
+// See also method [Amount.CanAdd].
+func (a Amount) CanMul(e decimal.Decimal) bool {
+	_, err := a.mul(e)
+	return err == nil
+}
+
 // Quo returns the (possibly rounded) quotient of amount a and divisor e.
 // See also methods [Amount.QuoRem], [Amount.Rat], and [Amount.Split].
 //
@@ -539,7 +809,7 @@ func (a Amount) quoRem(e decimal.Decimal) (q, r Amount, err error) {
 	}
 
 	// T-Division
-	q = q.TruncToCurr()
+	q = q.Trunc(q.Curr().Scale())
 
 	// Reminder
 	r, err = q.Mul(e)
@@ -570,6 +840,42 @@ func (a Amount) Rat(b Amount) (decimal.Decimal, error) {
 	return d, nil
 }
 
+// DivMod returns the number of times amount b fits into amount a and
+// the remainder, such that a = b * count + rem.
+// The count is an integer truncated towards zero (T-division), and the sign of
+// the remainder is the same as the sign of amount a.
+// For example, USD 3.10 divmod USD 0.25 returns 12 and USD 0.10.
+// This method is useful for denomination breakdowns and unit pricing.
+// See also methods [Amount.QuoRem] and [Amount.Rat].
+//
+// DivMod returns an error if:
+//   - amounts are denominated in different currencies;
+//   - the divisor is 0;
+//   - the integer part of the count has more than [decimal.MaxPrec] digits.
+func (a Amount) DivMod(b Amount) (count decimal.Decimal, rem Amount, err error) {
+	count, rem, err = a.divMod(b)
+	if err != nil {
+		return decimal.Decimal{}, Amount{}, fmt.Errorf("computing [%v div %v] and [%v mod %v]: %w", a, b, a, b, err)
+	}
+	return count, rem, nil
+}
+
+func (a Amount) divMod(b Amount) (count decimal.Decimal, rem Amount, err error) {
+	if !a.SameCurr(b) {
+		return decimal.Decimal{}, Amount{}, mismatchError(a.Curr(), b.Curr())
+	}
+	d, e := a.Decimal(), b.Decimal()
+	count, f, err := d.QuoRem(e)
+	if err != nil {
+		return decimal.Decimal{}, Amount{}, err
+	}
+	rem, err = newAmountSafe(a.Curr(), f)
+	if err != nil {
+		return decimal.Decimal{}, Amount{}, err
+	}
+	return count, rem, nil
+}
+
 // Split returns a slice of amounts that sum up to the original amount,
 // ensuring the parts are as equal as possible.
 // If the original amount cannot be divided equally among the specified number
@@ -631,6 +937,309 @@ func (a Amount) split(parts int) ([]Amount, error) {
 	return res, nil
 }
 
+// SpreadOver returns a slice of amounts that sum up to the original amount,
+// where all parts are equal except the final one, which absorbs
+// the adjustment and is never greater in absolute value than the others.
+// Unlike [Amount.Split], which front-loads the remainder, SpreadOver produces
+// parts that do not increase in absolute value, as some regulatory payment
+// plans and daily budgets require.
+// The equal parts are the quotient rounded away from zero to the scale of
+// the original amount.
+//
+// SpreadOver returns an error if:
+//   - the number of parts is not a positive integer;
+//   - the amount is too small to be spread over the number of parts,
+//     so that the final part would have the opposite sign.
+func (a Amount) SpreadOver(parts int) ([]Amount, error) {
+	r, err := a.spreadOver(parts)
+	if err != nil {
+		return nil, fmt.Errorf("spreading %v over %v parts: %w", a, parts, err)
+	}
+	return r, nil
+}
+
+func (a Amount) spreadOver(parts int) ([]Amount, error) {
+	// Parts
+	par, err := decimal.New(int64(parts), 0)
+	if err != nil {
+		return nil, err
+	}
+	if !par.IsPos() {
+		return nil, fmt.Errorf("number of parts must be positive")
+	}
+
+	// Quotient
+	quo, err := a.Quo(par)
+	if err != nil {
+		return nil, err
+	}
+	if a.IsNeg() {
+		quo = quo.Floor(a.Scale())
+	} else {
+		quo = quo.Ceil(a.Scale())
+	}
+
+	// Final part
+	par, err = decimal.New(int64(parts-1), 0)
+	if err != nil {
+		return nil, err
+	}
+	last, err := quo.Mul(par)
+	if err != nil {
+		return nil, err
+	}
+	last, err = a.Sub(last)
+	if err != nil {
+		return nil, err
+	}
+	if last.Sign() != 0 && last.Sign() != a.Sign() {
+		return nil, fmt.Errorf("amount is too small")
+	}
+
+	res := make([]Amount, parts)
+	for i := 0; i < parts-1; i++ {
+		res[i] = quo
+	}
+	res[parts-1] = last
+	return res, nil
+}
+
+// SplitCash returns a slice of amounts that sum up to the original amount,
+// where every part is a multiple of the cash increment, for example,
+// 0.05 for Swiss Franc cash payments.
+// The parts are as equal as possible: if the original amount cannot be divided
+// equally, the extra increments are distributed among the first parts of
+// the slice, like in [Amount.Split].
+// Amounts that are not multiples of the increment should be rounded first,
+// for example, using [Amount.RoundToCurr] with a [RoundingPolicy].
+//
+// SplitCash returns an error if:
+//   - the number of parts is not a positive integer;
+//   - the increment is not positive or is denominated in a different currency;
+//   - the amount is not a multiple of the increment.
+func (a Amount) SplitCash(parts int, increment Amount) ([]Amount, error) {
+	r, err := a.splitCash(parts, increment)
+	if err != nil {
+		return nil, fmt.Errorf("splitting %v into %v parts with increment %v: %w", a, parts, increment, err)
+	}
+	return r, nil
+}
+
+func (a Amount) splitCash(parts int, increment Amount) ([]Amount, error) {
+	// Parts
+	par, err := decimal.New(int64(parts), 0)
+	if err != nil {
+		return nil, err
+	}
+	if !par.IsPos() {
+		return nil, fmt.Errorf("number of parts must be positive")
+	}
+	if !a.SameCurr(increment) {
+		return nil, mismatchError(a.Curr(), increment.Curr())
+	}
+	if !increment.IsPos() {
+		return nil, fmt.Errorf("increment must be positive")
+	}
+
+	// Number of increments
+	count, rem, err := a.divMod(increment)
+	if err != nil {
+		return nil, err
+	}
+	if !rem.IsZero() {
+		return nil, fmt.Errorf("amount must be a multiple of the increment")
+	}
+	quo, extra, err := count.QuoRem(par)
+	if err != nil {
+		return nil, err
+	}
+	quo = quo.Trunc(0)
+	n, _, ok := extra.Int64(0)
+	if !ok {
+		return nil, fmt.Errorf("number of increments overflow")
+	}
+
+	// Parts
+	scale := max(a.Scale(), increment.Scale())
+	small, err := increment.mul(quo)
+	if err != nil {
+		return nil, err
+	}
+	small = small.Rescale(scale)
+	large := small
+	if n != 0 {
+		ulp := increment
+		if n < 0 {
+			ulp, n = ulp.Neg(), -n
+		}
+		large, err = small.add(ulp)
+		if err != nil {
+			return nil, err
+		}
+		large = large.Rescale(scale)
+	}
+	res := make([]Amount, parts)
+	for i := range res {
+		if int64(i) < n {
+			res[i] = large
+		} else {
+			res[i] = small
+		}
+	}
+	return res, nil
+}
+
+// SplitWeighted returns a slice of amounts that sum up to the original amount
+// and are proportional to the weights, for example, the parts of a payment
+// allocated across invoices by their outstanding balances.
+// Each part is first truncated to the scale of the original amount, and then
+// the remainder is distributed one unit in the last place at a time among
+// the first parts with positive weights, so parts with zero weights are always
+// zero.
+// See also method [Amount.Split].
+//
+// SplitWeighted returns an error if:
+//   - there are no weights;
+//   - the weights are denominated in a different currency than the amount;
+//   - any weight is negative or the sum of the weights is 0;
+//   - the integer part of any intermediate result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func (a Amount) SplitWeighted(weights []Amount) ([]Amount, error) {
+	r, err := a.splitWeighted(weights)
+	if err != nil {
+		return nil, fmt.Errorf("splitting %v by weights %v: %w", a, weights, err)
+	}
+	return r, nil
+}
+
+func (a Amount) splitWeighted(weights []Amount) ([]Amount, error) {
+	if len(weights) == 0 {
+		return nil, fmt.Errorf("no weights")
+	}
+
+	// Total weight
+	var err error
+	total := decimal.Zero
+	for _, w := range weights {
+		if !a.SameCurr(w) {
+			return nil, mismatchError(a.Curr(), w.Curr())
+		}
+		if w.IsNeg() {
+			return nil, fmt.Errorf("weights must not be negative")
+		}
+		total, err = total.Add(w.Decimal())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if total.IsZero() {
+		return nil, fmt.Errorf("sum of weights must be positive")
+	}
+
+	// Parts
+	c, d, scale := a.Curr(), a.Decimal(), a.Scale()
+	sum := a.Zero()
+	res := make([]Amount, len(weights))
+	for i, w := range weights {
+		q, err := d.Mul(w.Decimal())
+		if err != nil {
+			return nil, err
+		}
+		q, err = q.Quo(total)
+		if err != nil {
+			return nil, err
+		}
+		res[i], err = newAmountSafe(c, q.Trunc(scale).Pad(scale))
+		if err != nil {
+			return nil, err
+		}
+		sum, err = sum.add(res[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Reminder distribution
+	rem, err := a.sub(sum)
+	if err != nil {
+		return nil, err
+	}
+	ulp := rem.ULP().CopySign(rem)
+	for i := 0; !rem.IsZero(); i = (i + 1) % len(res) {
+		if weights[i].IsZero() {
+			continue
+		}
+		rem, err = rem.sub(ulp)
+		if err != nil {
+			return nil, err
+		}
+		res[i], err = res[i].add(ulp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// SplitMin returns a slice of amounts that sum up to the original amount,
+// ensuring the parts are as equal as possible and each part is greater than
+// or equal to the minimum part.
+// If this is not possible with the specified number of parts, the number of
+// parts is reduced to the largest number that satisfies the constraint.
+// The remainder is distributed among the first parts of the slice,
+// as in [Amount.Split].
+//
+// SplitMin returns an error if:
+//   - the number of parts is not a positive integer;
+//   - amounts are denominated in different currencies;
+//   - the minimum part is negative;
+//   - the amount is less than the minimum part.
+func (a Amount) SplitMin(parts int, min Amount) ([]Amount, error) {
+	r, err := a.splitMin(parts, min)
+	if err != nil {
+		return nil, fmt.Errorf("splitting %v into %v parts of at least %v: %w", a, parts, min, err)
+	}
+	return r, nil
+}
+
+func (a Amount) splitMin(parts int, min Amount) ([]Amount, error) {
+	if parts <= 0 {
+		return nil, fmt.Errorf("number of parts must be positive")
+	}
+	if !a.SameCurr(min) {
+		return nil, mismatchError(a.Curr(), min.Curr())
+	}
+	if min.IsNeg() {
+		return nil, fmt.Errorf("minimum part must not be negative")
+	}
+	if a.Decimal().Cmp(min.Decimal()) < 0 {
+		return nil, fmt.Errorf("amount is less than minimum part")
+	}
+
+	// Maximum number of parts
+	if min.IsPos() {
+		q, err := a.Decimal().Quo(min.Decimal())
+		if err != nil {
+			return nil, err
+		}
+		if n, _, ok := q.Trunc(0).Int64(0); ok && n < int64(parts) {
+			parts = int(n)
+		}
+	}
+
+	for {
+		res, err := a.split(parts)
+		if err != nil {
+			return nil, err
+		}
+		// The last part is the smallest one
+		if parts == 1 || res[parts-1].Decimal().Cmp(min.Decimal()) >= 0 {
+			return res, nil
+		}
+		parts--
+	}
+}
+
 // One returns an amount with a value of 1, having the same currency and scale
 // as amount a.
 // See also methods [Amount.Zero], [Amount.ULP].
@@ -666,11 +1275,13 @@ func (a Amount) Ceil(scale int) Amount {
 
 // CeilToCurr returns an amount rounded up to the scale of its currency
 // using [rounding toward positive infinity].
-// See also methods [Amount.Ceil], [Amount.SameScaleAsCurr].
+// If a rounding policy with an increment is registered for the currency,
+// the amount is rounded up to a multiple of that increment instead.
+// See also methods [Amount.Ceil], [Amount.SameScaleAsCurr] and function [SetRoundingPolicy].
 //
 // [rounding toward positive infinity]: https://en.wikipedia.org/wiki/Rounding#Rounding_up
 func (a Amount) CeilToCurr() Amount {
-	return a.Ceil(a.Curr().Scale())
+	return a.roundToCurr(Ceiling, roundingPolicies[a.Curr()].Load())
 }
 
 // Floor returns an amount rounded down to the specified number of digits after
@@ -686,11 +1297,13 @@ func (a Amount) Floor(scale int) Amount {
 
 // FloorToCurr returns an amount rounded down to the scale of its currency
 // using [rounding toward negative infinity].
-// See also methods [Amount.Floor], [Amount.SameScaleAsCurr].
+// If a rounding policy with an increment is registered for the currency,
+// the amount is rounded down to a multiple of that increment instead.
+// See also methods [Amount.Floor], [Amount.SameScaleAsCurr] and function [SetRoundingPolicy].
 //
 // [rounding toward negative infinity]: https://en.wikipedia.org/wiki/Rounding#Rounding_down
 func (a Amount) FloorToCurr() Amount {
-	return a.Floor(a.Curr().Scale())
+	return a.roundToCurr(Floor, roundingPolicies[a.Curr()].Load())
 }
 
 // Trunc returns an amount truncated to the specified number of digits after
@@ -706,11 +1319,13 @@ func (a Amount) Trunc(scale int) Amount {
 
 // TruncToCurr returns an amount truncated to the scale of its currency
 // using [rounding toward zero].
-// See also methods [Amount.Trunc], [Amount.SameScaleAsCurr].
+// If a rounding policy with an increment is registered for the currency,
+// the amount is truncated to a multiple of that increment instead.
+// See also methods [Amount.Trunc], [Amount.SameScaleAsCurr] and function [SetRoundingPolicy].
 //
 // [rounding toward zero]: https://en.wikipedia.org/wiki/Rounding#Rounding_toward_zero
 func (a Amount) TruncToCurr() Amount {
-	return a.Trunc(a.Curr().Scale())
+	return a.roundToCurr(Down, roundingPolicies[a.Curr()].Load())
 }
 
 // Round returns an amount rounded to the specified number of digits after
@@ -726,11 +1341,52 @@ func (a Amount) Round(scale int) Amount {
 
 // RoundToCurr returns an amount rounded to the scale of its currency
 // using [rounding half to even] (banker's rounding).
-// See also methods [Amount.Round], [Amount.SameScaleAsCurr].
+// If a rounding policy is registered for the currency, the amount is rounded
+// using the mode and the increment of that policy instead.
+// See also methods [Amount.Round], [Amount.SameScaleAsCurr] and function [SetRoundingPolicy].
 //
 // [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
 func (a Amount) RoundToCurr() Amount {
-	return a.Round(a.Curr().Scale())
+	p := roundingPolicies[a.Curr()].Load()
+	if p == nil {
+		return a.Round(a.Curr().Scale())
+	}
+	return a.roundToCurr(p.Mode, p)
+}
+
+// CeilToCurrChanged is like [Amount.CeilToCurr] but also reports whether
+// rounding changed the numeric value of the amount, so callers can warn users
+// whose input was adjusted.
+// Zero-padding to the scale of the currency is not considered a change.
+func (a Amount) CeilToCurrChanged() (Amount, bool) {
+	return a.changed(a.CeilToCurr())
+}
+
+// FloorToCurrChanged is like [Amount.FloorToCurr] but also reports whether
+// rounding changed the numeric value of the amount.
+// Zero-padding to the scale of the currency is not considered a change.
+func (a Amount) FloorToCurrChanged() (Amount, bool) {
+	return a.changed(a.FloorToCurr())
+}
+
+// TruncToCurrChanged is like [Amount.TruncToCurr] but also reports whether
+// truncation changed the numeric value of the amount.
+// Zero-padding to the scale of the currency is not considered a change.
+func (a Amount) TruncToCurrChanged() (Amount, bool) {
+	return a.changed(a.TruncToCurr())
+}
+
+// RoundToCurrChanged is like [Amount.RoundToCurr] but also reports whether
+// rounding changed the numeric value of the amount.
+// Zero-padding to the scale of the currency is not considered a change.
+func (a Amount) RoundToCurrChanged() (Amount, bool) {
+	return a.changed(a.RoundToCurr())
+}
+
+// changed returns amount b and true if it is not numerically equal to amount a.
+func (a Amount) changed(b Amount) (Amount, bool) {
+	d, e := a.Decimal(), b.Decimal()
+	return b, d.Cmp(e) != 0
 }
 
 // Quantize returns an amount rescaled to the same scale as amount b.
@@ -740,6 +1396,53 @@ func (a Amount) Quantize(b Amount) Amount {
 	return a.Rescale(b.Scale())
 }
 
+// QuantizeExact returns an amount rescaled to the same scale as amount b
+// using the given rounding mode.
+// Unlike [Amount.Quantize], which silently keeps a smaller scale when
+// zero-padding would overflow, it reports when the scale of amount b
+// cannot be honored.
+// The currency and the sign of amount b are ignored.
+//
+// QuantizeExact returns an error if:
+//   - the rounding mode is not valid;
+//   - the scale of amount b is less than the scale of the currency of amount a;
+//   - the rounding mode is [Unnecessary] and amount a has non-zero digits
+//     beyond the scale of amount b;
+//   - the result cannot be represented with the scale of amount b
+//     without exceeding [decimal.MaxPrec] digits.
+func (a Amount) QuantizeExact(b Amount, mode RoundingMode) (Amount, error) {
+	c, err := a.quantizeExact(b.Scale(), mode)
+	if err != nil {
+		return Amount{}, fmt.Errorf("quantizing %v to the scale of %v: %w", a, b, err)
+	}
+	return c, nil
+}
+
+func (a Amount) quantizeExact(scale int, mode RoundingMode) (Amount, error) {
+	c, d := a.Curr(), a.Decimal()
+	if !mode.valid() {
+		return Amount{}, fmt.Errorf("invalid rounding mode %v", mode)
+	}
+	if scale < c.Scale() {
+		return Amount{}, fmt.Errorf("scale %v is less than the scale of %v", scale, c)
+	}
+	if scale < d.Scale() {
+		if mode == Unnecessary {
+			if d.MinScale() > scale {
+				return Amount{}, fmt.Errorf("rounding to %v digits after the decimal point is necessary", scale)
+			}
+			d = d.Trim(scale)
+		}
+		d = roundDecimal(d, scale, mode)
+	} else {
+		d = d.Pad(scale)
+	}
+	if d.Scale() != scale {
+		return Amount{}, fmt.Errorf("scale %v cannot be honored without exceeding %v digits", scale, decimal.MaxPrec)
+	}
+	return newAmountSafe(c, d)
+}
+
 // Rescale returns an amount rounded or zero-padded to the given number of digits
 // after the decimal point.
 // See also method [Amount.Round].
@@ -766,6 +1469,22 @@ func (a Amount) TrimToCurr() Amount {
 	return a.Trim(a.Curr().Scale())
 }
 
+// Normalize returns the canonical representation of the amount, which has
+// trailing zeros removed up to the scale of its currency and is never
+// a negative zero.
+// Numerically equal amounts denominated in the same currency have identical
+// normalized representations, so normalizing amounts before encoding them
+// with [Amount.MarshalBinary], [EncodeCompact], or [Amount.String] makes
+// byte-level comparisons of the encoded amounts stable.
+// See also methods [Amount.TrimToCurr] and [Amount.CanonicalString].
+func (a Amount) Normalize() Amount {
+	a = a.TrimToCurr()
+	if a.IsZero() {
+		a = a.Abs()
+	}
+	return a
+}
+
 // SameCurr returns true if amounts are denominated in the same currency.
 // See also method [Amount.Curr].
 func (a Amount) SameCurr(b Amount) bool {
@@ -793,6 +1512,13 @@ func (a Amount) SameScaleAsCurr() bool {
 // [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
 // [Decimal.String]: https://pkg.go.dev/github.com/govalues/decimal#Decimal.String
 func (a Amount) String() string {
+	var buf [32]byte
+	return string(a.appendText(buf[:0]))
+}
+
+// appendText appends the text produced by [Amount.String] to dst and returns
+// the extended buffer.
+func (a Amount) appendText(dst []byte) []byte {
 	var buf [32]byte
 	pos := len(buf) - 1
 	coef := a.Decimal().Coef()
@@ -838,7 +1564,228 @@ func (a Amount) String() string {
 		pos--
 	}
 
-	return string(buf[pos+1:])
+	return append(dst, buf[pos+1:]...)
+}
+
+// CanonicalString returns a string representation of the amount with trailing
+// zeros removed up to the scale of its currency.
+// Unlike [Amount.String], it guarantees that numerically equal amounts
+// denominated in the same currency produce identical strings,
+// for example, both "USD 5.6" and "USD 5.600" are represented as "USD 5.60".
+// This method is useful for building idempotency keys and deduplication caches.
+// See also method [Amount.Normalize].
+func (a Amount) CanonicalString() string {
+	return a.Normalize().String()
+}
+
+// LogValue implements the [slog.LogValuer] interface.
+// It returns a group with the currency code, the decimal value as a string
+// to avoid any loss of precision, and the scale of the amount.
+// See also method [Amount.Redacted].
+//
+// [slog.LogValuer]: https://pkg.go.dev/log/slog#LogValuer
+func (a Amount) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("currency", a.Curr().Code()),
+		slog.String("value", a.Decimal().String()),
+		slog.Int("scale", a.Scale()),
+	)
+}
+
+// Scan implements the [sql.Scanner] interface.
+// It accepts strings and byte slices in one of the following layouts:
+//
+//	| Layout                           | Example      |
+//	| -------------------------------- | ------------ |
+//	| text produced by [Amount.String] | "USD 5.67"   |
+//	| text produced by the %m verb     | "USD5.67"    |
+//	| Postgres composite type literal  | "(USD,5.67)" |
+//
+// For amounts stored in separate currency and value columns,
+// use function [ScanPair].
+//
+// [sql.Scanner]: https://pkg.go.dev/database/sql#Scanner
+func (a *Amount) Scan(value any) error {
+	var err error
+	switch value := value.(type) {
+	case string:
+		*a, err = parseAmountSQL(value)
+	case []byte:
+		*a, err = parseAmountSQL(string(value))
+	case nil:
+		err = fmt.Errorf("converting to %T: nil is not supported", a)
+	default:
+		err = fmt.Errorf("converting from %T to %T: type %T is not supported", value, a, value)
+	}
+	return err
+}
+
+// parseAmountSQL parses the text produced by [Amount.String], the text
+// produced by the %m verb of [Amount.Format], or a Postgres composite type
+// literal.
+func parseAmountSQL(s string) (Amount, error) {
+	if t, ok := strings.CutPrefix(s, "("); ok {
+		t, ok = strings.CutSuffix(t, ")")
+		if !ok {
+			return Amount{}, fmt.Errorf("parsing %q: missing closing parenthesis", s)
+		}
+		curr, amount, ok := strings.Cut(t, ",")
+		if !ok {
+			return Amount{}, fmt.Errorf("parsing %q: missing delimiter", s)
+		}
+		curr = strings.Trim(strings.TrimSpace(curr), `"`)
+		amount = strings.Trim(strings.TrimSpace(amount), `"`)
+		return ParseAmount(curr, amount)
+	}
+	a, err := decodeV0([]byte(s))
+	if err != nil {
+		return Amount{}, fmt.Errorf("parsing %q: %w", s, err)
+	}
+	return a, nil
+}
+
+// Value implements the [driver.Valuer] interface.
+// It returns the text produced by [Amount.String], which preserves
+// the scale of the amount and can be scanned back using [Amount.Scan].
+//
+// [driver.Valuer]: https://pkg.go.dev/database/sql/driver#Valuer
+func (a Amount) Value() (driver.Value, error) {
+	return a.String(), nil
+}
+
+// UnmarshalGQL implements the [graphql.Unmarshaler] interface of gqlgen,
+// so amounts can be used as custom GraphQL scalars.
+// It accepts strings in the format produced by [Amount.MarshalGQL],
+// for example, "USD 5.67".
+//
+// [graphql.Unmarshaler]: https://gqlgen.com/reference/scalars/
+func (a *Amount) UnmarshalGQL(v any) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("converting from %T to %T: type %T is not supported", v, a, v)
+	}
+	var err error
+	*a, err = decodeV0([]byte(s))
+	if err != nil {
+		return fmt.Errorf("converting %q: %w", s, err)
+	}
+	return nil
+}
+
+// MarshalGQL implements the [graphql.Marshaler] interface of gqlgen.
+// It writes the text produced by [Amount.String] as a quoted string,
+// for example, "USD 5.67", which preserves the scale of the amount.
+//
+// [graphql.Marshaler]: https://gqlgen.com/reference/scalars/
+func (a Amount) MarshalGQL(w io.Writer) {
+	_, _ = io.WriteString(w, strconv.Quote(a.String()))
+}
+
+// GormDataType returns the general data type used by [GORM] for storing
+// the amount, so that amounts can be used as model fields without bespoke
+// value converters.
+// Together with methods [Amount.Scan] and [Amount.Value], it stores amounts
+// as text without any loss of scale.
+//
+// [GORM]: https://gorm.io/docs/data_types.html
+func (a Amount) GormDataType() string {
+	return "string"
+}
+
+// ScanPair returns a pair of [sql.Scanner] destinations that scan an amount
+// stored in two columns, the currency code followed by the value.
+// The amount is stored into a once both columns have been scanned.
+// For example:
+//
+//	var a money.Amount
+//	err := row.Scan(money.ScanPair(&a))
+//
+// The value column may hold strings, byte slices, integers, or floats.
+//
+// [sql.Scanner]: https://pkg.go.dev/database/sql#Scanner
+func ScanPair(a *Amount) (curr, value any) {
+	p := &pairScanner{amount: a}
+	return pairCurrScanner{p}, pairValueScanner{p}
+}
+
+// pairScanner holds the columns scanned by [ScanPair].
+type pairScanner struct {
+	amount   *Amount
+	curr     Currency
+	value    string
+	hasCurr  bool
+	hasValue bool
+}
+
+// store stores the amount once both columns have been scanned.
+// The columns are then cleared, so that the destinations can be reused
+// for the next row without mixing the columns of different rows.
+func (p *pairScanner) store() error {
+	if !p.hasCurr || !p.hasValue {
+		return nil
+	}
+	p.hasCurr, p.hasValue = false, false
+	a, err := ParseAmount(p.curr.Code(), p.value)
+	if err != nil {
+		return err
+	}
+	*p.amount = a
+	return nil
+}
+
+type pairCurrScanner struct{ p *pairScanner }
+
+func (s pairCurrScanner) Scan(value any) error {
+	if err := s.p.curr.Scan(value); err != nil {
+		return err
+	}
+	s.p.hasCurr = true
+	return s.p.store()
+}
+
+type pairValueScanner struct{ p *pairScanner }
+
+func (s pairValueScanner) Scan(value any) error {
+	switch v := value.(type) {
+	case string:
+		s.p.value = v
+	case []byte:
+		s.p.value = string(v)
+	case int64:
+		s.p.value = strconv.FormatInt(v, 10)
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return fmt.Errorf("converting float: special value %v", v)
+		}
+		s.p.value = strconv.FormatFloat(v, 'f', -1, 64)
+	case nil:
+		return fmt.Errorf("converting to %T: nil is not supported", s.p.amount)
+	default:
+		return fmt.Errorf("converting from %T to %T: type %T is not supported", value, s.p.amount, value)
+	}
+	s.p.hasValue = true
+	return s.p.store()
+}
+
+// Redacted returns a masked string representation of the amount, which
+// contains only the currency code and the shape of its minor units,
+// for example, "USD **.**" or "JPY **".
+// Neither the sign nor the magnitude of the amount is disclosed, which makes
+// the result suitable for logs subject to PCI DSS or privacy requirements.
+// To mask the digits of a formatted amount while keeping its shape, use
+// the '#' flag with any verb supported by [Amount.Format], for example, "%#v".
+func (a Amount) Redacted() string {
+	c := a.Curr()
+	buf := make([]byte, 0, 32)
+	buf = append(buf, c.Code()...)
+	buf = append(buf, " **"...)
+	if c.Scale() > 0 {
+		buf = append(buf, '.')
+		for i := 0; i < c.Scale(); i++ {
+			buf = append(buf, '*')
+		}
+	}
+	return string(buf)
 }
 
 // Cmp compares amounts and returns:
@@ -852,7 +1799,7 @@ func (a Amount) String() string {
 // Cmp returns an error if amounts are denominated in different currencies.
 func (a Amount) Cmp(b Amount) (int, error) {
 	if !a.SameCurr(b) {
-		return 0, fmt.Errorf("comparing [%v] and [%v]: %w", a, b, errCurrencyMismatch)
+		return 0, fmt.Errorf("comparing [%v] and [%v]: %w", a, b, mismatchError(a.Curr(), b.Curr()))
 	}
 	d, e := a.Decimal(), b.Decimal()
 	return d.Cmp(e), nil
@@ -869,7 +1816,7 @@ func (a Amount) Cmp(b Amount) (int, error) {
 // CmpAbs returns an error if amounts are denominated in different currencies.
 func (a Amount) CmpAbs(b Amount) (int, error) {
 	if !a.SameCurr(b) {
-		return 0, fmt.Errorf("comparing [abs(%v)] and [abs(%v)]: %w", a, b, errCurrencyMismatch)
+		return 0, fmt.Errorf("comparing [abs(%v)] and [abs(%v)]: %w", a, b, mismatchError(a.Curr(), b.Curr()))
 	}
 	d, e := a.Decimal(), b.Decimal()
 	return d.CmpAbs(e), nil
@@ -888,12 +1835,100 @@ func (a Amount) CmpAbs(b Amount) (int, error) {
 // CmpTotal returns an error if amounts are denominated in different currencies.
 func (a Amount) CmpTotal(b Amount) (int, error) {
 	if !a.SameCurr(b) {
-		return 0, fmt.Errorf("comparing [%v] and [%v]: %w", a, b, errCurrencyMismatch)
+		return 0, fmt.Errorf("comparing [%v] and [%v]: %w", a, b, mismatchError(a.Curr(), b.Curr()))
 	}
 	d, e := a.Decimal(), b.Decimal()
 	return d.CmpTotal(e), nil
 }
 
+// EqualWithin returns true if amounts a and b differ by no more than
+// the tolerance, that is, if |a - b| <= tol.
+// This method is useful for payment reconciliation, where amounts
+// from different sources may differ due to rounding.
+// See also method [Amount.Cmp].
+//
+// EqualWithin returns an error if:
+//   - amounts or the tolerance are denominated in different currencies;
+//   - the tolerance is negative;
+//   - the integer part of the difference has more than ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func (a Amount) EqualWithin(b, tol Amount) (bool, error) {
+	ok, err := a.equalWithin(b, tol)
+	if err != nil {
+		return false, fmt.Errorf("comparing [%v] and [%v] within [%v]: %w", a, b, tol, err)
+	}
+	return ok, nil
+}
+
+func (a Amount) equalWithin(b, tol Amount) (bool, error) {
+	if !a.SameCurr(b) {
+		return false, mismatchError(a.Curr(), b.Curr())
+	}
+	if !a.SameCurr(tol) {
+		return false, mismatchError(a.Curr(), tol.Curr())
+	}
+	if tol.IsNeg() {
+		return false, fmt.Errorf("tolerance must not be negative")
+	}
+	diff, err := a.sub(b)
+	if err != nil {
+		return false, err
+	}
+	d, e := diff.Decimal(), tol.Decimal()
+	return d.CmpAbs(e) <= 0, nil
+}
+
+// IsAtLeast returns true if a >= b.
+// See also methods [Amount.IsAtMost], [Amount.IsStrictlyBetween].
+//
+// IsAtLeast returns an error if amounts are denominated in different currencies.
+func (a Amount) IsAtLeast(b Amount) (bool, error) {
+	if !a.SameCurr(b) {
+		return false, fmt.Errorf("comparing [%v] and [%v]: %w", a, b, mismatchError(a.Curr(), b.Curr()))
+	}
+	d, e := a.Decimal(), b.Decimal()
+	return d.Cmp(e) >= 0, nil
+}
+
+// IsAtMost returns true if a <= b.
+// See also methods [Amount.IsAtLeast], [Amount.IsStrictlyBetween].
+//
+// IsAtMost returns an error if amounts are denominated in different currencies.
+func (a Amount) IsAtMost(b Amount) (bool, error) {
+	if !a.SameCurr(b) {
+		return false, fmt.Errorf("comparing [%v] and [%v]: %w", a, b, mismatchError(a.Curr(), b.Curr()))
+	}
+	d, e := a.Decimal(), b.Decimal()
+	return d.Cmp(e) <= 0, nil
+}
+
+// IsStrictlyBetween returns true if lo < a < hi.
+// See also methods [Amount.IsAtLeast], [Amount.IsAtMost], [Amount.Clamp].
+//
+// IsStrictlyBetween returns an error if:
+//   - amounts are denominated in different currencies;
+//   - lo is greater than hi.
+func (a Amount) IsStrictlyBetween(lo, hi Amount) (bool, error) {
+	ok, err := a.isStrictlyBetween(lo, hi)
+	if err != nil {
+		return false, fmt.Errorf("comparing [%v] with [%v] and [%v]: %w", a, lo, hi, err)
+	}
+	return ok, nil
+}
+
+func (a Amount) isStrictlyBetween(lo, hi Amount) (bool, error) {
+	if !a.SameCurr(lo) {
+		return false, mismatchError(a.Curr(), lo.Curr())
+	}
+	if !a.SameCurr(hi) {
+		return false, mismatchError(a.Curr(), hi.Curr())
+	}
+	d, l, h := a.Decimal(), lo.Decimal(), hi.Decimal()
+	if l.Cmp(h) > 0 {
+		return false, fmt.Errorf("lower bound must not be greater than upper bound")
+	}
+	return d.Cmp(l) > 0 && d.Cmp(h) < 0, nil
+}
+
 // Min returns the smaller amount.
 // See also method [Amount.CmpTotal].
 //
@@ -968,25 +2003,46 @@ func (a Amount) Clamp(min, max Amount) (Amount, error) {
 // Format implements the [fmt.Formatter] interface.
 // The following [format verbs] are available:
 //
-//	| Verb   | Example     | Description                |
-//	| ------ | ----------- | -------------------------- |
-//	| %s, %v | USD 5.678   | Currency and amount        |
-//	| %q     | "USD 5.678" | Quoted currency and amount |
-//	| %f     | 5.678       | Amount                     |
-//	| %d     | 568         | Amount in minor units      |
-//	| %c     | USD         | Currency                   |
+//	| Verb   | Example      | Description                   |
+//	| ------ | ------------ | ----------------------------- |
+//	| %s, %v | USD 5.678    | Currency and amount           |
+//	| %q     | "USD 5.678"  | Quoted currency and amount    |
+//	| %m     | USD5.678     | Currency and amount, no space |
+//	| %f     | 5.678        | Amount                        |
+//	| %e     | 5.678000e+00 | Amount in scientific notation |
+//	| %g     | 5.678        | Amount in %e or %f notation   |
+//	| %d     | 568          | Amount in minor units         |
+//	| %c     | USD          | Currency                      |
 //
 // The '-' format flag can be used with all verbs.
 // The '+', ' ', '0' format flags can be used with all verbs except %c.
+// The '#' format flag masks all digits with asterisks, for example,
+// "%#v" formats "USD 5.678" as "USD *.***".
+// See also method [Amount.Redacted].
+//
+// The %m verb produces a machine-readable form without embedded spaces,
+// for example, "USD-5.678", for CSV and fixed-layout consumers that cannot
+// handle them.
+// The ' ' flag is ignored by this verb.
+// This form is accepted by [Amount.Scan] and [DecodeAny].
 //
-// Precision is only supported for the %f verb.
-// The default precision is equal to the actual scale of the amount.
+// Precision is only supported for the %f, %e, and %g verbs.
+// For the %f verb, the default precision is equal to the actual scale of
+// the amount.
+// For the %e and %g verbs, precision follows the [fmt] conventions for floats,
+// but the digits are computed from the exact decimal value and rounded using
+// rounding half to even.
 //
 // [format verbs]: https://pkg.go.dev/fmt#hdr-Printing
 // [fmt.Formatter]: https://pkg.go.dev/fmt#Formatter
 //
 //gocyclo:ignore
 func (a Amount) Format(state fmt.State, verb rune) {
+	if verb == 'e' || verb == 'E' || verb == 'g' || verb == 'G' {
+		a.formatExp(state, verb)
+		return
+	}
+
 	c, d := a.Curr(), a.Decimal()
 
 	// Rescaling
@@ -1037,8 +2093,10 @@ func (a Amount) Format(state fmt.State, verb rune) {
 	}
 
 	// Arithmetic sign
+	// The ' ' flag is ignored by the %m verb, which has no embedded spaces.
+	space := state.Flag(' ') && verb != 'm' && verb != 'M'
 	rsign := 0
-	if verb != 'c' && verb != 'C' && (d.IsNeg() || state.Flag('+') || state.Flag(' ')) {
+	if verb != 'c' && verb != 'C' && (d.IsNeg() || state.Flag('+') || space) {
 		rsign = 1
 	}
 
@@ -1050,6 +2108,10 @@ func (a Amount) Format(state fmt.State, verb rune) {
 	case 'c', 'C':
 		curr = c.Code()
 		currsyms = len(curr)
+	case 'm', 'M':
+		// Machine-readable form without a delimiter
+		curr = c.Code()
+		currsyms = len(curr)
 	default:
 		curr = c.Code()
 		currsyms = len(curr)
@@ -1129,7 +2191,7 @@ func (a Amount) Format(state fmt.State, verb rune) {
 	if rsign > 0 {
 		if d.IsNeg() {
 			buf[pos] = '-'
-		} else if state.Flag(' ') {
+		} else if space {
 			buf[pos] = ' '
 		} else {
 			buf[pos] = '+'
@@ -1161,10 +2223,19 @@ func (a Amount) Format(state fmt.State, verb rune) {
 		pos--
 	}
 
+	// Masking digits
+	if state.Flag('#') {
+		for i, b := range buf {
+			if '0' <= b && b <= '9' {
+				buf[i] = '*'
+			}
+		}
+	}
+
 	// Writing result
 	//nolint:errcheck
 	switch verb {
-	case 'q', 'Q', 's', 'S', 'v', 'V', 'f', 'F', 'd', 'D', 'c', 'C':
+	case 'q', 'Q', 's', 'S', 'v', 'V', 'm', 'M', 'f', 'F', 'd', 'D', 'c', 'C':
 		state.Write(buf)
 	default:
 		state.Write([]byte("%!"))
@@ -1174,3 +2245,237 @@ func (a Amount) Format(state fmt.State, verb rune) {
 		state.Write([]byte(")"))
 	}
 }
+
+// formatExp implements the %e and %g verbs of [Amount.Format].
+func (a Amount) formatExp(state fmt.State, verb rune) {
+	d := a.Decimal()
+
+	// Significant digits and exponent
+	digs := strconv.FormatUint(d.Coef(), 10)
+	exp := len(digs) - 1 - d.Scale()
+	digs = strings.TrimRight(digs, "0")
+	if digs == "" {
+		digs, exp = "0", 0
+	}
+
+	// Notation
+	prec, ok := state.Precision()
+	sci := true
+	switch verb {
+	case 'e', 'E':
+		if !ok {
+			prec = 6
+		}
+		digs, exp = roundDigits(digs, exp, prec+1)
+	default:
+		eprec := 6
+		if ok {
+			prec = max(prec, 1)
+			digs, exp = roundDigits(digs, exp, prec)
+			eprec = prec
+		}
+		sci = exp < -4 || exp >= eprec
+		prec = len(digs) - 1
+	}
+
+	buf := make([]byte, 0, 32)
+
+	// Arithmetic sign
+	switch {
+	case d.IsNeg():
+		buf = append(buf, '-')
+	case state.Flag('+'):
+		buf = append(buf, '+')
+	case state.Flag(' '):
+		buf = append(buf, ' ')
+	}
+	sign := len(buf)
+
+	// Digits
+	if sci {
+		buf = append(buf, digs[0])
+		if prec > 0 {
+			buf = append(buf, '.')
+			buf = append(buf, digs[1:]...)
+			for i := len(digs) - 1; i < prec; i++ {
+				buf = append(buf, '0')
+			}
+		}
+		if verb == 'E' || verb == 'G' {
+			buf = append(buf, 'E')
+		} else {
+			buf = append(buf, 'e')
+		}
+		if exp < 0 {
+			buf = append(buf, '-')
+			exp = -exp
+		} else {
+			buf = append(buf, '+')
+		}
+		if exp < 10 {
+			buf = append(buf, '0')
+		}
+		buf = strconv.AppendInt(buf, int64(exp), 10)
+	} else {
+		switch {
+		case exp < 0:
+			buf = append(buf, "0."...)
+			for i := exp; i < -1; i++ {
+				buf = append(buf, '0')
+			}
+			buf = append(buf, digs...)
+		case exp+1 >= len(digs):
+			buf = append(buf, digs...)
+			for i := len(digs); i <= exp; i++ {
+				buf = append(buf, '0')
+			}
+		default:
+			buf = append(buf, digs[:exp+1]...)
+			buf = append(buf, '.')
+			buf = append(buf, digs[exp+1:]...)
+		}
+	}
+
+	// Padding
+	if w, ok := state.Width(); ok && w > len(buf) {
+		pad := make([]byte, w-len(buf))
+		switch {
+		case state.Flag('-'):
+			for i := range pad {
+				pad[i] = ' '
+			}
+			buf = append(buf, pad...)
+		case state.Flag('0'):
+			for i := range pad {
+				pad[i] = '0'
+			}
+			buf = append(buf[:sign], append(pad, buf[sign:]...)...)
+		default:
+			for i := range pad {
+				pad[i] = ' '
+			}
+			buf = append(pad, buf...)
+		}
+	}
+
+	// Masking digits
+	if state.Flag('#') {
+		for i, b := range buf {
+			if '0' <= b && b <= '9' {
+				buf[i] = '*'
+			}
+		}
+	}
+
+	//nolint:errcheck
+	state.Write(buf)
+}
+
+// roundDigits rounds significant digits with the specified exponent to at
+// most n digits using rounding half to even and removes trailing zeros.
+func roundDigits(digs string, exp, n int) (string, int) {
+	if len(digs) <= n {
+		return digs, exp
+	}
+	keep := []byte(digs[:n])
+	next, rest := digs[n], strings.TrimRight(digs[n+1:], "0")
+	if next > '5' || next == '5' && (rest != "" || (keep[n-1]-'0')%2 == 1) {
+		i := n - 1
+		for ; i >= 0 && keep[i] == '9'; i-- {
+			keep[i] = '0'
+		}
+		if i < 0 {
+			keep = append([]byte{'1'}, keep[:n-1]...)
+			exp++
+		} else {
+			keep[i]++
+		}
+	}
+	res := strings.TrimRight(string(keep), "0")
+	if res == "" {
+		res = "0"
+	}
+	return res, exp
+}
+
+// CompactOptions specifies how [Amount.FormatCompact] abbreviates amounts.
+// The zero value corresponds to 2 significant digits, a decimal point,
+// and the suffixes "K", "M", "B", and "T".
+type CompactOptions struct {
+	// Digits is the number of significant digits of the abbreviated value.
+	// The zero value means 2.
+	Digits int
+	// DecimalMark is the character separating the integer and the fractional
+	// parts. The zero value means '.'.
+	DecimalMark rune
+	// Suffixes are the abbreviations for thousands, millions, billions,
+	// and trillions, for example, {" k", " M", " Mrd", " Bio"} for German.
+	// The zero value means {"K", "M", "B", "T"}.
+	Suffixes [4]string
+}
+
+// FormatCompact returns a compact human-readable representation of the amount,
+// such as "USD 1.2M" or "JPY 980K", suitable for dashboards where
+// full precision is noise.
+// The abbreviated value is rounded to the specified number of significant digits
+// using [rounding half to even] (banker's rounding), and trailing zeros are removed.
+// Integer digits are never discarded, so values above the largest suffix may
+// have more significant digits than requested, for example, "USD 12346T".
+// Amounts with an absolute value less than 1000 are not abbreviated and are
+// rounded to the scale of the currency instead.
+// See also method [Amount.Format].
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (a Amount) FormatCompact(opts CompactOptions) string {
+	digits := opts.Digits
+	if digits <= 0 {
+		digits = 2
+	}
+	mark := opts.DecimalMark
+	if mark == 0 {
+		mark = '.'
+	}
+	suffixes := opts.Suffixes
+	if suffixes == [4]string{} {
+		suffixes = [4]string{"K", "M", "B", "T"}
+	}
+
+	c, d := a.Curr(), a.Decimal()
+	suffix := ""
+	if intdigs := d.Prec() - d.Scale(); intdigs > 3 {
+		k := min((intdigs-1)/3, len(suffixes))
+		m, err := compactValue(d, k, digits)
+		// Rounding may produce a value like 1000K
+		if err == nil && k < len(suffixes) && m.Abs().Cmp(decimal.Thousand) >= 0 {
+			k++
+			m, err = compactValue(d, k, digits)
+		}
+		if err == nil {
+			d, suffix = m, suffixes[k-1]
+		}
+	}
+	if suffix == "" {
+		d = d.Round(c.Scale())
+	}
+
+	s := d.String()
+	if mark != '.' {
+		s = strings.Replace(s, ".", string(mark), 1)
+	}
+	return c.Code() + " " + s + suffix
+}
+
+// compactValue returns d / 1000^k rounded to the specified number of
+// significant digits with trailing zeros removed.
+func compactValue(d decimal.Decimal, k, digits int) (decimal.Decimal, error) {
+	p, err := decimal.New(int64(math.Pow10(3*k)), 0)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	m, err := d.Quo(p)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	intdigs := max(m.Prec()-m.Scale(), 1)
+	return m.Round(max(digits-intdigs, 0)).Trim(0), nil
+}
@@ -1,9 +1,14 @@
 package money
 
 import (
+	"database/sql"
+	"database/sql/driver"
 	"fmt"
+	"io"
+	"log/slog"
 	"math"
 	"reflect"
+	"strings"
 	"testing"
 	"unsafe"
 
@@ -37,6 +42,24 @@ func TestAmount_Interfaces(t *testing.T) {
 	if !ok {
 		t.Errorf("%T does not implement fmt.Formatter", i)
 	}
+	_, ok = i.(slog.LogValuer)
+	if !ok {
+		t.Errorf("%T does not implement slog.LogValuer", i)
+	}
+	_, ok = i.(driver.Valuer)
+	if !ok {
+		t.Errorf("%T does not implement driver.Valuer", i)
+	}
+	_, ok = i.(gormDataTyper)
+	if !ok {
+		t.Errorf("%T does not implement schema.GormDataTypeInterface", i)
+	}
+
+	i = &Amount{}
+	_, ok = i.(sql.Scanner)
+	if !ok {
+		t.Errorf("%T does not implement sql.Scanner", i)
+	}
 }
 
 func TestNewAmount(t *testing.T) {
@@ -404,6 +427,81 @@ func TestParseAmount(t *testing.T) {
 	})
 }
 
+func TestParseAmountRound(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, a string
+			scale   int
+			mode    RoundingMode
+			want    string
+		}{
+			{"USD", "1.005", 2, HalfEven, "1.00"},
+			{"USD", "1.005", 2, HalfUp, "1.01"},
+			{"USD", "1.001", 2, Up, "1.01"},
+			{"USD", "-1.001", 2, Up, "-1.01"},
+			{"USD", "1.009", 2, Down, "1.00"},
+			{"USD", "-1.009", 2, Down, "-1.00"},
+			{"USD", "-1.001", 2, Ceiling, "-1.00"},
+			{"USD", "-1.001", 2, Floor, "-1.01"},
+			{"USD", "1.000", 2, Unnecessary, "1.00"},
+			{"USD", "1.010", 2, Unnecessary, "1.01"},
+			{"USD", "1", 2, Unnecessary, "1.00"},
+			{"USD", "1.23456", 4, Down, "1.2345"},
+			{"USD", "1.23450", 4, Unnecessary, "1.2345"},
+			{"USD", "1.99", 0, Down, "1.00"},
+			{"JPY", "1.5", 0, HalfUp, "2"},
+			{"JPY", "2.5", 0, HalfEven, "2"},
+			{"USD", "1.00000000000000000001", 2, Up, "1.01"},
+			{"USD", "-1.00000000000000000001", 2, Floor, "-1.01"},
+			{"USD", "0.12499999999999999999", 2, HalfUp, "0.12"},
+			{"USD", "0.12500000000000000000", 2, HalfUp, "0.13"},
+			{"USD", "0.12500000000000000001", 2, HalfEven, "0.13"},
+			{"USD", "1.00000000000000000000", 2, Unnecessary, "1.00"},
+			{"USD", "1.5e-1", 2, Unnecessary, "0.15"},
+			{"USD", "-0.001", 2, Down, "0.00"},
+			{"USD", "+.5", 2, Unnecessary, "0.50"},
+			{"USD", "5.", 2, Unnecessary, "5.00"},
+		}
+		for _, tt := range tests {
+			got, err := ParseAmountRound(tt.curr, tt.a, tt.scale, tt.mode)
+			if err != nil {
+				t.Errorf("ParseAmountRound(%q, %q, %v, %v) failed: %v", tt.curr, tt.a, tt.scale, tt.mode, err)
+				continue
+			}
+			want := MustParseAmount(tt.curr, tt.want)
+			if got != want {
+				t.Errorf("ParseAmountRound(%q, %q, %v, %v) = %q, want %q", tt.curr, tt.a, tt.scale, tt.mode, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr, a string
+			scale   int
+			mode    RoundingMode
+		}{
+			"currency 1":    {"ZZZ", "1.00", 2, HalfEven},
+			"decimal 1":     {"USD", "abc", 2, HalfEven},
+			"scale range 1": {"USD", "1.00", -1, HalfEven},
+			"scale range 2": {"USD", "1.00", 20, HalfEven},
+			"mode 1":        {"USD", "1.00", 2, RoundingMode(100)},
+			"unnecessary 1": {"USD", "1.001", 2, Unnecessary},
+			"unnecessary 2": {"JPY", "1.5", 0, Unnecessary},
+			"unnecessary 3": {"USD", "0.10000000000000000001", 2, Unnecessary},
+			"overflow 1":    {"USD", "100000000000000000", 2, HalfEven},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := ParseAmountRound(tt.curr, tt.a, tt.scale, tt.mode)
+				if err == nil {
+					t.Errorf("ParseAmountRound(%q, %q, %v, %v) did not fail", tt.curr, tt.a, tt.scale, tt.mode)
+				}
+			})
+		}
+	})
+}
+
 func TestMustParseAmount(t *testing.T) {
 	t.Run("error", func(t *testing.T) {
 		defer func() {
@@ -480,6 +578,127 @@ func TestAmount_MinorUnits(t *testing.T) {
 	}
 }
 
+func TestAmount_Components(t *testing.T) {
+	tests := []struct {
+		curr, a   string
+		wantCoef  uint64
+		wantScale int
+		wantNeg   bool
+	}{
+		{"USD", "0", 0, 2, false},
+		{"USD", "1.23", 123, 2, false},
+		{"USD", "-1.230", 1230, 3, true},
+		{"JPY", "100", 100, 0, false},
+		{"OMR", "9999999999999999.999", 9999999999999999999, 3, false},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.curr, tt.a)
+		gotCoef, gotScale, gotNeg, gotCurr := a.Components()
+		wantCurr := MustParseCurr(tt.curr)
+		if gotCoef != tt.wantCoef || gotScale != tt.wantScale || gotNeg != tt.wantNeg || gotCurr != wantCurr {
+			t.Errorf("%q.Components() = [%v %v %v %v], want [%v %v %v %v]", a, gotCoef, gotScale, gotNeg, gotCurr, tt.wantCoef, tt.wantScale, tt.wantNeg, wantCurr)
+		}
+	}
+}
+
+func TestAmount_Float64Lossy(t *testing.T) {
+	tests := []struct {
+		curr, a   string
+		want      float64
+		wantLossy bool
+	}{
+		{"USD", "0", 0, false},
+		{"USD", "0.01", 0.01, false},
+		{"USD", "-1.23", -1.23, false},
+		{"USD", "1234567.89", 1234567.89, false},
+		{"USD", "12345678901234567.89", 12345678901234567.89, true},
+		{"OMR", "0.1234567890123456789", 0.1234567890123456789, true},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.curr, tt.a)
+		got, gotLossy := a.Float64Lossy()
+		if got != tt.want || gotLossy != tt.wantLossy {
+			t.Errorf("%q.Float64Lossy() = [%v %v], want [%v %v]", a, got, gotLossy, tt.want, tt.wantLossy)
+		}
+	}
+}
+
+func TestAmount_MinorUnitsMode(t *testing.T) {
+	tests := []struct {
+		curr, a   string
+		mode      RoundingMode
+		wantUnits int64
+		wantOk    bool
+	}{
+		{"USD", "1.565", HalfEven, 156, true},
+		{"USD", "1.565", HalfUp, 157, true},
+		{"USD", "1.561", Up, 157, true},
+		{"USD", "1.569", Down, 156, true},
+		{"USD", "-1.561", Ceiling, -156, true},
+		{"USD", "-1.561", Floor, -157, true},
+		{"USD", "1.560", Unnecessary, 156, true},
+		{"USD", "1.561", Unnecessary, 0, false},
+		{"USD", "1.56", RoundingMode(-1), 0, false},
+		{"USD", "92233720368547758.07", Down, 9223372036854775807, true},
+		{"USD", "92233720368547758.08", Down, 0, false},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.curr, tt.a)
+		gotUnits, gotOk := a.MinorUnitsMode(tt.mode)
+		if gotUnits != tt.wantUnits || gotOk != tt.wantOk {
+			t.Errorf("%q.MinorUnitsMode(%v) = [%v %v], want [%v %v]", a, tt.mode, gotUnits, gotOk, tt.wantUnits, tt.wantOk)
+		}
+	}
+}
+
+func TestAmount_MinorUnitsExact(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, a string
+			want    int64
+		}{
+			{"USD", "0", 0},
+			{"USD", "1.56", 156},
+			{"USD", "-1.5600", -156},
+			{"JPY", "100.000", 100},
+			{"OMR", "1.5", 1500},
+			{"USD", "-92233720368547758.08", -9223372036854775808},
+			{"USD", "92233720368547758.07", 9223372036854775807},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.a)
+			got, err := a.MinorUnitsExact()
+			if err != nil {
+				t.Errorf("%q.MinorUnitsExact() failed: %v", a, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%q.MinorUnitsExact() = %v, want %v", a, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr, a string
+		}{
+			"rounding 1": {"USD", "1.561"},
+			"rounding 2": {"JPY", "0.5"},
+			"overflow 1": {"USD", "92233720368547758.08"},
+			"overflow 2": {"USD", "-92233720368547758.09"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				a := MustParseAmount(tt.curr, tt.a)
+				_, err := a.MinorUnitsExact()
+				if err == nil {
+					t.Errorf("%q.MinorUnitsExact() did not fail", a)
+				}
+			})
+		}
+	})
+}
+
 func TestAmount_SameScaleAsCurr(t *testing.T) {
 	tests := []struct {
 		curr, a string
@@ -567,6 +786,28 @@ func TestAmount_Add(t *testing.T) {
 	})
 }
 
+func TestAmount_CanAdd(t *testing.T) {
+	tests := []struct {
+		curra, a, currb, b string
+		want               bool
+	}{
+		{"USD", "1", "USD", "1", true},
+		{"USD", "99999999999999999.99", "USD", "-0.01", true},
+		{"USD", "99999999999999999.99", "USD", "0.004", true},
+		{"USD", "1", "EUR", "1", false},
+		{"USD", "99999999999999999.99", "USD", "0.01", false},
+		{"USD", "-99999999999999999.99", "USD", "-0.006", false},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.curra, tt.a)
+		b := MustParseAmount(tt.currb, tt.b)
+		got := a.CanAdd(b)
+		if got != tt.want {
+			t.Errorf("%q.CanAdd(%q) = %v, want %v", a, b, got, tt.want)
+		}
+	}
+}
+
 func TestAmount_Sub(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -766,6 +1007,53 @@ func TestAmount_FMA(t *testing.T) {
 	})
 }
 
+func TestAmount_AddConverted(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			a, base, quote, rate, b, want string
+		}{
+			{"10.00", "EUR", "USD", "1.2", "5", "16.0000"},
+			{"0.01", "EUR", "USD", "1.1", "0.005", "0.01550"},
+			{"-10", "EUR", "USD", "1.25", "8", "0.0000"},
+			{"1000000000.00", "EUR", "USD", "1.1", "1.23456789012345678", "1000000001.358024679"},
+			{"0", "USD", "USD", "1", "0.01", "0.0100"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.quote, tt.a)
+			r := MustParseExchRate(tt.base, tt.quote, tt.rate)
+			b := MustParseAmount(tt.base, tt.b)
+			got, err := a.AddConverted(b, r)
+			if err != nil {
+				t.Errorf("%q.AddConverted(%q, %q) failed: %v", a, b, r, err)
+				continue
+			}
+			want := MustParseAmount(tt.quote, tt.want)
+			if got != want {
+				t.Errorf("%q.AddConverted(%q, %q) = %q, want %q", a, b, r, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curra, a, base, quote, rate, currb, b string
+		}{
+			"currency 1": {"USD", "1", "EUR", "USD", "1.2", "JPY", "1"},
+			"currency 2": {"JPY", "1", "EUR", "USD", "1.2", "EUR", "1"},
+			"overflow 1": {"USD", "99999999999999999", "EUR", "USD", "1.2", "EUR", "1"},
+		}
+		for name, tt := range tests {
+			a := MustParseAmount(tt.curra, tt.a)
+			r := MustParseExchRate(tt.base, tt.quote, tt.rate)
+			b := MustParseAmount(tt.currb, tt.b)
+			_, err := a.AddConverted(b, r)
+			if err == nil {
+				t.Errorf("%q.AddConverted(%q, %q) did not fail: %v", a, b, r, name)
+			}
+		}
+	})
+}
+
 func TestAmount_Rat(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -939,6 +1227,58 @@ func TestAmount_QuoRem(t *testing.T) {
 	})
 }
 
+func TestAmount_DivMod(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, a, b, wantCount, wantRem string
+		}{
+			{"USD", "0", "1", "0", "0.00"},
+			{"USD", "3.10", "0.25", "12", "0.10"},
+			{"USD", "3.00", "0.25", "12", "0.00"},
+			{"USD", "0.24", "0.25", "0", "0.24"},
+			{"USD", "3.10", "-0.25", "-12", "0.10"},
+			{"USD", "-3.10", "0.25", "-12", "-0.10"},
+			{"USD", "-3.10", "-0.25", "12", "-0.10"},
+			{"USD", "1", "0.003", "333", "0.001"},
+			{"JPY", "1000", "300", "3", "100"},
+			{"OMR", "1", "0.3", "3", "0.100"},
+			{"USD", "99999999999999999.99", "0.01", "9999999999999999999", "0.00"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.a)
+			b := MustParseAmount(tt.curr, tt.b)
+			gotCount, gotRem, err := a.DivMod(b)
+			if err != nil {
+				t.Errorf("%q.DivMod(%q) failed: %v", a, b, err)
+				continue
+			}
+			wantCount := decimal.MustParse(tt.wantCount)
+			wantRem := MustParseAmount(tt.curr, tt.wantRem)
+			if gotCount != wantCount || gotRem != wantRem {
+				t.Errorf("%q.DivMod(%q) = [%q %q], want [%q %q]", a, b, gotCount, gotRem, wantCount, wantRem)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curra, a, currb, b string
+		}{
+			"currency 1": {"USD", "1", "EUR", "1"},
+			"zero 1":     {"USD", "1", "USD", "0"},
+			"overflow 1": {"USD", "99999999999999999", "USD", "0.001"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.curra, tt.a)
+			b := MustParseAmount(tt.currb, tt.b)
+			_, _, err := a.DivMod(b)
+			if err == nil {
+				t.Errorf("%q.DivMod(%q) did not fail", a, b)
+			}
+		}
+	})
+}
+
 func TestAmount_Mul(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -994,6 +1334,27 @@ func TestAmount_Mul(t *testing.T) {
 	})
 }
 
+func TestAmount_CanMul(t *testing.T) {
+	tests := []struct {
+		curr, a, e string
+		want       bool
+	}{
+		{"USD", "1.20", "2", true},
+		{"USD", "5.09", "7.1", true},
+		{"USD", "10000000000", "100000", true},
+		{"USD", "10000000000", "1000000000", false},
+		{"USD", "10000000000000000", "1000", false},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.curr, tt.a)
+		e := decimal.MustParse(tt.e)
+		got := a.CanMul(e)
+		if got != tt.want {
+			t.Errorf("%q.CanMul(%q) = %v, want %v", a, e, got, tt.want)
+		}
+	}
+}
+
 func TestAmount_Split(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -1054,61 +1415,633 @@ func TestAmount_Split(t *testing.T) {
 	})
 }
 
-func TestAmount_String(t *testing.T) {
-	tests := []struct {
-		curr, a, want string
-	}{
-		// Zeros
-		{"JPY", "0", "JPY 0"},
-		{"JPY", "0.0", "JPY 0.0"},
-		{"USD", "0", "USD 0.00"},
-		{"USD", "0.0", "USD 0.00"},
-		{"USD", "0.00", "USD 0.00"},
-		{"USD", "0.000", "USD 0.000"},
-		{"OMR", "0", "OMR 0.000"},
-		{"OMR", "0.0", "OMR 0.000"},
-		{"OMR", "0.00", "OMR 0.000"},
-		{"OMR", "0.000", "OMR 0.000"},
-		{"OMR", "0.0000", "OMR 0.0000"},
+func TestAmount_SpreadOver(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, a string
+			parts   int
+			want    []string
+		}{
+			{"JPY", "0", 3, []string{"0", "0", "0"}},
+			{"JPY", "1000", 7, []string{"143", "143", "143", "143", "143", "143", "142"}},
+			{"USD", "1.01", 1, []string{"1.01"}},
+			{"USD", "1.01", 3, []string{"0.34", "0.34", "0.33"}},
+			{"USD", "1.00", 4, []string{"0.25", "0.25", "0.25", "0.25"}},
+			{"USD", "0.06", 4, []string{"0.02", "0.02", "0.02", "0.00"}},
+			{"USD", "100", 3, []string{"33.34", "33.34", "33.32"}},
+			{"USD", "-100", 3, []string{"-33.34", "-33.34", "-33.32"}},
+			{"USD", "100.000", 3, []string{"33.334", "33.334", "33.332"}},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.a)
+			got, err := a.SpreadOver(tt.parts)
+			if err != nil {
+				t.Errorf("%q.SpreadOver(%v) failed: %v", a, tt.parts, err)
+				continue
+			}
+			want := MustParseAmountSlice(tt.curr, tt.want)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%q.SpreadOver(%v) = %v, want %v", a, tt.parts, got, want)
+			}
+		}
+	})
 
-		// Negative
-		{"USD", "-1", "USD -1.00"},
-		{"USD", "1", "USD 1.00"},
-	}
-	for _, tt := range tests {
-		a := MustParseAmount(tt.curr, tt.a)
-		got := a.String()
-		if got != tt.want {
-			t.Errorf("%q.String() = %q, want %q", a, got, tt.want)
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr, a string
+			parts   int
+		}{
+			"parts 1": {"USD", "1", 0},
+			"parts 2": {"USD", "1", -1},
+			"small 1": {"USD", "0.05", 4},
+			"small 2": {"USD", "-0.05", 4},
+			"small 3": {"JPY", "1", 3},
 		}
-	}
+		for name, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.a)
+			_, err := a.SpreadOver(tt.parts)
+			if err == nil {
+				t.Errorf("%q.SpreadOver(%v) did not fail: %v", a, tt.parts, name)
+			}
+		}
+	})
 }
 
-func TestAmount_Format(t *testing.T) {
-	tests := []struct {
-		curr, a, format, want string
-	}{
-		// %T verb
-		{"USD", "100.00", "%T", "money.Amount"},
-		// %q verb
-		{"USD", "100.00", "%q", "\"USD 100.00\""},
-		{"USD", "100.00", "%+q", "\"USD +100.00\""},
-		{"USD", "100.00", "% q", "\"USD  100.00\""},
-		{"USD", "100.00", "%.6q", "\"USD 100.00\""}, // precision is ignored
-		{"USD", "100.00", "%10q", "\"USD 100.00\""},
-		{"USD", "100.00", "%11q", "\"USD 100.00\""},
-		{"USD", "100.00", "%12q", "\"USD 100.00\""},
-		{"USD", "100.00", "%13q", " \"USD 100.00\""},
-		{"USD", "100.00", "%013q", "\"USD 0100.00\""},
-		{"USD", "100.00", "%+13q", "\"USD +100.00\""},
-		{"USD", "100.00", "%-13q", "\"USD 100.00\" "},
-		{"USD", "100.00", "%+-015q", "\"USD +100.00\"  "}, // '0' is ignored
-		// %s verb
-		{"USD", "100.00", "%s", "USD 100.00"},
-		{"USD", "100.00", "%+s", "USD +100.00"},
-		{"USD", "100.00", "% s", "USD  100.00"},
-		{"USD", "100.00", "%.6s", "USD 100.00"}, // precision is ignored
-		{"USD", "100.00", "%10s", "USD 100.00"},
+func TestAmount_SplitCash(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, a   string
+			parts     int
+			increment string
+			want      []string
+		}{
+			{"CHF", "100", 1, "0.05", []string{"100.00"}},
+			{"CHF", "100", 3, "0.05", []string{"33.35", "33.35", "33.30"}},
+			{"CHF", "-100", 3, "0.05", []string{"-33.35", "-33.35", "-33.30"}},
+			{"CHF", "0.10", 3, "0.05", []string{"0.05", "0.05", "0.00"}},
+			{"CHF", "0", 2, "0.05", []string{"0.00", "0.00"}},
+			{"USD", "10", 4, "1", []string{"3.00", "3.00", "2.00", "2.00"}},
+			{"USD", "10.000", 4, "0.01", []string{"2.500", "2.500", "2.500", "2.500"}},
+			{"JPY", "1000", 3, "10", []string{"340", "330", "330"}},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.a)
+			inc := MustParseAmount(tt.curr, tt.increment)
+			got, err := a.SplitCash(tt.parts, inc)
+			if err != nil {
+				t.Errorf("%q.SplitCash(%v, %q) failed: %v", a, tt.parts, inc, err)
+				continue
+			}
+			want := MustParseAmountSlice(tt.curr, tt.want)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%q.SplitCash(%v, %q) = %v, want %v", a, tt.parts, inc, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr, a          string
+			parts            int
+			curri, increment string
+		}{
+			"parts 1":     {"CHF", "1", 0, "CHF", "0.05"},
+			"increment 1": {"CHF", "1", 2, "CHF", "0"},
+			"increment 2": {"CHF", "1", 2, "CHF", "-0.05"},
+			"currency 1":  {"CHF", "1", 2, "EUR", "0.05"},
+			"multiple 1":  {"CHF", "100.03", 3, "CHF", "0.05"},
+		}
+		for name, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.a)
+			inc := MustParseAmount(tt.curri, tt.increment)
+			_, err := a.SplitCash(tt.parts, inc)
+			if err == nil {
+				t.Errorf("%q.SplitCash(%v, %q) did not fail: %v", a, tt.parts, inc, name)
+			}
+		}
+	})
+}
+
+func TestAmount_SplitMin(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, a string
+			parts   int
+			min     string
+			want    []string
+		}{
+			{"USD", "1.01", 3, "0", []string{"0.34", "0.34", "0.33"}},
+			{"USD", "100", 3, "30", []string{"33.34", "33.33", "33.33"}},
+			{"USD", "100", 5, "30", []string{"33.34", "33.33", "33.33"}},
+			{"USD", "100", 12, "25", []string{"25.00", "25.00", "25.00", "25.00"}},
+			{"USD", "1.00", 3, "0.34", []string{"0.50", "0.50"}},
+			{"USD", "1.00", 3, "0.333", []string{"0.50", "0.50"}},
+			{"USD", "1.00", 3, "1", []string{"1.00"}},
+			{"JPY", "1000", 4, "300", []string{"334", "333", "333"}},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.a)
+			min := MustParseAmount(tt.curr, tt.min)
+			got, err := a.SplitMin(tt.parts, min)
+			if err != nil {
+				t.Errorf("%q.SplitMin(%v, %q) failed: %v", a, tt.parts, min, err)
+				continue
+			}
+			want := MustParseAmountSlice(tt.curr, tt.want)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%q.SplitMin(%v, %q) = %v, want %v", a, tt.parts, min, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr, a      string
+			parts        int
+			currmin, min string
+		}{
+			"parts 1":    {"USD", "1", 0, "USD", "0.5"},
+			"parts 2":    {"USD", "1", -1, "USD", "0.5"},
+			"currency 1": {"USD", "1", 2, "EUR", "0.5"},
+			"minimum 1":  {"USD", "1", 2, "USD", "-0.5"},
+			"minimum 2":  {"USD", "1", 2, "USD", "1.01"},
+			"minimum 3":  {"USD", "-1", 2, "USD", "0"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				a := MustParseAmount(tt.curr, tt.a)
+				min := MustParseAmount(tt.currmin, tt.min)
+				_, err := a.SplitMin(tt.parts, min)
+				if err == nil {
+					t.Errorf("%q.SplitMin(%v, %q) did not fail", a, tt.parts, min)
+				}
+			})
+		}
+	})
+}
+
+func TestAmount_SplitWeighted(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, a string
+			weights []string
+			want    []string
+		}{
+			{"USD", "100", []string{"1"}, []string{"100.00"}},
+			{"USD", "100", []string{"1", "1"}, []string{"50.00", "50.00"}},
+			{"USD", "100", []string{"1", "1", "1"}, []string{"33.34", "33.33", "33.33"}},
+			{"USD", "100", []string{"0", "1", "1", "1"}, []string{"0.00", "33.34", "33.33", "33.33"}},
+			{"USD", "100", []string{"200", "300", "500"}, []string{"20.00", "30.00", "50.00"}},
+			{"USD", "10", []string{"0.01", "0.01", "99.98"}, []string{"0.01", "0.00", "9.99"}},
+			{"USD", "1.01", []string{"1", "2"}, []string{"0.34", "0.67"}},
+			{"USD", "-1.01", []string{"1", "2"}, []string{"-0.34", "-0.67"}},
+			{"USD", "0", []string{"1", "2"}, []string{"0.00", "0.00"}},
+			{"USD", "1.000", []string{"1", "1", "1"}, []string{"0.334", "0.333", "0.333"}},
+			{"JPY", "1000", []string{"150.5", "250.25", "99.25"}, []string{"302", "500", "198"}},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.a)
+			weights := MustParseAmountSlice(tt.curr, tt.weights)
+			got, err := a.SplitWeighted(weights)
+			if err != nil {
+				t.Errorf("%q.SplitWeighted(%v) failed: %v", a, weights, err)
+				continue
+			}
+			want := MustParseAmountSlice(tt.curr, tt.want)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%q.SplitWeighted(%v) = %v, want %v", a, weights, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr, a string
+			weights []Amount
+		}{
+			"no weights": {"USD", "1", nil},
+			"currency 1": {"USD", "1", []Amount{MustParseAmount("EUR", "1")}},
+			"weights 1":  {"USD", "1", MustParseAmountSlice("USD", []string{"1", "-1"})},
+			"weights 2":  {"USD", "1", MustParseAmountSlice("USD", []string{"0", "0"})},
+		}
+		for name, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.a)
+			_, err := a.SplitWeighted(tt.weights)
+			if err == nil {
+				t.Errorf("%q.SplitWeighted(%v) did not fail: %v", a, tt.weights, name)
+			}
+		}
+	})
+}
+
+func TestAmount_String(t *testing.T) {
+	tests := []struct {
+		curr, a, want string
+	}{
+		// Zeros
+		{"JPY", "0", "JPY 0"},
+		{"JPY", "0.0", "JPY 0.0"},
+		{"USD", "0", "USD 0.00"},
+		{"USD", "0.0", "USD 0.00"},
+		{"USD", "0.00", "USD 0.00"},
+		{"USD", "0.000", "USD 0.000"},
+		{"OMR", "0", "OMR 0.000"},
+		{"OMR", "0.0", "OMR 0.000"},
+		{"OMR", "0.00", "OMR 0.000"},
+		{"OMR", "0.000", "OMR 0.000"},
+		{"OMR", "0.0000", "OMR 0.0000"},
+
+		// Negative
+		{"USD", "-1", "USD -1.00"},
+		{"USD", "1", "USD 1.00"},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.curr, tt.a)
+		got := a.String()
+		if got != tt.want {
+			t.Errorf("%q.String() = %q, want %q", a, got, tt.want)
+		}
+	}
+}
+
+func TestAmount_LogValue(t *testing.T) {
+	tests := []struct {
+		curr, a, want string
+	}{
+		{"JPY", "0", "[currency=JPY value=0 scale=0]"},
+		{"USD", "1.23", "[currency=USD value=1.23 scale=2]"},
+		{"USD", "-0.0001", "[currency=USD value=-0.0001 scale=4]"},
+		{"OMR", "5", "[currency=OMR value=5.000 scale=3]"},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.curr, tt.a)
+		got := a.LogValue().String()
+		if got != tt.want {
+			t.Errorf("%q.LogValue() = %q, want %q", a, got, tt.want)
+		}
+	}
+}
+
+func TestAmount_Scan(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			value any
+			want  string
+		}{
+			{"USD 5.67", "USD 5.67"},
+			{[]byte("USD 5.67"), "USD 5.67"},
+			{"JPY 5", "JPY 5"},
+			{"OMR -5.6", "OMR -5.600"},
+			{"(USD,5.67)", "USD 5.67"},
+			{[]byte("(USD,5.67)"), "USD 5.67"},
+			{`("USD","5.670")`, "USD 5.670"},
+			{"( USD , 5 )", "USD 5.00"},
+			{"USD5.67", "USD 5.67"},
+			{"USD-5.67", "USD -5.67"},
+			{"JPY5", "JPY 5"},
+		}
+		for _, tt := range tests {
+			var got Amount
+			err := got.Scan(tt.value)
+			if err != nil {
+				t.Errorf("Scan(%v) failed: %v", tt.value, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("Scan(%v) = %q, want %q", tt.value, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []any{
+			nil,
+			int64(5),
+			5.67,
+			"",
+			"USD",
+			"USD#5.67",
+			"UUU 5.67",
+			"UUU5.67",
+			"USD abc",
+			"(USD,5.67",
+			"(USD 5.67)",
+			"(,5.67)",
+		}
+		for _, value := range tests {
+			var got Amount
+			err := got.Scan(value)
+			if err == nil {
+				t.Errorf("Scan(%v) did not fail", value)
+			}
+		}
+	})
+}
+
+func TestAmount_Value(t *testing.T) {
+	tests := []struct {
+		curr, amount string
+		want         driver.Value
+	}{
+		{"USD", "5.67", "USD 5.67"},
+		{"USD", "5.670", "USD 5.670"},
+		{"JPY", "-5", "JPY -5"},
+		{"OMR", "0", "OMR 0.000"},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.curr, tt.amount)
+		got, err := a.Value()
+		if err != nil {
+			t.Errorf("%q.Value() failed: %v", a, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%q.Value() = %q, want %q", a, got, tt.want)
+		}
+		var b Amount
+		err = b.Scan(got)
+		if err != nil {
+			t.Errorf("Scan(%q) failed: %v", got, err)
+			continue
+		}
+		if b != a {
+			t.Errorf("Scan(%q) = %q, want %q", got, b, a)
+		}
+	}
+	if got := MustParseAmount("USD", "1").GormDataType(); got != "string" {
+		t.Errorf("GormDataType() = %q, want %q", got, "string")
+	}
+}
+
+func TestAmount_MarshalGQL(t *testing.T) {
+	tests := []struct {
+		curr, amount, want string
+	}{
+		{"USD", "5.67", `"USD 5.67"`},
+		{"USD", "5.670", `"USD 5.670"`},
+		{"JPY", "-5", `"JPY -5"`},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.curr, tt.amount)
+		var buf strings.Builder
+		a.MarshalGQL(&buf)
+		got := buf.String()
+		if got != tt.want {
+			t.Errorf("%q.MarshalGQL() = %s, want %s", a, got, tt.want)
+		}
+	}
+}
+
+func TestAmount_UnmarshalGQL(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			v    any
+			want string
+		}{
+			{"USD 5.67", "USD 5.67"},
+			{"USD 5.670", "USD 5.670"},
+			{"usd 5", "USD 5.00"},
+			{"JPY -5", "JPY -5"},
+		}
+		for _, tt := range tests {
+			var got Amount
+			err := got.UnmarshalGQL(tt.v)
+			if err != nil {
+				t.Errorf("UnmarshalGQL(%v) failed: %v", tt.v, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("UnmarshalGQL(%v) = %q, want %q", tt.v, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []any{nil, 5.67, []byte("USD 5.67"), "", "USD", "UUU 5.67", "USD abc"}
+		for _, v := range tests {
+			var got Amount
+			err := got.UnmarshalGQL(v)
+			if err == nil {
+				t.Errorf("UnmarshalGQL(%v) did not fail", v)
+			}
+		}
+	})
+}
+
+func TestScanPair(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, value any
+			want        string
+		}{
+			{"USD", "5.67", "USD 5.67"},
+			{[]byte("usd"), []byte("5.670"), "USD 5.670"},
+			{"JPY", int64(500), "JPY 500"},
+			{"840", 5.67, "USD 5.67"},
+		}
+		for _, tt := range tests {
+			var got Amount
+			curr, value := ScanPair(&got)
+			if err := curr.(sql.Scanner).Scan(tt.curr); err != nil {
+				t.Errorf("ScanPair(%v, %v) failed: %v", tt.curr, tt.value, err)
+				continue
+			}
+			if err := value.(sql.Scanner).Scan(tt.value); err != nil {
+				t.Errorf("ScanPair(%v, %v) failed: %v", tt.curr, tt.value, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("ScanPair(%v, %v) = %q, want %q", tt.curr, tt.value, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("rows", func(t *testing.T) {
+		rows := []struct {
+			curr, value any
+			want        string
+		}{
+			{"JPY", "9999999999999999999", "JPY 9999999999999999999"},
+			{"USD", "5.67", "USD 5.67"},
+			{"OMR", int64(1), "OMR 1.000"},
+		}
+		var got Amount
+		curr, value := ScanPair(&got)
+		for _, tt := range rows {
+			if err := curr.(sql.Scanner).Scan(tt.curr); err != nil {
+				t.Errorf("ScanPair(%v, %v) failed: %v", tt.curr, tt.value, err)
+				continue
+			}
+			if err := value.(sql.Scanner).Scan(tt.value); err != nil {
+				t.Errorf("ScanPair(%v, %v) failed: %v", tt.curr, tt.value, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("ScanPair(%v, %v) = %q, want %q", tt.curr, tt.value, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr, value any
+		}{
+			"currency 1": {"UUU", "5.67"},
+			"currency 2": {nil, "5.67"},
+			"value 1":    {"USD", "abc"},
+			"value 2":    {"USD", nil},
+			"value 3":    {"USD", true},
+			"value 4":    {"USD", math.NaN()},
+		}
+		for name, tt := range tests {
+			var got Amount
+			curr, value := ScanPair(&got)
+			err1 := curr.(sql.Scanner).Scan(tt.curr)
+			err2 := value.(sql.Scanner).Scan(tt.value)
+			if err1 == nil && err2 == nil {
+				t.Errorf("%v: ScanPair(%v, %v) did not fail", name, tt.curr, tt.value)
+			}
+		}
+	})
+}
+
+func TestAmount_Redacted(t *testing.T) {
+	tests := []struct {
+		curr, a, want string
+	}{
+		{"JPY", "0", "JPY **"},
+		{"JPY", "-123456", "JPY **"},
+		{"USD", "0", "USD **.**"},
+		{"USD", "5.678", "USD **.**"},
+		{"USD", "-1000000", "USD **.**"},
+		{"OMR", "1", "OMR **.***"},
+		{"XXX", "1", "XXX **"},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.curr, tt.a)
+		got := a.Redacted()
+		if got != tt.want {
+			t.Errorf("%q.Redacted() = %q, want %q", a, got, tt.want)
+		}
+	}
+}
+
+func TestAmount_DigitAt(t *testing.T) {
+	tests := []struct {
+		curr, a     string
+		wantLeading int
+		wantDigits  []int
+	}{
+		{"USD", "0", 0, []int{0, 0, 0}},
+		{"USD", "-0.0345", 3, []int{3, 4, 5, 0}},
+		{"USD", "5", 5, []int{5, 0, 0, 0}},
+		{"JPY", "5", 5, []int{5, 0}},
+		{"USD", "123.45", 1, []int{1, 2, 3, 4, 5, 0}},
+		{"JPY", "9999999999999999999", 9, []int{9, 9, 9}},
+		{"USD", "0.0000000000000000001", 1, []int{1, 0}},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.curr, tt.a)
+		got := a.LeadingDigit()
+		if got != tt.wantLeading {
+			t.Errorf("%q.LeadingDigit() = %v, want %v", a, got, tt.wantLeading)
+		}
+		for i, want := range tt.wantDigits {
+			got := a.DigitAt(i)
+			if got != want {
+				t.Errorf("%q.DigitAt(%v) = %v, want %v", a, i, got, want)
+			}
+		}
+		if got := a.DigitAt(-1); got != 0 {
+			t.Errorf("%q.DigitAt(-1) = %v, want 0", a, got)
+		}
+		if got := a.DigitAt(19); got != 0 {
+			t.Errorf("%q.DigitAt(19) = %v, want 0", a, got)
+		}
+	}
+}
+
+func TestAmount_Normalize(t *testing.T) {
+	tests := []struct {
+		curr, a, want string
+	}{
+		{"JPY", "0", "0"},
+		{"JPY", "-0", "0"},
+		{"JPY", "-0.000", "0"},
+		{"JPY", "5.600", "5.6"},
+		{"USD", "0", "0.00"},
+		{"USD", "-0.000", "0.00"},
+		{"USD", "5.6", "5.60"},
+		{"USD", "5.600", "5.60"},
+		{"USD", "5.601", "5.601"},
+		{"USD", "-5.600", "-5.60"},
+		{"OMR", "-0.00000", "0.000"},
+		{"OMR", "5.60000", "5.600"},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.curr, tt.a)
+		got := a.Normalize()
+		want := MustParseAmount(tt.curr, tt.want)
+		if got != want {
+			t.Errorf("%q.Normalize() = %q, want %q", a, got, want)
+		}
+		if got.IsZero() && got.IsNeg() {
+			t.Errorf("%q.Normalize() = %q, want non-negative zero", a, got)
+		}
+	}
+}
+
+func TestAmount_CanonicalString(t *testing.T) {
+	tests := []struct {
+		curr, a, want string
+	}{
+		{"JPY", "0", "JPY 0"},
+		{"JPY", "0.000", "JPY 0"},
+		{"JPY", "5.600", "JPY 5.6"},
+		{"USD", "0", "USD 0.00"},
+		{"USD", "0.000", "USD 0.00"},
+		{"USD", "5.6", "USD 5.60"},
+		{"USD", "5.60", "USD 5.60"},
+		{"USD", "5.600", "USD 5.60"},
+		{"USD", "5.601", "USD 5.601"},
+		{"USD", "-5.600", "USD -5.60"},
+		{"OMR", "5.6", "OMR 5.600"},
+		{"OMR", "5.60000", "OMR 5.600"},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.curr, tt.a)
+		got := a.CanonicalString()
+		if got != tt.want {
+			t.Errorf("%q.CanonicalString() = %q, want %q", a, got, tt.want)
+		}
+	}
+}
+
+func TestAmount_Format(t *testing.T) {
+	tests := []struct {
+		curr, a, format, want string
+	}{
+		// %T verb
+		{"USD", "100.00", "%T", "money.Amount"},
+		// %q verb
+		{"USD", "100.00", "%q", "\"USD 100.00\""},
+		{"USD", "100.00", "%+q", "\"USD +100.00\""},
+		{"USD", "100.00", "% q", "\"USD  100.00\""},
+		{"USD", "100.00", "%.6q", "\"USD 100.00\""}, // precision is ignored
+		{"USD", "100.00", "%10q", "\"USD 100.00\""},
+		{"USD", "100.00", "%11q", "\"USD 100.00\""},
+		{"USD", "100.00", "%12q", "\"USD 100.00\""},
+		{"USD", "100.00", "%13q", " \"USD 100.00\""},
+		{"USD", "100.00", "%013q", "\"USD 0100.00\""},
+		{"USD", "100.00", "%+13q", "\"USD +100.00\""},
+		{"USD", "100.00", "%-13q", "\"USD 100.00\" "},
+		{"USD", "100.00", "%+-015q", "\"USD +100.00\"  "}, // '0' is ignored
+		// %s verb
+		{"USD", "100.00", "%s", "USD 100.00"},
+		{"USD", "100.00", "%+s", "USD +100.00"},
+		{"USD", "100.00", "% s", "USD  100.00"},
+		{"USD", "100.00", "%.6s", "USD 100.00"}, // precision is ignored
+		{"USD", "100.00", "%10s", "USD 100.00"},
 		{"USD", "100.00", "%11s", " USD 100.00"},
 		{"USD", "100.00", "%12s", "  USD 100.00"},
 		{"USD", "100.00", "%13s", "   USD 100.00"},
@@ -1116,6 +2049,18 @@ func TestAmount_Format(t *testing.T) {
 		{"USD", "100.00", "%+13s", "  USD +100.00"},
 		{"USD", "100.00", "%-13s", "USD 100.00   "},
 		{"USD", "100.00", "%+-015s", "USD +100.00    "}, // '0' is ignored
+		// %m verb
+		{"USD", "100.00", "%m", "USD100.00"},
+		{"USD", "-100.00", "%m", "USD-100.00"},
+		{"USD", "100.00", "% m", "USD100.00"},
+		{"USD", "-100.00", "% m", "USD-100.00"},
+		{"USD", "100.00", "%+m", "USD+100.00"},
+		{"USD", "100.00", "%.6m", "USD100.00"}, // precision is ignored
+		{"USD", "100.00", "%11m", "  USD100.00"},
+		{"USD", "100.00", "%011m", "USD00100.00"},
+		{"USD", "100.00", "%-11m", "USD100.00  "},
+		{"USD", "100.00", "%#m", "USD***.**"},
+		{"JPY", "5", "%m", "JPY5"},
 		// %v verb
 		{"USD", "100.00", "%v", "USD 100.00"},
 		{"USD", "100.00", "%+v", "USD +100.00"},
@@ -1180,6 +2125,13 @@ func TestAmount_Format(t *testing.T) {
 		{"USD", "100.00", "%010d", "0000010000"},
 		{"USD", "100.00", "%+10d", "    +10000"},
 		{"USD", "100.00", "%-10d", "10000     "},
+		// '#' flag
+		{"USD", "5.678", "%#v", "USD *.***"},
+		{"USD", "-5.678", "%#s", "USD -*.***"},
+		{"USD", "100.00", "%#q", "\"USD ***.**\""},
+		{"USD", "100.00", "%#f", "***.**"},
+		{"USD", "100.00", "%#d", "*****"},
+		{"USD", "100.00", "%#012v", "USD *****.**"},
 		// %c verb
 		{"USD", "100.00", "%c", "USD"},
 		{"USD", "100.00", "%+c", "USD"}, // '+' is ignored
@@ -1192,10 +2144,51 @@ func TestAmount_Format(t *testing.T) {
 		{"USD", "100.00", "%-#5c", "USD  "}, // '#' is ignored
 		// wrong verbs
 		{"USD", "12.34", "%b", "%!b(money.Amount=USD 12.34)"},
-		{"USD", "12.34", "%e", "%!e(money.Amount=USD 12.34)"},
-		{"USD", "12.34", "%E", "%!E(money.Amount=USD 12.34)"},
-		{"USD", "12.34", "%g", "%!g(money.Amount=USD 12.34)"},
-		{"USD", "12.34", "%G", "%!G(money.Amount=USD 12.34)"},
+		// %e verb
+		{"USD", "12.34", "%e", "1.234000e+01"},
+		{"USD", "12.34", "%E", "1.234000E+01"},
+		{"USD", "12.34", "%.2e", "1.23e+01"},
+		{"USD", "12.35", "%.2e", "1.24e+01"},
+		{"USD", "12.25", "%.2e", "1.22e+01"},
+		{"USD", "12.251", "%.2e", "1.23e+01"},
+		{"USD", "99.99", "%.2e", "1.00e+02"},
+		{"USD", "12.34", "%.0e", "1e+01"},
+		{"USD", "-12.34", "%e", "-1.234000e+01"},
+		{"USD", "12.34", "%+e", "+1.234000e+01"},
+		{"USD", "12.34", "% e", " 1.234000e+01"},
+		{"USD", "12.34", "%14e", "  1.234000e+01"},
+		{"USD", "-12.34", "%015e", "-001.234000e+01"},
+		{"USD", "12.34", "%-14e|", "1.234000e+01  |"},
+		{"USD", "12.34", "%#e", "*.******e+**"},
+		{"USD", "0", "%e", "0.000000e+00"},
+		{"USD", "0.01", "%e", "1.000000e-02"},
+		{"USD", "99999999999999999.99", "%e", "1.000000e+17"},
+		{"USD", "99999999999999999.99", "%.20e", "9.99999999999999999900e+16"},
+		{"USD", "0.0000000000000000001", "%e", "1.000000e-19"},
+		// %g verb
+		{"USD", "12.34", "%g", "12.34"},
+		{"USD", "12.34", "%G", "12.34"},
+		{"USD", "12.30", "%g", "12.3"},
+		{"USD", "0", "%g", "0"},
+		{"USD", "0.0001", "%g", "0.0001"},
+		{"USD", "0.00001", "%g", "1e-05"},
+		{"USD", "0.00001", "%G", "1E-05"},
+		{"USD", "100000", "%g", "100000"},
+		{"USD", "1000000", "%g", "1e+06"},
+		{"USD", "1234567", "%g", "1.234567e+06"},
+		{"USD", "12.34", "%.3g", "12.3"},
+		{"USD", "12.35", "%.3g", "12.4"},
+		{"USD", "12.34", "%.1g", "1e+01"},
+		{"USD", "12.34", "%.0g", "1e+01"},
+		{"USD", "100", "%.3g", "100"},
+		{"USD", "1000", "%.3g", "1e+03"},
+		{"USD", "-12.34", "%+g", "-12.34"},
+		{"USD", "12.34", "%+g", "+12.34"},
+		{"USD", "12.34", "%8g", "   12.34"},
+		{"USD", "12.34", "%-8g|", "12.34   |"},
+		{"USD", "-12.34", "%08g", "-0012.34"},
+		{"USD", "99999999999999999.99", "%g", "9.999999999999999999e+16"},
+		{"USD", "99999999999999999.99", "%.19g", "99999999999999999.99"},
 		{"USD", "12.34", "%x", "%!x(money.Amount=USD 12.34)"},
 		{"USD", "12.34", "%X", "%!X(money.Amount=USD 12.34)"},
 	}
@@ -1406,6 +2399,186 @@ func TestAmount_CmpTotal(t *testing.T) {
 	})
 }
 
+func TestAmount_EqualWithin(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, a, b, tol string
+			want            bool
+		}{
+			{"USD", "1.00", "1.00", "0.00", true},
+			{"USD", "1.00", "1.01", "0.00", false},
+			{"USD", "1.00", "1.01", "0.01", true},
+			{"USD", "1.01", "1.00", "0.01", true},
+			{"USD", "1.00", "1.02", "0.01", false},
+			{"USD", "-1.00", "1.00", "2.00", true},
+			{"USD", "-1.00", "1.00", "1.99", false},
+			{"USD", "1.004", "1.00", "0.005", true},
+			{"JPY", "100", "105", "5", true},
+			{"JPY", "100", "106", "5", false},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.a)
+			b := MustParseAmount(tt.curr, tt.b)
+			tol := MustParseAmount(tt.curr, tt.tol)
+			got, err := a.EqualWithin(b, tol)
+			if err != nil {
+				t.Errorf("%q.EqualWithin(%q, %q) failed: %v", a, b, tol, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%q.EqualWithin(%q, %q) = %v, want %v", a, b, tol, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curra, a, currb, b, currtol, tol string
+		}{
+			"currency 1":  {"USD", "1.00", "EUR", "1.00", "USD", "0.01"},
+			"currency 2":  {"USD", "1.00", "USD", "1.00", "EUR", "0.01"},
+			"tolerance 1": {"USD", "1.00", "USD", "1.00", "USD", "-0.01"},
+			"overflow 1":  {"USD", "99999999999999999.99", "USD", "-99999999999999999.99", "USD", "0.01"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				a := MustParseAmount(tt.curra, tt.a)
+				b := MustParseAmount(tt.currb, tt.b)
+				tol := MustParseAmount(tt.currtol, tt.tol)
+				_, err := a.EqualWithin(b, tol)
+				if err == nil {
+					t.Errorf("%q.EqualWithin(%q, %q) did not fail", a, b, tol)
+				}
+			})
+		}
+	})
+}
+
+func TestAmount_IsAtLeast(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, a, b string
+			want       bool
+		}{
+			{"USD", "1.00", "1.00", true},
+			{"USD", "1.00", "1", true},
+			{"USD", "1.01", "1.00", true},
+			{"USD", "0.99", "1.00", false},
+			{"USD", "-1.00", "0", false},
+			{"JPY", "0", "-1", true},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.a)
+			b := MustParseAmount(tt.curr, tt.b)
+			got, err := a.IsAtLeast(b)
+			if err != nil {
+				t.Errorf("%q.IsAtLeast(%q) failed: %v", a, b, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%q.IsAtLeast(%q) = %v, want %v", a, b, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		a := MustParseAmount("USD", "1.00")
+		b := MustParseAmount("EUR", "1.00")
+		_, err := a.IsAtLeast(b)
+		if err == nil {
+			t.Errorf("%q.IsAtLeast(%q) did not fail", a, b)
+		}
+	})
+}
+
+func TestAmount_IsAtMost(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, a, b string
+			want       bool
+		}{
+			{"USD", "1.00", "1.00", true},
+			{"USD", "1.00", "1", true},
+			{"USD", "1.01", "1.00", false},
+			{"USD", "0.99", "1.00", true},
+			{"USD", "-1.00", "0", true},
+			{"JPY", "0", "-1", false},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.a)
+			b := MustParseAmount(tt.curr, tt.b)
+			got, err := a.IsAtMost(b)
+			if err != nil {
+				t.Errorf("%q.IsAtMost(%q) failed: %v", a, b, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%q.IsAtMost(%q) = %v, want %v", a, b, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		a := MustParseAmount("USD", "1.00")
+		b := MustParseAmount("EUR", "1.00")
+		_, err := a.IsAtMost(b)
+		if err == nil {
+			t.Errorf("%q.IsAtMost(%q) did not fail", a, b)
+		}
+	})
+}
+
+func TestAmount_IsStrictlyBetween(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, a, lo, hi string
+			want            bool
+		}{
+			{"USD", "1.00", "0.00", "2.00", true},
+			{"USD", "0.00", "0.00", "2.00", false},
+			{"USD", "2.00", "0.00", "2", false},
+			{"USD", "1.999", "0.00", "2.00", true},
+			{"USD", "-0.01", "0.00", "2.00", false},
+			{"USD", "1.00", "1.00", "1.00", false},
+			{"JPY", "-5", "-10", "0", true},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.a)
+			lo := MustParseAmount(tt.curr, tt.lo)
+			hi := MustParseAmount(tt.curr, tt.hi)
+			got, err := a.IsStrictlyBetween(lo, hi)
+			if err != nil {
+				t.Errorf("%q.IsStrictlyBetween(%q, %q) failed: %v", a, lo, hi, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%q.IsStrictlyBetween(%q, %q) = %v, want %v", a, lo, hi, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curra, a, currlo, lo, currhi, hi string
+		}{
+			"currency 1": {"USD", "1.00", "EUR", "0.00", "USD", "2.00"},
+			"currency 2": {"USD", "1.00", "USD", "0.00", "EUR", "2.00"},
+			"bounds 1":   {"USD", "1.00", "USD", "2.00", "USD", "0.00"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				a := MustParseAmount(tt.curra, tt.a)
+				lo := MustParseAmount(tt.currlo, tt.lo)
+				hi := MustParseAmount(tt.currhi, tt.hi)
+				_, err := a.IsStrictlyBetween(lo, hi)
+				if err == nil {
+					t.Errorf("%q.IsStrictlyBetween(%q, %q) did not fail", a, lo, hi)
+				}
+			})
+		}
+	})
+}
+
 func TestAmount_Min(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -1665,6 +2838,49 @@ func TestAmount_Rescale(t *testing.T) {
 	}
 }
 
+func TestAmount_ToCurrChanged(t *testing.T) {
+	tests := []struct {
+		curr, a                        string
+		wantCeil, wantFloor, wantTrunc string
+		wantRound                      string
+		changed                        bool
+	}{
+		{"USD", "5.678", "5.68", "5.67", "5.67", "5.68", true},
+		{"USD", "-5.678", "-5.67", "-5.68", "-5.67", "-5.68", true},
+		{"USD", "5.6", "5.60", "5.60", "5.60", "5.60", false},
+		{"USD", "5.600", "5.60", "5.60", "5.60", "5.60", false},
+		{"USD", "5.601", "5.61", "5.60", "5.60", "5.60", true},
+		{"JPY", "5", "5", "5", "5", "5", false},
+		{"JPY", "5.5", "6", "5", "5", "6", true},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.curr, tt.a)
+		methods := []struct {
+			name string
+			f    func() (Amount, bool)
+			want string
+		}{
+			{"CeilToCurrChanged", a.CeilToCurrChanged, tt.wantCeil},
+			{"FloorToCurrChanged", a.FloorToCurrChanged, tt.wantFloor},
+			{"TruncToCurrChanged", a.TruncToCurrChanged, tt.wantTrunc},
+			{"RoundToCurrChanged", a.RoundToCurrChanged, tt.wantRound},
+		}
+		for _, m := range methods {
+			got, changed := m.f()
+			want := MustParseAmount(tt.curr, m.want)
+			if got != want {
+				t.Errorf("%q.%v() = %q, want %q", a, m.name, got, want)
+			}
+			if wantChanged := got.Decimal().Cmp(a.Decimal()) != 0; changed != wantChanged {
+				t.Errorf("%q.%v() changed = %v, want %v", a, m.name, changed, wantChanged)
+			}
+		}
+		if _, changed := a.CeilToCurrChanged(); changed != tt.changed {
+			t.Errorf("%q.CeilToCurrChanged() changed = %v, want %v", a, changed, tt.changed)
+		}
+	}
+}
+
 func TestAmount_Quantize(t *testing.T) {
 	tests := []struct {
 		curr, a, b, want string
@@ -1726,3 +2942,110 @@ func TestAmount_Quantize(t *testing.T) {
 		}
 	}
 }
+
+func TestAmount_QuantizeExact(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, a, b string
+			mode       RoundingMode
+			want       string
+		}{
+			// Padding
+			{"USD", "1", "0.000", HalfEven, "1.000"},
+			{"USD", "1", "0.00000000000000000", HalfEven, "1.00000000000000000"},
+			{"OMR", "0", "0.0000000000000000000", HalfEven, "0.0000000000000000000"},
+
+			// Rounding
+			{"USD", "3.0450", "0.00", HalfEven, "3.04"},
+			{"USD", "3.0450", "0.00", HalfUp, "3.05"},
+			{"USD", "3.0450", "0.00", Down, "3.04"},
+			{"USD", "3.0410", "0.00", Up, "3.05"},
+			{"USD", "-3.0410", "0.00", Ceiling, "-3.04"},
+			{"USD", "-3.0410", "0.00", Floor, "-3.05"},
+			{"USD", "3.0400", "0.00", Unnecessary, "3.04"},
+			{"USD", "9.999", "0.00", HalfEven, "10.00"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.a)
+			b := MustParseAmount(tt.curr, tt.b)
+			got, err := a.QuantizeExact(b, tt.mode)
+			if err != nil {
+				t.Errorf("%q.QuantizeExact(%q, %v) failed: %v", a, b, tt.mode, err)
+				continue
+			}
+			want := MustParseAmount(tt.curr, tt.want)
+			if got != want {
+				t.Errorf("%q.QuantizeExact(%q, %v) = %q, want %q", a, b, tt.mode, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr, a, b string
+			mode       RoundingMode
+		}{
+			"mode 1":     {"USD", "1", "0.00", RoundingMode(-1)},
+			"mode 2":     {"USD", "3.041", "0.00", Unnecessary},
+			"scale 1":    {"OMR", "1", "0", HalfEven},
+			"overflow 1": {"JPY", "9999999999999999999", "0.1", HalfEven},
+			"overflow 2": {"USD", "99999999999999999.99", "0.001", HalfEven},
+			"overflow 3": {"OMR", "9999999999999999.999", "0.0001", HalfEven},
+		}
+		for name, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.a)
+			b := MustParseAmount("JPY", tt.b)
+			_, err := a.QuantizeExact(b, tt.mode)
+			if err == nil {
+				t.Errorf("%q.QuantizeExact(%q, %v) did not fail: %v", a, b, tt.mode, name)
+			}
+		}
+	})
+}
+
+func TestAmount_FormatCompact(t *testing.T) {
+	de := CompactOptions{DecimalMark: ',', Suffixes: [4]string{" Tsd.", " Mio.", " Mrd.", " Bio."}}
+	tests := []struct {
+		curr, a string
+		opts    CompactOptions
+		want    string
+	}{
+		{"USD", "0", CompactOptions{}, "USD 0.00"},
+		{"USD", "5.678", CompactOptions{}, "USD 5.68"},
+		{"USD", "999.99", CompactOptions{}, "USD 999.99"},
+		{"USD", "1000", CompactOptions{}, "USD 1K"},
+		{"USD", "1234.56", CompactOptions{}, "USD 1.2K"},
+		{"USD", "1234567.89", CompactOptions{}, "USD 1.2M"},
+		{"USD", "-1234567.89", CompactOptions{}, "USD -1.2M"},
+		{"USD", "1250000", CompactOptions{}, "USD 1.2M"},
+		{"USD", "1234567.89", CompactOptions{Digits: 4}, "USD 1.235M"},
+		{"USD", "999999", CompactOptions{}, "USD 1M"},
+		{"USD", "999999", CompactOptions{Digits: 6}, "USD 999.999K"},
+		{"JPY", "980000", CompactOptions{}, "JPY 980K"},
+		{"JPY", "980400", CompactOptions{}, "JPY 980K"},
+		{"USD", "12345678901", CompactOptions{}, "USD 12B"},
+		{"USD", "12345678901234", CompactOptions{}, "USD 12T"},
+		{"USD", "12345678901234567", CompactOptions{}, "USD 12346T"},
+		{"EUR", "1234567.89", de, "EUR 1,2 Mio."},
+		{"EUR", "1234.5", de, "EUR 1,2 Tsd."},
+		{"EUR", "12.5", de, "EUR 12,50"},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.curr, tt.a)
+		got := a.FormatCompact(tt.opts)
+		if got != tt.want {
+			t.Errorf("%q.FormatCompact(%v) = %q, want %q", a, tt.opts, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkAmount_Format(b *testing.B) {
+	a := MustParseAmount("USD", "-12345.6789")
+	for _, verb := range []string{"%v", "%f"} {
+		b.Run(verb, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				fmt.Fprintf(io.Discard, verb, a)
+			}
+		})
+	}
+}
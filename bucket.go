@@ -0,0 +1,87 @@
+package money
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/govalues/decimal"
+)
+
+// Buckets represents an ordered set of boundaries that divide amounts in
+// a single currency into consecutive ranges, such as fee tiers, reporting
+// ranges, or fraud rule thresholds.
+// The boundaries b[0] < b[1] < ... < b[n-1] define n+1 buckets:
+//
+//	bucket 0: a < b[0]
+//	bucket i: b[i-1] <= a < b[i]
+//	bucket n: b[n-1] <= a
+//
+// The boundaries are validated once by [NewBuckets], so looking up a bucket
+// using [Amount.Bucket] does not require handling comparison errors.
+// The zero value has no boundaries in currency [XXX].
+// This type is designed to be safe for concurrent use by multiple goroutines.
+type Buckets struct {
+	curr   Currency
+	bounds []decimal.Decimal
+}
+
+// NewBuckets returns buckets defined by the specified boundaries.
+//
+// NewBuckets returns an error if:
+//   - there are no boundaries;
+//   - boundaries are denominated in different currencies;
+//   - boundaries are not in strictly ascending order.
+func NewBuckets(bounds ...Amount) (Buckets, error) {
+	b, err := newBuckets(bounds)
+	if err != nil {
+		return Buckets{}, fmt.Errorf("creating buckets: %w", err)
+	}
+	return b, nil
+}
+
+func newBuckets(bounds []Amount) (Buckets, error) {
+	if len(bounds) == 0 {
+		return Buckets{}, fmt.Errorf("no boundaries")
+	}
+	b := Buckets{
+		curr:   bounds[0].Curr(),
+		bounds: make([]decimal.Decimal, len(bounds)),
+	}
+	for i, a := range bounds {
+		if !a.SameCurr(bounds[0]) {
+			return Buckets{}, fmt.Errorf("[%v] and [%v]: %w", bounds[0], a, mismatchError(bounds[0].Curr(), a.Curr()))
+		}
+		b.bounds[i] = a.Decimal()
+		if i > 0 && b.bounds[i-1].Cmp(b.bounds[i]) >= 0 {
+			return Buckets{}, fmt.Errorf("boundaries [%v] and [%v] are not in ascending order", bounds[i-1], a)
+		}
+	}
+	return b, nil
+}
+
+// Curr returns the currency of the boundaries.
+func (b Buckets) Curr() Currency {
+	return b.curr
+}
+
+// Len returns the number of buckets, which is one more than the number of
+// boundaries.
+func (b Buckets) Len() int {
+	return len(b.bounds) + 1
+}
+
+// Bucket returns the index of the bucket that contains the amount.
+// The result is in the range [0, b.Len()).
+// See also type [Buckets].
+//
+// Bucket returns an error if the amount is denominated in a different currency
+// than the boundaries.
+func (a Amount) Bucket(b Buckets) (int, error) {
+	if a.Curr() != b.Curr() {
+		return 0, fmt.Errorf("finding bucket of [%v]: %w", a, mismatchError(b.Curr(), a.Curr()))
+	}
+	d := a.Decimal()
+	return sort.Search(len(b.bounds), func(i int) bool {
+		return d.Cmp(b.bounds[i]) < 0
+	}), nil
+}
@@ -0,0 +1,96 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestNewBuckets(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr    string
+			bounds  []string
+			wantLen int
+		}{
+			{"USD", []string{"0"}, 2},
+			{"USD", []string{"10", "100", "1000"}, 4},
+			{"JPY", []string{"-1", "0", "0.5"}, 4},
+		}
+		for _, tt := range tests {
+			bounds := MustParseAmountSlice(tt.curr, tt.bounds)
+			got, err := NewBuckets(bounds...)
+			if err != nil {
+				t.Errorf("NewBuckets(%v) failed: %v", bounds, err)
+				continue
+			}
+			if got.Len() != tt.wantLen {
+				t.Errorf("NewBuckets(%v).Len() = %v, want %v", bounds, got.Len(), tt.wantLen)
+			}
+			if got.Curr().Code() != tt.curr {
+				t.Errorf("NewBuckets(%v).Curr() = %v, want %v", bounds, got.Curr(), tt.curr)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string][]Amount{
+			"no boundaries": nil,
+			"currency 1":    {MustParseAmount("USD", "1"), MustParseAmount("EUR", "2")},
+			"order 1":       MustParseAmountSlice("USD", []string{"2", "1"}),
+			"order 2":       MustParseAmountSlice("USD", []string{"1", "1.00"}),
+			"order 3":       MustParseAmountSlice("USD", []string{"1", "2", "2"}),
+		}
+		for name, bounds := range tests {
+			_, err := NewBuckets(bounds...)
+			if err == nil {
+				t.Errorf("NewBuckets(%v) did not fail: %v", bounds, name)
+			}
+		}
+	})
+}
+
+func TestAmount_Bucket(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		b, err := NewBuckets(MustParseAmountSlice("USD", []string{"10", "100", "1000"})...)
+		if err != nil {
+			t.Fatalf("NewBuckets() failed: %v", err)
+		}
+		tests := []struct {
+			a    string
+			want int
+		}{
+			{"-5", 0},
+			{"0", 0},
+			{"9.999", 0},
+			{"10", 1},
+			{"10.00", 1},
+			{"99.99", 1},
+			{"100", 2},
+			{"999.99", 2},
+			{"1000", 3},
+			{"1000000", 3},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount("USD", tt.a)
+			got, err := a.Bucket(b)
+			if err != nil {
+				t.Errorf("%q.Bucket(%v) failed: %v", a, b, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%q.Bucket(%v) = %v, want %v", a, b, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		b, err := NewBuckets(MustParseAmount("USD", "10"))
+		if err != nil {
+			t.Fatalf("NewBuckets() failed: %v", err)
+		}
+		a := MustParseAmount("EUR", "5")
+		_, err = a.Bucket(b)
+		if err == nil {
+			t.Errorf("%q.Bucket(%v) did not fail", a, b)
+		}
+	})
+}
@@ -0,0 +1,134 @@
+package money
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/govalues/decimal"
+)
+
+// Budget tracks cumulative spending against a limit and notifies
+// registered callbacks when the spending reaches a threshold,
+// such as 80% or 100% of the limit.
+// Budget is not safe for concurrent use by multiple goroutines.
+type Budget struct {
+	limit      Amount
+	spent      Amount
+	thresholds []budgetThreshold // sorted by ratio
+}
+
+// budgetThreshold represents a callback registered with [Budget.OnThreshold].
+type budgetThreshold struct {
+	ratio  decimal.Decimal
+	amount Amount // limit * ratio
+	fn     func(ratio decimal.Decimal, spent Amount)
+	fired  bool
+}
+
+// NewBudget returns a budget with the specified limit and no spending.
+// See also methods [Budget.Spend] and [Budget.OnThreshold].
+//
+// NewBudget returns an error if the limit is negative.
+func NewBudget(limit Amount) (*Budget, error) {
+	if limit.IsNeg() {
+		return nil, fmt.Errorf("creating budget: limit %v must not be negative", limit)
+	}
+	return &Budget{limit: limit, spent: limit.Zero()}, nil
+}
+
+// Limit returns the limit of the budget.
+func (b *Budget) Limit() Amount {
+	return b.limit
+}
+
+// Spent returns the cumulative spending.
+func (b *Budget) Spent() Amount {
+	return b.spent
+}
+
+// Remaining returns the limit minus the cumulative spending.
+// The result is negative if the budget has been overspent.
+func (b *Budget) Remaining() (Amount, error) {
+	r, err := b.limit.sub(b.spent)
+	if err != nil {
+		return Amount{}, fmt.Errorf("computing remaining budget: %w", err)
+	}
+	return r, nil
+}
+
+// OnThreshold registers a callback that is called by [Budget.Spend] when
+// the cumulative spending reaches the ratio of the limit, for example,
+// 0.8 for 80% or 1 for 100%.
+// Each callback is called once when its threshold is reached and is armed
+// again only if a refund brings the spending back below the threshold.
+// If the threshold has already been reached, the callback is called
+// immediately.
+//
+// OnThreshold returns an error if:
+//   - the ratio is not positive;
+//   - the callback is nil;
+//   - the integer part of the threshold amount has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func (b *Budget) OnThreshold(ratio decimal.Decimal, fn func(ratio decimal.Decimal, spent Amount)) error {
+	err := b.onThreshold(ratio, fn)
+	if err != nil {
+		return fmt.Errorf("registering budget threshold %v: %w", ratio, err)
+	}
+	return nil
+}
+
+func (b *Budget) onThreshold(ratio decimal.Decimal, fn func(ratio decimal.Decimal, spent Amount)) error {
+	if !ratio.IsPos() {
+		return fmt.Errorf("ratio must be positive")
+	}
+	if fn == nil {
+		return fmt.Errorf("callback must not be nil")
+	}
+	a, err := b.limit.mul(ratio)
+	if err != nil {
+		return err
+	}
+	th := budgetThreshold{ratio: ratio, amount: a, fn: fn}
+	i := sort.Search(len(b.thresholds), func(i int) bool {
+		return b.thresholds[i].ratio.Cmp(ratio) > 0
+	})
+	b.thresholds = append(b.thresholds, budgetThreshold{})
+	copy(b.thresholds[i+1:], b.thresholds[i:])
+	b.thresholds[i] = th
+	b.notify()
+	return nil
+}
+
+// Spend adds the amount to the cumulative spending and calls the callbacks
+// of the thresholds reached, in the order of increasing ratios.
+// A negative amount is treated as a refund.
+//
+// Spend returns an error if:
+//   - the amount is denominated in a different currency than the limit;
+//   - the integer part of the spending has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func (b *Budget) Spend(a Amount) error {
+	s, err := b.spent.add(a)
+	if err != nil {
+		return fmt.Errorf("spending %v: %w", a, err)
+	}
+	b.spent = s
+	b.notify()
+	return nil
+}
+
+// notify calls the callbacks of the reached thresholds that have not been
+// called yet, and rearms the thresholds that are no longer reached.
+func (b *Budget) notify() {
+	for i := range b.thresholds {
+		th := &b.thresholds[i]
+		reached := th.amount.Decimal().Cmp(b.spent.Decimal()) <= 0
+		switch {
+		case reached && !th.fired:
+			th.fired = true
+			th.fn(th.ratio, b.spent)
+		case !reached:
+			th.fired = false
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/govalues/decimal"
+)
+
+func TestBudget(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		b, err := NewBudget(MustParseAmount("USD", "100"))
+		if err != nil {
+			t.Fatalf("NewBudget() failed: %v", err)
+		}
+		var fired []string
+		record := func(ratio decimal.Decimal, spent Amount) {
+			fired = append(fired, ratio.String()+"@"+spent.Decimal().String())
+		}
+		for _, r := range []string{"1", "0.8"} {
+			err := b.OnThreshold(decimal.MustParse(r), record)
+			if err != nil {
+				t.Fatalf("OnThreshold(%v) failed: %v", r, err)
+			}
+		}
+		steps := []struct {
+			spend         string
+			wantRemaining string
+			wantFired     []string
+		}{
+			{"50", "50.00", nil},
+			{"30", "20.00", []string{"0.8@80.00"}},
+			{"10", "10.00", nil},
+			{"15", "-5.00", []string{"1@105.00"}},
+			{"-30", "25.00", nil},
+			{"25", "0.00", []string{"0.8@100.00", "1@100.00"}},
+		}
+		for _, s := range steps {
+			fired = nil
+			a := MustParseAmount("USD", s.spend)
+			if err := b.Spend(a); err != nil {
+				t.Fatalf("Spend(%q) failed: %v", a, err)
+			}
+			got, err := b.Remaining()
+			if err != nil {
+				t.Fatalf("Remaining() failed: %v", err)
+			}
+			want := MustParseAmount("USD", s.wantRemaining)
+			if got != want {
+				t.Errorf("Remaining() after Spend(%q) = %q, want %q", a, got, want)
+			}
+			if len(fired) != len(s.wantFired) {
+				t.Errorf("Spend(%q) fired %v, want %v", a, fired, s.wantFired)
+				continue
+			}
+			for i := range fired {
+				if fired[i] != s.wantFired[i] {
+					t.Errorf("Spend(%q) fired %v, want %v", a, fired, s.wantFired)
+				}
+			}
+		}
+		if got, want := b.Spent(), MustParseAmount("USD", "100"); got != want {
+			t.Errorf("Spent() = %q, want %q", got, want)
+		}
+
+		// Late registration
+		fired = nil
+		if err := b.OnThreshold(decimal.MustParse("0.5"), record); err != nil {
+			t.Fatalf("OnThreshold(0.5) failed: %v", err)
+		}
+		if len(fired) != 1 || fired[0] != "0.5@100.00" {
+			t.Errorf("OnThreshold(0.5) fired %v, want [0.5@100.00]", fired)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := NewBudget(MustParseAmount("USD", "-1"))
+		if err == nil {
+			t.Errorf("NewBudget(-1) did not fail")
+		}
+		b, err := NewBudget(MustParseAmount("USD", "100"))
+		if err != nil {
+			t.Fatalf("NewBudget() failed: %v", err)
+		}
+		if err := b.Spend(MustParseAmount("EUR", "1")); err == nil {
+			t.Errorf("Spend(EUR) did not fail")
+		}
+		fn := func(decimal.Decimal, Amount) {}
+		if err := b.OnThreshold(decimal.Zero, fn); err == nil {
+			t.Errorf("OnThreshold(0) did not fail")
+		}
+		if err := b.OnThreshold(decimal.One, nil); err == nil {
+			t.Errorf("OnThreshold(1, nil) did not fail")
+		}
+	})
+}
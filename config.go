@@ -0,0 +1,151 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/govalues/decimal"
+)
+
+// Config holds per-tenant settings for parsing, rounding, and formatting
+// amounts, so that multi-tenant systems can vary behavior by tenant without
+// modifying global state, such as registered rounding policies.
+// The zero value allows any currency and behaves like the package-level
+// functions.
+// Config must not be modified after it has been shared, and then it is safe
+// for concurrent use by multiple goroutines.
+// See also method [Config.Validate].
+type Config struct {
+	// Currencies is the set of allowed currencies.
+	// An empty set allows any currency.
+	Currencies CurrencySet
+	// Scales overrides the number of digits after the decimal point used by
+	// [Config.Format], for example, {JPY: 0, USD: 0} to display whole units.
+	// Currencies without an entry are displayed at the scale of the currency.
+	Scales map[Currency]int
+	// Modes overrides the rounding mode used by [Config.Round] and
+	// [Config.Format].
+	// Currencies without an entry are rounded half to even.
+	Modes map[Currency]RoundingMode
+	// Limits sets the largest absolute value of an amount, such as
+	// a regulatory cap on a single transaction, accepted by
+	// [Config.ParseAmount] and [Config.CheckLimit].
+	// Currencies without an entry are not limited.
+	Limits map[Currency]Amount
+}
+
+// Validate returns an error if:
+//   - any display scale is negative or greater than [decimal.MaxScale];
+//   - any rounding mode is not valid or is [Unnecessary];
+//   - any limit is negative or denominated in a different currency.
+func (cfg Config) Validate() error {
+	for c, scale := range cfg.Scales {
+		if scale < 0 || scale > decimal.MaxScale {
+			return fmt.Errorf("validating config: display scale %v for %v must be between 0 and %v", scale, c, decimal.MaxScale)
+		}
+	}
+	for c, mode := range cfg.Modes {
+		if !mode.rounds() {
+			return fmt.Errorf("validating config: invalid rounding mode %v for %v", mode, c)
+		}
+	}
+	for c, lim := range cfg.Limits {
+		if lim.Curr() != c {
+			return fmt.Errorf("validating config: limit %v: %w", lim, mismatchError(c, lim.Curr()))
+		}
+		if lim.IsNeg() {
+			return fmt.Errorf("validating config: limit %v must not be negative", lim)
+		}
+	}
+	return nil
+}
+
+// CheckLimit returns an error if the absolute value of the amount exceeds
+// the limit of its currency.
+// See also field [Config.Limits].
+func (cfg Config) CheckLimit(a Amount) error {
+	lim, ok := cfg.Limits[a.Curr()]
+	if !ok {
+		return nil
+	}
+	d, e := a.Decimal(), lim.Decimal()
+	if d.CmpAbs(e) > 0 {
+		return fmt.Errorf("checking [%v]: amount exceeds limit [%v]", a, lim)
+	}
+	return nil
+}
+
+// check returns an error if the currency is not allowed.
+func (cfg Config) check(c Currency) error {
+	if cfg.Currencies.Len() == 0 {
+		return nil
+	}
+	return cfg.Currencies.check(c)
+}
+
+// Scale returns the number of digits after the decimal point used for
+// displaying amounts denominated in the currency.
+func (cfg Config) Scale(c Currency) int {
+	if scale, ok := cfg.Scales[c]; ok {
+		return scale
+	}
+	return c.Scale()
+}
+
+// Mode returns the rounding mode used for amounts denominated in the currency.
+func (cfg Config) Mode(c Currency) RoundingMode {
+	if mode, ok := cfg.Modes[c]; ok {
+		return mode
+	}
+	return HalfEven
+}
+
+// ParseAmount is like [ParseAmount] but also returns an error if the currency
+// is not allowed or the amount exceeds the limit of the currency.
+// See also method [Config.CheckLimit].
+func (cfg Config) ParseAmount(curr, amount string) (Amount, error) {
+	c, err := ParseCurr(curr)
+	if err != nil {
+		return Amount{}, fmt.Errorf("parsing currency: %w", err)
+	}
+	if err := cfg.check(c); err != nil {
+		return Amount{}, fmt.Errorf("parsing currency: %w", err)
+	}
+	a, err := ParseAmount(c.Code(), amount)
+	if err != nil {
+		return Amount{}, err
+	}
+	if err := cfg.CheckLimit(a); err != nil {
+		return Amount{}, err
+	}
+	return a, nil
+}
+
+// Round returns an amount rounded to the scale of its currency using
+// the rounding mode of the config.
+// See also method [Amount.RoundToCurr].
+//
+// Round returns an error if the currency is not allowed.
+func (cfg Config) Round(a Amount) (Amount, error) {
+	c := a.Curr()
+	if err := cfg.check(c); err != nil {
+		return Amount{}, fmt.Errorf("rounding [%v]: %w", a, err)
+	}
+	d := roundDecimal(a.Decimal(), c.Scale(), cfg.Mode(c)).Pad(c.Scale())
+	return newAmountUnsafe(c, d), nil
+}
+
+// Format returns the text form of the amount, as produced by [Amount.String],
+// rounded and zero-padded to the display scale of the config using
+// the rounding mode of the config, for example, "JPY 1235" or "USD 6".
+// The display scale may be less than the scale of the currency.
+//
+// Format returns an error if the currency is not allowed.
+func (cfg Config) Format(a Amount) (string, error) {
+	c := a.Curr()
+	if err := cfg.check(c); err != nil {
+		return "", fmt.Errorf("formatting [%v]: %w", a, err)
+	}
+	scale := cfg.Scale(c)
+	d := roundDecimal(a.Decimal(), scale, cfg.Mode(c))
+	return c.Code() + " " + d.Pad(scale).String(), nil
+}
@@ -0,0 +1,176 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []Config{
+			{},
+			{Currencies: NewCurrencySet(USD), Scales: map[Currency]int{USD: 0}, Modes: map[Currency]RoundingMode{USD: HalfUp}},
+			{Limits: map[Currency]Amount{USD: MustParseAmount("USD", "10000")}},
+		}
+		for _, cfg := range tests {
+			if err := cfg.Validate(); err != nil {
+				t.Errorf("%+v.Validate() failed: %v", cfg, err)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]Config{
+			"scale 1": {Scales: map[Currency]int{USD: -1}},
+			"scale 2": {Scales: map[Currency]int{USD: 20}},
+			"mode 1":  {Modes: map[Currency]RoundingMode{USD: Unnecessary}},
+			"mode 2":  {Modes: map[Currency]RoundingMode{USD: RoundingMode(-1)}},
+			"limit 1": {Limits: map[Currency]Amount{USD: MustParseAmount("EUR", "100")}},
+			"limit 2": {Limits: map[Currency]Amount{USD: MustParseAmount("USD", "-100")}},
+		}
+		for name, cfg := range tests {
+			t.Run(name, func(t *testing.T) {
+				if err := cfg.Validate(); err == nil {
+					t.Errorf("%+v.Validate() did not fail", cfg)
+				}
+			})
+		}
+	})
+}
+
+func TestConfig_ParseAmount(t *testing.T) {
+	cfg := Config{
+		Currencies: NewCurrencySet(USD, EUR),
+		Limits:     map[Currency]Amount{USD: MustParseAmount("USD", "10000")},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			cfg          Config
+			curr, amount string
+		}{
+			{cfg, "USD", "5.67"},
+			{cfg, "EUR", "5"},
+			{cfg, "USD", "10000.00"},
+			{cfg, "USD", "-10000"},
+			{cfg, "EUR", "1000000"},
+			{Config{}, "JPY", "5"},
+		}
+		for _, tt := range tests {
+			got, err := tt.cfg.ParseAmount(tt.curr, tt.amount)
+			if err != nil {
+				t.Errorf("ParseAmount(%q, %q) failed: %v", tt.curr, tt.amount, err)
+				continue
+			}
+			if want := MustParseAmount(tt.curr, tt.amount); got != want {
+				t.Errorf("ParseAmount(%q, %q) = %q, want %q", tt.curr, tt.amount, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr, amount string
+		}{
+			"currency 1": {"JPY", "5"},
+			"currency 2": {"ZZZ", "5"},
+			"decimal 1":  {"USD", "abc"},
+			"limit 1":    {"USD", "10000.01"},
+			"limit 2":    {"USD", "-10000.001"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := cfg.ParseAmount(tt.curr, tt.amount)
+				if err == nil {
+					t.Errorf("ParseAmount(%q, %q) did not fail", tt.curr, tt.amount)
+				}
+			})
+		}
+	})
+}
+
+func TestConfig_Round(t *testing.T) {
+	cfg := Config{
+		Currencies: NewCurrencySet(USD, CHF),
+		Modes:      map[Currency]RoundingMode{USD: HalfUp, CHF: Floor},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, a, want string
+		}{
+			{"USD", "5.665", "5.67"},
+			{"USD", "-5.665", "-5.67"},
+			{"CHF", "5.669", "5.66"},
+			{"USD", "5", "5.00"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.a)
+			got, err := cfg.Round(a)
+			if err != nil {
+				t.Errorf("Round(%q) failed: %v", a, err)
+				continue
+			}
+			if want := MustParseAmount(tt.curr, tt.want); got != want {
+				t.Errorf("Round(%q) = %q, want %q", a, got, want)
+			}
+		}
+		// Default mode
+		a := MustParseAmount("EUR", "5.665")
+		got, err := Config{}.Round(a)
+		if err != nil {
+			t.Fatalf("Round(%q) failed: %v", a, err)
+		}
+		if want := MustParseAmount("EUR", "5.66"); got != want {
+			t.Errorf("Round(%q) = %q, want %q", a, got, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		a := MustParseAmount("EUR", "5.665")
+		_, err := cfg.Round(a)
+		if err == nil {
+			t.Errorf("Round(%q) did not fail", a)
+		}
+	})
+}
+
+func TestConfig_Format(t *testing.T) {
+	cfg := Config{
+		Currencies: NewCurrencySet(USD, JPY, OMR),
+		Scales:     map[Currency]int{USD: 0, OMR: 2},
+		Modes:      map[Currency]RoundingMode{USD: Ceiling},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, a, want string
+		}{
+			{"USD", "5.01", "USD 6"},
+			{"USD", "-5.99", "USD -5"},
+			{"USD", "-0.50", "USD 0"},
+			{"JPY", "1234.5", "JPY 1234"},
+			{"JPY", "1235.5", "JPY 1236"},
+			{"OMR", "5.675", "OMR 5.68"},
+			{"OMR", "5", "OMR 5.00"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.a)
+			got, err := cfg.Format(a)
+			if err != nil {
+				t.Errorf("Format(%q) failed: %v", a, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("Format(%q) = %q, want %q", a, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		a := MustParseAmount("EUR", "5.665")
+		_, err := cfg.Format(a)
+		if err == nil {
+			t.Errorf("Format(%q) did not fail", a)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package money
+
+import (
+	"context"
+	"fmt"
+)
+
+var errNoDefaultCurrency = fmt.Errorf("no default currency in context")
+
+// defaultCurrencyKey is the context key for the default currency.
+type defaultCurrencyKey struct{}
+
+// WithDefaultCurrency returns a copy of the context carrying the default
+// currency, which is used by [ParseAmountCtx] and [NewAmountCtx].
+// This is useful in HTTP handlers, where the currency of a tenant is known
+// once per request.
+// See also function [DefaultCurrency].
+func WithDefaultCurrency(ctx context.Context, curr Currency) context.Context {
+	return context.WithValue(ctx, defaultCurrencyKey{}, curr)
+}
+
+// DefaultCurrency returns the default currency carried by the context and
+// true, or [XXX] and false if the context does not carry a default currency.
+// See also function [WithDefaultCurrency].
+func DefaultCurrency(ctx context.Context) (Currency, bool) {
+	c, ok := ctx.Value(defaultCurrencyKey{}).(Currency)
+	if !ok {
+		return XXX, false
+	}
+	return c, true
+}
+
+// ParseAmountCtx is like [ParseAmount] but takes the currency from
+// the context.
+// See also function [WithDefaultCurrency].
+//
+// ParseAmountCtx returns an error if:
+//   - the context does not carry a default currency;
+//   - the string is not a valid decimal;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func ParseAmountCtx(ctx context.Context, amount string) (Amount, error) {
+	c, ok := DefaultCurrency(ctx)
+	if !ok {
+		return Amount{}, fmt.Errorf("parsing amount: %w", errNoDefaultCurrency)
+	}
+	return ParseAmount(c.Code(), amount)
+}
+
+// NewAmountCtx is like [NewAmount] but takes the currency from the context.
+// See also function [WithDefaultCurrency].
+//
+// NewAmountCtx returns an error if:
+//   - the context does not carry a default currency;
+//   - the scale is negative or greater than [decimal.MaxScale];
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func NewAmountCtx(ctx context.Context, coef int64, scale int) (Amount, error) {
+	c, ok := DefaultCurrency(ctx)
+	if !ok {
+		return Amount{}, fmt.Errorf("converting coefficient: %w", errNoDefaultCurrency)
+	}
+	return NewAmount(c.Code(), coef, scale)
+}
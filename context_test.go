@@ -0,0 +1,71 @@
+package money
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDefaultCurrency(t *testing.T) {
+	ctx := context.Background()
+	if c, ok := DefaultCurrency(ctx); ok || c != XXX {
+		t.Errorf("DefaultCurrency(ctx) = [%v %v], want [XXX false]", c, ok)
+	}
+	ctx = WithDefaultCurrency(ctx, EUR)
+	if c, ok := DefaultCurrency(ctx); !ok || c != EUR {
+		t.Errorf("DefaultCurrency(ctx) = [%v %v], want [EUR true]", c, ok)
+	}
+	ctx = WithDefaultCurrency(ctx, JPY)
+	if c, ok := DefaultCurrency(ctx); !ok || c != JPY {
+		t.Errorf("DefaultCurrency(ctx) = [%v %v], want [JPY true]", c, ok)
+	}
+}
+
+func TestParseAmountCtx(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr   Currency
+			amount string
+			want   string
+		}{
+			{USD, "5.67", "USD 5.67"},
+			{USD, "5", "USD 5.00"},
+			{JPY, "5", "JPY 5"},
+			{OMR, "-0.5", "OMR -0.500"},
+		}
+		for _, tt := range tests {
+			ctx := WithDefaultCurrency(context.Background(), tt.curr)
+			got, err := ParseAmountCtx(ctx, tt.amount)
+			if err != nil {
+				t.Errorf("ParseAmountCtx(%v, %q) failed: %v", tt.curr, tt.amount, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParseAmountCtx(%v, %q) = %q, want %q", tt.curr, tt.amount, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		if _, err := ParseAmountCtx(context.Background(), "1"); err == nil {
+			t.Errorf("ParseAmountCtx(ctx, \"1\") did not fail without default currency")
+		}
+		ctx := WithDefaultCurrency(context.Background(), USD)
+		if _, err := ParseAmountCtx(ctx, "abc"); err == nil {
+			t.Errorf("ParseAmountCtx(ctx, \"abc\") did not fail")
+		}
+	})
+}
+
+func TestNewAmountCtx(t *testing.T) {
+	ctx := WithDefaultCurrency(context.Background(), USD)
+	got, err := NewAmountCtx(ctx, 567, 2)
+	if err != nil {
+		t.Fatalf("NewAmountCtx(ctx, 567, 2) failed: %v", err)
+	}
+	if want := MustParseAmount("USD", "5.67"); got != want {
+		t.Errorf("NewAmountCtx(ctx, 567, 2) = %q, want %q", got, want)
+	}
+	if _, err := NewAmountCtx(context.Background(), 567, 2); err == nil {
+		t.Errorf("NewAmountCtx(ctx, 567, 2) did not fail without default currency")
+	}
+}
@@ -0,0 +1,160 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/govalues/decimal"
+)
+
+// ConvRoundToCurr returns an amount converted from the base currency to
+// the quote currency of the exchange rate and rounded to the scale of
+// the quote currency, along with the residue, that is, the difference
+// between the exact and the rounded results.
+// Unlike [ExchangeRate.Conv] followed by [Amount.RoundToCurr], it computes
+// the exact product of the rate and the amount and rounds it only once,
+// so that the result never suffers from double rounding.
+// The amount is rounded using [rounding half to even] (banker's rounding),
+// or using the mode and the increment of the rounding policy registered for
+// the quote currency, if any.
+// If the residue has more than [decimal.MaxScale] digits after the decimal
+// point, it is rounded.
+// Converting a zero amount returns zero with the scale of the quote currency
+// without performing any multiplication, unlike [ExchangeRate.Conv], which
+// returns zero with the combined scale of the rate and the amount.
+// See also function [SetRoundingPolicy].
+//
+// ConvRoundToCurr returns an error if:
+//   - the base currency of the exchange rate does not match the currency of the given amount;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (r ExchangeRate) ConvRoundToCurr(b Amount) (c, residue Amount, err error) {
+	c, residue, err = r.convRoundToCurr(b)
+	if err != nil {
+		return Amount{}, Amount{}, fmt.Errorf("converting [%v] to [%v]: %w", b, r.Quote(), err)
+	}
+	return c, residue, nil
+}
+
+func (r ExchangeRate) convRoundToCurr(b Amount) (c, residue Amount, err error) {
+	if !r.CanConv(b) {
+		return Amount{}, Amount{}, mismatchError(r.Base(), b.Curr())
+	}
+	q := r.Quote()
+
+	// Policy
+	mode, inc, incScale := HalfEven, uint64(1), q.Scale()
+	if p := roundingPolicies[q].Load(); p != nil {
+		mode = p.Mode
+		if !p.Increment.IsZero() {
+			inc, incScale = p.Increment.Decimal().Coef(), p.Increment.Scale()
+		}
+	}
+
+	// Zero amount
+	scale := r.Scale() + b.Scale()
+	if b.IsZero() {
+		zero := decimal.Zero.Pad(min(max(scale, incScale), decimal.MaxScale))
+		return newAmountUnsafe(q, decimal.Zero.Pad(max(q.Scale(), incScale))), newAmountUnsafe(q, zero), nil
+	}
+
+	// Exact product
+	x := new(big.Int).SetUint64(r.Decimal().Coef())
+	x.Mul(x, new(big.Int).SetUint64(b.Decimal().Coef()))
+	if scale < incScale {
+		x.Mul(x, pow10Big(incScale-scale))
+		scale = incScale
+	}
+	neg := b.IsNeg()
+
+	// Rounding
+	den := new(big.Int).SetUint64(inc)
+	den.Mul(den, pow10Big(scale-incScale))
+	quo, rem := new(big.Int).QuoRem(x, den, new(big.Int))
+	if rem.Sign() != 0 {
+		away := false
+		switch mode {
+		case Up:
+			away = true
+		case Ceiling:
+			away = !neg
+		case Floor:
+			away = neg
+		case HalfUp, HalfEven:
+			// Comparing the doubled remainder with the increment
+			// to find the nearest neighbor.
+			switch c := new(big.Int).Lsh(rem, 1).Cmp(den); {
+			case c > 0:
+				away = true
+			case c == 0:
+				away = mode == HalfUp || quo.Bit(0) != 0
+			}
+		}
+		if away {
+			quo.Add(quo, big.NewInt(1))
+			rem.Sub(rem, den)
+		}
+	}
+	if neg {
+		quo.Neg(quo)
+		rem.Neg(rem)
+	}
+
+	// Result
+	quo.Mul(quo, new(big.Int).SetUint64(inc))
+	d, err := bigDecimalExact(quo, incScale)
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+	c, err = newAmountSafe(q, d.Pad(q.Scale()))
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+
+	// Residue
+	d, err = bigDecimal(rem, scale)
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+	residue, err = newAmountSafe(q, d)
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+	return c, residue, nil
+}
+
+// pow10Big returns 10 raised to the power of n.
+func pow10Big(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// bigDecimalExact returns a decimal equal to x / 10^scale with exactly
+// the specified scale, or an error if x has more than [decimal.MaxPrec] digits.
+func bigDecimalExact(x *big.Int, scale int) (decimal.Decimal, error) {
+	d, err := bigDecimal(x, scale)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	if d.Scale() != scale {
+		return decimal.Decimal{}, fmt.Errorf("result with %v digits after the decimal point has more than %v digits", scale, decimal.MaxPrec)
+	}
+	return d, nil
+}
+
+// bigDecimal returns a (possibly rounded) decimal equal to x / 10^scale.
+func bigDecimal(x *big.Int, scale int) (decimal.Decimal, error) {
+	s := new(big.Int).Abs(x).String()
+	if scale > 0 {
+		if len(s) <= scale {
+			s = strings.Repeat("0", scale-len(s)+1) + s
+		}
+		s = s[:len(s)-scale] + "." + s[len(s)-scale:]
+	}
+	if x.Sign() < 0 {
+		s = "-" + s
+	}
+	return decimal.Parse(s)
+}
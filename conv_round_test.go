@@ -0,0 +1,76 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestExchangeRate_ConvRoundToCurr(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			base, quote, rate, b, want, wantResidue string
+		}{
+			{"EUR", "USD", "1.2", "5", "6.00", "0.0000"},
+			{"EUR", "USD", "1.2345", "10.00", "12.34", "0.005000"},
+			{"EUR", "USD", "1.2355", "10.00", "12.36", "-0.005000"},
+			{"EUR", "USD", "1.2345", "-10.00", "-12.34", "-0.005000"},
+			{"EUR", "JPY", "160.5", "0.01", "2", "-0.395"},
+			{"USD", "JPY", "150.123", "-0.01", "-2", "0.49877"},
+			// Conv followed by RoundToCurr returns 0.02 here.
+			{"EUR", "USD", "0.9999999999999999999", "0.015", "0.01", "0.0050000000000000000"},
+			// Zero amounts
+			{"EUR", "USD", "1.2345", "0", "0.00", "0.000000"},
+			{"EUR", "JPY", "160.5", "-0.00", "0", "0.000"},
+			{"EUR", "USD", "0.9999999999999999999", "0", "0.00", "0.0000000000000000000"},
+		}
+		for _, tt := range tests {
+			r := MustParseExchRate(tt.base, tt.quote, tt.rate)
+			b := MustParseAmount(tt.base, tt.b)
+			got, gotResidue, err := r.ConvRoundToCurr(b)
+			if err != nil {
+				t.Errorf("%q.ConvRoundToCurr(%q) failed: %v", r, b, err)
+				continue
+			}
+			want := MustParseAmount(tt.quote, tt.want)
+			wantResidue := MustParseAmount(tt.quote, tt.wantResidue)
+			if got != want || gotResidue != wantResidue {
+				t.Errorf("%q.ConvRoundToCurr(%q) = %q, %q, want %q, %q", r, b, got, gotResidue, want, wantResidue)
+			}
+		}
+	})
+
+	t.Run("policy", func(t *testing.T) {
+		defer ResetRoundingPolicy(CHF)
+		err := SetRoundingPolicy(CHF, RoundingPolicy{Mode: HalfUp, Increment: MustParseAmount("CHF", "0.05")})
+		if err != nil {
+			t.Fatalf("SetRoundingPolicy() failed: %v", err)
+		}
+		r := MustParseExchRate("EUR", "CHF", "0.95")
+		b := MustParseAmount("EUR", "10.50")
+		got, gotResidue, err := r.ConvRoundToCurr(b)
+		if err != nil {
+			t.Fatalf("%q.ConvRoundToCurr(%q) failed: %v", r, b, err)
+		}
+		want := MustParseAmount("CHF", "10.00")
+		wantResidue := MustParseAmount("CHF", "-0.0250")
+		if got != want || gotResidue != wantResidue {
+			t.Errorf("%q.ConvRoundToCurr(%q) = %q, %q, want %q, %q", r, b, got, gotResidue, want, wantResidue)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			base, quote, rate, curr, b string
+		}{
+			"currency 1": {"EUR", "USD", "1.2", "JPY", "1"},
+			"overflow 1": {"EUR", "USD", "10", "EUR", "99999999999999999"},
+		}
+		for name, tt := range tests {
+			r := MustParseExchRate(tt.base, tt.quote, tt.rate)
+			b := MustParseAmount(tt.curr, tt.b)
+			_, _, err := r.ConvRoundToCurr(b)
+			if err == nil {
+				t.Errorf("%q.ConvRoundToCurr(%q) did not fail: %v", r, b, name)
+			}
+		}
+	})
+}
@@ -0,0 +1,271 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/govalues/decimal"
+)
+
+// ConversionGraph represents a set of exchange rates that can be chained to
+// convert between currencies that are not quoted against each other directly.
+// The rates are treated as edges of a graph, where every rate can be used in
+// both directions: a rate "EUR/USD" converts from EUR to USD, while its inverse
+// converts from USD to EUR.
+// Conversions follow the path with the fewest rates, so a direct rate is
+// always preferred over a chain of rates.
+// The zero value is an empty graph ready to use.
+// ConversionGraph is not safe for concurrent modification, but it is safe for
+// concurrent reads by multiple goroutines.
+type ConversionGraph struct {
+	rates  map[[2]Currency]ExchangeRate       // rates by base and quote currencies
+	adj    map[Currency]CurrencySet           // currencies connected by a rate in any direction
+	bounds map[[2]Currency][2]decimal.Decimal // plausibility bounds by base and quote currencies
+}
+
+// NewConversionGraph returns a graph containing the specified rates.
+// See also method [ConversionGraph.Add].
+//
+// NewConversionGraph returns an error if any of the rates cannot be added.
+func NewConversionGraph(rates ...ExchangeRate) (*ConversionGraph, error) {
+	g := &ConversionGraph{}
+	for _, r := range rates {
+		if err := g.Add(r); err != nil {
+			return nil, err
+		}
+	}
+	return g, nil
+}
+
+// Add inserts the rate into the graph.
+// If the graph already contains a rate with the same base and quote currencies,
+// the rate is replaced.
+//
+// Add returns an error if:
+//   - the base and quote currencies of the rate are the same;
+//   - the rate is outside the plausibility bounds set by [ConversionGraph.SetBounds].
+func (g *ConversionGraph) Add(r ExchangeRate) error {
+	b, q := r.Base(), r.Quote()
+	if b == q {
+		return fmt.Errorf("adding %v: base and quote currencies must be different", r)
+	}
+	if !g.plausible(r) {
+		bnd := g.bounds[[2]Currency{b, q}]
+		return fmt.Errorf("adding %v: rate is outside the plausibility bounds [%v, %v]", r, bnd[0], bnd[1])
+	}
+	if g.rates == nil {
+		g.rates = make(map[[2]Currency]ExchangeRate)
+		g.adj = make(map[Currency]CurrencySet)
+	}
+	g.rates[[2]Currency{b, q}] = r
+	g.adj[b] = g.adj[b].With(q)
+	g.adj[q] = g.adj[q].With(b)
+	return nil
+}
+
+// SetBounds sets the plausibility bounds for rates with the specified base
+// and quote currencies, so that [ConversionGraph.Add] rejects rates outside
+// the range [min, max].
+// This guards against fat-finger errors or corrupted feed values reaching
+// conversions.
+// The bounds apply to the specified direction only, and they replace
+// any previously set bounds for the same currencies.
+// See also method [ConversionGraph.Bounds].
+//
+// SetBounds returns an error if:
+//   - the base and quote currencies are the same;
+//   - min is not positive or is greater than max;
+//   - the graph already contains a rate outside the bounds.
+func (g *ConversionGraph) SetBounds(base, quote Currency, min, max decimal.Decimal) error {
+	err := g.setBounds(base, quote, min, max)
+	if err != nil {
+		return fmt.Errorf("setting bounds for %v/%v: %w", base, quote, err)
+	}
+	return nil
+}
+
+func (g *ConversionGraph) setBounds(base, quote Currency, min, max decimal.Decimal) error {
+	if base == quote {
+		return fmt.Errorf("base and quote currencies must be different")
+	}
+	if !min.IsPos() || min.Cmp(max) > 0 {
+		return fmt.Errorf("bounds [%v, %v] must be positive and ordered", min, max)
+	}
+	key := [2]Currency{base, quote}
+	if r, ok := g.rates[key]; ok && (r.Decimal().Cmp(min) < 0 || r.Decimal().Cmp(max) > 0) {
+		return fmt.Errorf("existing rate %v is outside the bounds [%v, %v]", r, min, max)
+	}
+	if g.bounds == nil {
+		g.bounds = make(map[[2]Currency][2]decimal.Decimal)
+	}
+	g.bounds[key] = [2]decimal.Decimal{min, max}
+	return nil
+}
+
+// Bounds returns the plausibility bounds for rates with the specified base
+// and quote currencies.
+// If no bounds have been set, ok is false.
+// See also method [ConversionGraph.SetBounds].
+func (g *ConversionGraph) Bounds(base, quote Currency) (min, max decimal.Decimal, ok bool) {
+	bnd, ok := g.bounds[[2]Currency{base, quote}]
+	return bnd[0], bnd[1], ok
+}
+
+// plausible returns true if the rate is within its plausibility bounds,
+// or if no bounds have been set for its currencies.
+func (g *ConversionGraph) plausible(r ExchangeRate) bool {
+	bnd, ok := g.bounds[[2]Currency{r.Base(), r.Quote()}]
+	if !ok {
+		return true
+	}
+	d := r.Decimal()
+	return d.Cmp(bnd[0]) >= 0 && d.Cmp(bnd[1]) <= 0
+}
+
+// Len returns the number of rates in the graph.
+func (g *ConversionGraph) Len() int {
+	return len(g.rates)
+}
+
+// Path returns the shortest sequence of currencies leading from the base
+// currency to the quote currency, including both of them.
+// If there are several shortest paths, the one going through currencies with
+// the lowest indexes is returned, so the result is deterministic.
+// If the currencies are the same, the path consists of a single currency.
+// See also method [ConversionGraph.Rate].
+//
+// Path returns an error if the quote currency cannot be reached from
+// the base currency.
+func (g *ConversionGraph) Path(base, quote Currency) ([]Currency, error) {
+	path, err := g.path(base, quote)
+	if err != nil {
+		return nil, fmt.Errorf("finding path from %v to %v: %w", base, quote, err)
+	}
+	return path, nil
+}
+
+func (g *ConversionGraph) path(base, quote Currency) ([]Currency, error) {
+	if base == quote {
+		return []Currency{base}, nil
+	}
+	// Breadth-first search
+	prev := make(map[Currency]Currency)
+	seen := NewCurrencySet(base)
+	queue := []Currency{base}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		for _, v := range g.adj[u].Currencies() {
+			if seen.Contains(v) {
+				continue
+			}
+			seen = seen.With(v)
+			prev[v] = u
+			if v == quote {
+				return g.unwind(prev, base, quote), nil
+			}
+			queue = append(queue, v)
+		}
+	}
+	return nil, fmt.Errorf("no conversion path")
+}
+
+// unwind reconstructs the path from the base currency to the quote currency
+// using the predecessors found by the breadth-first search.
+func (g *ConversionGraph) unwind(prev map[Currency]Currency, base, quote Currency) []Currency {
+	var path []Currency
+	for c := quote; c != base; c = prev[c] {
+		path = append(path, c)
+	}
+	path = append(path, base)
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// Rate returns the (possibly rounded) rate from the base currency to the quote
+// currency composed along the shortest path, and the path itself.
+// The rates along the path are multiplied one by one, and rates used in
+// the opposite direction are inverted using [ExchangeRate.Inv].
+// Each multiplication and inversion is rounded to [decimal.MaxPrec]
+// significant digits, so the result may differ from the exactly composed
+// rate in the last digits.
+// If the currencies are the same, the result is [IdentityRate].
+// See also methods [ConversionGraph.Path] and [ConversionGraph.Conv].
+//
+// Rate returns an error if:
+//   - the quote currency cannot be reached from the base currency;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func (g *ConversionGraph) Rate(base, quote Currency) (ExchangeRate, []Currency, error) {
+	r, path, err := g.rate(base, quote)
+	if err != nil {
+		return ExchangeRate{}, nil, fmt.Errorf("composing %v/%v rate: %w", base, quote, err)
+	}
+	return r, path, nil
+}
+
+func (g *ConversionGraph) rate(base, quote Currency) (ExchangeRate, []Currency, error) {
+	path, err := g.path(base, quote)
+	if err != nil {
+		return ExchangeRate{}, nil, err
+	}
+	if len(path) == 1 {
+		return IdentityRate(base), path, nil
+	}
+	d := decimal.One
+	for i := 1; i < len(path); i++ {
+		r, err := g.edge(path[i-1], path[i])
+		if err != nil {
+			return ExchangeRate{}, nil, err
+		}
+		d, err = d.Mul(r.Decimal())
+		if err != nil {
+			return ExchangeRate{}, nil, err
+		}
+	}
+	r, err := newExchRateSafe(base, quote, d)
+	if err != nil {
+		return ExchangeRate{}, nil, err
+	}
+	return r, path, nil
+}
+
+// edge returns the rate from currency b to currency q, inverting the rate
+// from currency q to currency b if there is no direct one.
+func (g *ConversionGraph) edge(b, q Currency) (ExchangeRate, error) {
+	if r, ok := g.rates[[2]Currency{b, q}]; ok {
+		return r, nil
+	}
+	return g.rates[[2]Currency{q, b}].inv()
+}
+
+// Conv returns a (possibly rounded) amount converted to the quote currency
+// using the rate composed by [ConversionGraph.Rate], and the path taken.
+//
+// Conv returns an error if:
+//   - the quote currency cannot be reached from the currency of the amount;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func (g *ConversionGraph) Conv(b Amount, quote Currency) (Amount, []Currency, error) {
+	c, path, err := g.conv(b, quote)
+	if err != nil {
+		return Amount{}, nil, fmt.Errorf("converting [%v] to [%v]: %w", b, quote, err)
+	}
+	return c, path, nil
+}
+
+func (g *ConversionGraph) conv(b Amount, quote Currency) (Amount, []Currency, error) {
+	r, path, err := g.rate(b.Curr(), quote)
+	if err != nil {
+		return Amount{}, nil, err
+	}
+	if r.IsIdentity() {
+		return b, path, nil
+	}
+	c, err := r.conv(b)
+	if err != nil {
+		return Amount{}, nil, err
+	}
+	return c, path, nil
+}
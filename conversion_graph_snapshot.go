@@ -0,0 +1,196 @@
+package money
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// snapshotVersion is the version of the snapshot formats produced by
+// [ConversionGraph.SaveJSON] and [ConversionGraph.SaveBinary].
+// It is incremented whenever a format changes, and the Load methods keep
+// reading snapshots produced by previous versions of this package.
+const snapshotVersion = 1
+
+// Rates returns all rates of the graph ordered by base and quote
+// currency codes.
+func (g *ConversionGraph) Rates() []ExchangeRate {
+	rates := make([]ExchangeRate, 0, len(g.rates))
+	for _, r := range g.rates {
+		rates = append(rates, r)
+	}
+	sort.Slice(rates, func(i, j int) bool {
+		bi, bj := rates[i].Base().Code(), rates[j].Base().Code()
+		if bi != bj {
+			return bi < bj
+		}
+		return rates[i].Quote().Code() < rates[j].Quote().Code()
+	})
+	return rates
+}
+
+// replace replaces all rates of the graph with the specified ones.
+func (g *ConversionGraph) replace(rates []ExchangeRate) error {
+	h := &ConversionGraph{bounds: g.bounds}
+	for _, r := range rates {
+		if err := h.Add(r); err != nil {
+			return err
+		}
+	}
+	*g = *h
+	return nil
+}
+
+type jsonSnapshot struct {
+	Version int            `json:"version"`
+	Rates   []jsonRateItem `json:"rates"`
+}
+
+type jsonRateItem struct {
+	Base  string `json:"base"`
+	Quote string `json:"quote"`
+	Rate  string `json:"rate"`
+}
+
+// SaveJSON writes a snapshot of all rates of the graph to w in JSON format,
+// for example:
+//
+//	{"version":1,"rates":[{"base":"EUR","quote":"USD","rate":"1.2500"}]}
+//
+// The rates are ordered by base and quote currency codes, and their scales
+// are preserved, so the same graph always produces the same snapshot.
+// This is useful for persisting the last known rates across restarts.
+// See also method [ConversionGraph.LoadJSON].
+func (g *ConversionGraph) SaveJSON(w io.Writer) error {
+	s := jsonSnapshot{Version: snapshotVersion, Rates: []jsonRateItem{}}
+	for _, r := range g.Rates() {
+		s.Rates = append(s.Rates, jsonRateItem{
+			Base:  r.Base().Code(),
+			Quote: r.Quote().Code(),
+			Rate:  r.Decimal().String(),
+		})
+	}
+	if err := json.NewEncoder(w).Encode(s); err != nil {
+		return fmt.Errorf("saving rates: %w", err)
+	}
+	return nil
+}
+
+// LoadJSON replaces all rates of the graph with the rates read from
+// a snapshot produced by [ConversionGraph.SaveJSON].
+// If the snapshot cannot be read, the graph is not modified.
+//
+// LoadJSON returns an error if:
+//   - the data is not valid JSON;
+//   - the version is greater than the version supported by this package;
+//   - any rate is not valid.
+func (g *ConversionGraph) LoadJSON(r io.Reader) error {
+	if err := g.loadJSON(r); err != nil {
+		return fmt.Errorf("loading rates: %w", err)
+	}
+	return nil
+}
+
+func (g *ConversionGraph) loadJSON(r io.Reader) error {
+	var s jsonSnapshot
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return err
+	}
+	if s.Version < 1 || s.Version > snapshotVersion {
+		return fmt.Errorf("unsupported snapshot version %v", s.Version)
+	}
+	rates := make([]ExchangeRate, len(s.Rates))
+	for i, item := range s.Rates {
+		var err error
+		rates[i], err = ParseExchRate(item.Base, item.Quote, item.Rate)
+		if err != nil {
+			return err
+		}
+	}
+	return g.replace(rates)
+}
+
+// SaveBinary writes a snapshot of all rates of the graph to w in a compact
+// binary format, which consists of the version byte, the number of rates as
+// an unsigned varint, and, for every rate, the 3-byte base currency code,
+// the 3-byte quote currency code, the length of the decimal string as
+// an unsigned varint, and the decimal string.
+// The rates are ordered by base and quote currency codes, and their scales
+// are preserved, so the same graph always produces the same snapshot.
+// See also method [ConversionGraph.LoadBinary].
+func (g *ConversionGraph) SaveBinary(w io.Writer) error {
+	rates := g.Rates()
+	data := make([]byte, 0, 1+binary.MaxVarintLen64+len(rates)*32)
+	data = append(data, snapshotVersion)
+	data = binary.AppendUvarint(data, uint64(len(rates)))
+	for _, r := range rates {
+		s := r.Decimal().String()
+		data = append(data, r.Base().Code()...)
+		data = append(data, r.Quote().Code()...)
+		data = binary.AppendUvarint(data, uint64(len(s)))
+		data = append(data, s...)
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("saving rates: %w", err)
+	}
+	return nil
+}
+
+// LoadBinary replaces all rates of the graph with the rates read from
+// a snapshot produced by [ConversionGraph.SaveBinary].
+// If the snapshot cannot be read, the graph is not modified.
+//
+// LoadBinary returns an error if:
+//   - the data is empty, truncated, or has trailing bytes;
+//   - the version is greater than the version supported by this package;
+//   - any rate is not valid.
+func (g *ConversionGraph) LoadBinary(r io.Reader) error {
+	if err := g.loadBinary(r); err != nil {
+		return fmt.Errorf("loading rates: %w", err)
+	}
+	return nil
+}
+
+func (g *ConversionGraph) loadBinary(r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return fmt.Errorf("no data")
+	}
+	if v := data[0]; v < 1 || v > snapshotVersion {
+		return fmt.Errorf("unsupported snapshot version %v", v)
+	}
+	data = data[1:]
+	n, k := binary.Uvarint(data)
+	if k <= 0 {
+		return fmt.Errorf("data is truncated")
+	}
+	data = data[k:]
+	var rates []ExchangeRate
+	for i := uint64(0); i < n; i++ {
+		if len(data) < 6 {
+			return fmt.Errorf("data is truncated")
+		}
+		base, quote := string(data[:3]), string(data[3:6])
+		data = data[6:]
+		m, k := binary.Uvarint(data)
+		if k <= 0 || uint64(len(data)-k) < m {
+			return fmt.Errorf("data is truncated")
+		}
+		s := string(data[k : k+int(m)])
+		data = data[k+int(m):]
+		q, err := ParseExchRate(base, quote, s)
+		if err != nil {
+			return err
+		}
+		rates = append(rates, q)
+	}
+	if len(data) != 0 {
+		return fmt.Errorf("unexpected trailing data")
+	}
+	return g.replace(rates)
+}
@@ -0,0 +1,173 @@
+package money
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestConversionGraph_Rates(t *testing.T) {
+	g, err := NewConversionGraph(
+		MustParseExchRate("EUR", "USD", "1.25"),
+		MustParseExchRate("USD", "JPY", "150"),
+		MustParseExchRate("GBP", "EUR", "1.2"),
+		MustParseExchRate("CHF", "GBP", "0.8"),
+		MustParseExchRate("AUD", "NZD", "1.1"),
+	)
+	if err != nil {
+		t.Fatalf("NewConversionGraph() failed: %v", err)
+	}
+	got := g.Rates()
+	want := []ExchangeRate{
+		MustParseExchRate("AUD", "NZD", "1.1"),
+		MustParseExchRate("CHF", "GBP", "0.8"),
+		MustParseExchRate("EUR", "USD", "1.25"),
+		MustParseExchRate("GBP", "EUR", "1.2"),
+		MustParseExchRate("USD", "JPY", "150"),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Rates() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Rates()[%v] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestConversionGraph_SaveJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		g, err := NewConversionGraph(
+			MustParseExchRate("EUR", "USD", "1.25"),
+			MustParseExchRate("USD", "JPY", "150"),
+			MustParseExchRate("GBP", "EUR", "1.2"),
+			MustParseExchRate("CHF", "GBP", "0.8"),
+			MustParseExchRate("AUD", "NZD", "1.1"),
+		)
+		if err != nil {
+			t.Fatalf("NewConversionGraph() failed: %v", err)
+		}
+		var buf bytes.Buffer
+		if err := g.SaveJSON(&buf); err != nil {
+			t.Fatalf("SaveJSON() failed: %v", err)
+		}
+		want := `{"version":1,"rates":[` +
+			`{"base":"AUD","quote":"NZD","rate":"1.10"},` +
+			`{"base":"CHF","quote":"GBP","rate":"0.80"},` +
+			`{"base":"EUR","quote":"USD","rate":"1.25"},` +
+			`{"base":"GBP","quote":"EUR","rate":"1.20"},` +
+			`{"base":"USD","quote":"JPY","rate":"150"}]}` + "\n"
+		if buf.String() != want {
+			t.Errorf("SaveJSON() = %q, want %q", buf.String(), want)
+		}
+
+		var h ConversionGraph
+		if err := h.LoadJSON(&buf); err != nil {
+			t.Fatalf("LoadJSON() failed: %v", err)
+		}
+		if got, want := h.Rates(), g.Rates(); !slices.Equal(got, want) {
+			t.Errorf("Rates() = %v, want %v", got, want)
+		}
+
+		var e ConversionGraph
+		buf.Reset()
+		if err := e.SaveJSON(&buf); err != nil {
+			t.Fatalf("SaveJSON() failed: %v", err)
+		}
+		if buf.String() != `{"version":1,"rates":[]}`+"\n" {
+			t.Errorf("SaveJSON() = %q, want empty snapshot", buf.String())
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"json 1":     ``,
+			"json 2":     `{"version":1,"rates":[`,
+			"version 1":  `{"version":0,"rates":[]}`,
+			"version 2":  `{"version":2,"rates":[]}`,
+			"rate 1":     `{"version":1,"rates":[{"base":"EUR","quote":"USD","rate":"-1"}]}`,
+			"currency 1": `{"version":1,"rates":[{"base":"ZZZ","quote":"USD","rate":"1"}]}`,
+			"currency 2": `{"version":1,"rates":[{"base":"USD","quote":"USD","rate":"1"}]}`,
+		}
+		for name, s := range tests {
+			g, err := NewConversionGraph(
+				MustParseExchRate("EUR", "USD", "1.25"),
+				MustParseExchRate("USD", "JPY", "150"),
+				MustParseExchRate("GBP", "EUR", "1.2"),
+				MustParseExchRate("CHF", "GBP", "0.8"),
+				MustParseExchRate("AUD", "NZD", "1.1"),
+			)
+			if err != nil {
+				t.Fatalf("NewConversionGraph() failed: %v", err)
+			}
+			if err := g.LoadJSON(strings.NewReader(s)); err == nil {
+				t.Errorf("LoadJSON(%q) did not fail: %v", s, name)
+			}
+			if g.Len() != 5 {
+				t.Errorf("LoadJSON(%q) modified the graph: %v", s, name)
+			}
+		}
+	})
+}
+
+func TestConversionGraph_SaveBinary(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		g, err := NewConversionGraph(
+			MustParseExchRate("EUR", "USD", "1.2500"),
+			MustParseExchRate("USD", "JPY", "150"),
+		)
+		if err != nil {
+			t.Fatalf("NewConversionGraph() failed: %v", err)
+		}
+		var buf bytes.Buffer
+		if err := g.SaveBinary(&buf); err != nil {
+			t.Fatalf("SaveBinary() failed: %v", err)
+		}
+		want := "\x01\x02EURUSD\x061.2500USDJPY\x03150"
+		if buf.String() != want {
+			t.Errorf("SaveBinary() = %q, want %q", buf.String(), want)
+		}
+
+		var h ConversionGraph
+		if err := h.LoadBinary(&buf); err != nil {
+			t.Fatalf("LoadBinary() failed: %v", err)
+		}
+		if got, want := h.Rates(), g.Rates(); !slices.Equal(got, want) {
+			t.Errorf("Rates() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"empty 1":     "",
+			"version 1":   "\x00\x00",
+			"version 2":   "\x02\x00",
+			"truncated 1": "\x01",
+			"truncated 2": "\x01\x01EURUS",
+			"truncated 3": "\x01\x01EURUSD",
+			"truncated 4": "\x01\x01EURUSD\x061.25",
+			"trailing 1":  "\x01\x00\x00",
+			"rate 1":      "\x01\x01EURUSD\x02-1",
+			"currency 1":  "\x01\x01ZZZUSD\x011",
+		}
+		for name, s := range tests {
+			g, err := NewConversionGraph(
+				MustParseExchRate("EUR", "USD", "1.25"),
+				MustParseExchRate("USD", "JPY", "150"),
+				MustParseExchRate("GBP", "EUR", "1.2"),
+				MustParseExchRate("CHF", "GBP", "0.8"),
+				MustParseExchRate("AUD", "NZD", "1.1"),
+			)
+			if err != nil {
+				t.Fatalf("NewConversionGraph() failed: %v", err)
+			}
+			if err := g.LoadBinary(strings.NewReader(s)); err == nil {
+				t.Errorf("LoadBinary(%q) did not fail: %v", s, name)
+			}
+			if g.Len() != 5 {
+				t.Errorf("LoadBinary(%q) modified the graph: %v", s, name)
+			}
+		}
+	})
+}
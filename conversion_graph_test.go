@@ -0,0 +1,325 @@
+package money
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/govalues/decimal"
+)
+
+func TestNewConversionGraph(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		g, err := NewConversionGraph(
+			MustParseExchRate("EUR", "USD", "1.25"),
+			MustParseExchRate("USD", "JPY", "150"),
+			MustParseExchRate("GBP", "EUR", "1.2"),
+			MustParseExchRate("CHF", "GBP", "0.8"),
+			MustParseExchRate("AUD", "NZD", "1.1"),
+		)
+		if err != nil {
+			t.Fatalf("NewConversionGraph() failed: %v", err)
+		}
+		if g.Len() != 5 {
+			t.Errorf("Len() = %v, want 5", g.Len())
+		}
+		// Replacing
+		err = g.Add(MustParseExchRate("EUR", "USD", "1.1"))
+		if err != nil {
+			t.Fatalf("Add() failed: %v", err)
+		}
+		if g.Len() != 5 {
+			t.Errorf("Len() = %v, want 5", g.Len())
+		}
+		// Zero value
+		var z ConversionGraph
+		err = z.Add(MustParseExchRate("EUR", "USD", "1.1"))
+		if err != nil {
+			t.Fatalf("Add() failed: %v", err)
+		}
+		if z.Len() != 1 {
+			t.Errorf("Len() = %v, want 1", z.Len())
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string][]ExchangeRate{
+			"zero rate":       {{}},
+			"same currencies": {MustParseExchRate("USD", "USD", "1")},
+		}
+		for name, rates := range tests {
+			_, err := NewConversionGraph(rates...)
+			if err == nil {
+				t.Errorf("NewConversionGraph(%v) did not fail", name)
+			}
+		}
+	})
+}
+
+func TestConversionGraph_SetBounds(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		g, err := NewConversionGraph(
+			MustParseExchRate("EUR", "USD", "1.25"),
+			MustParseExchRate("USD", "JPY", "150"),
+			MustParseExchRate("GBP", "EUR", "1.2"),
+			MustParseExchRate("CHF", "GBP", "0.8"),
+			MustParseExchRate("AUD", "NZD", "1.1"),
+		)
+		if err != nil {
+			t.Fatalf("NewConversionGraph() failed: %v", err)
+		}
+		min, max := decimal.MustParse("1"), decimal.MustParse("1.5")
+		if err := g.SetBounds(EUR, USD, min, max); err != nil {
+			t.Fatalf("SetBounds(EUR, USD, %v, %v) failed: %v", min, max, err)
+		}
+		gotMin, gotMax, ok := g.Bounds(EUR, USD)
+		if !ok || gotMin != min || gotMax != max {
+			t.Errorf("Bounds(EUR, USD) = %v, %v, %v, want %v, %v, true", gotMin, gotMax, ok, min, max)
+		}
+		if _, _, ok := g.Bounds(USD, EUR); ok {
+			t.Errorf("Bounds(USD, EUR) returned bounds for the opposite direction")
+		}
+		for _, s := range []string{"1", "1.2", "1.5"} {
+			r := MustParseExchRate("EUR", "USD", s)
+			if err := g.Add(r); err != nil {
+				t.Errorf("Add(%q) failed: %v", r, err)
+			}
+		}
+		for _, s := range []string{"0.9999", "1.5001", "12.5"} {
+			r := MustParseExchRate("EUR", "USD", s)
+			if err := g.Add(r); err == nil {
+				t.Errorf("Add(%q) did not fail", r)
+			}
+		}
+		got, _, err := g.Rate(EUR, USD)
+		if err != nil {
+			t.Fatalf("Rate(EUR, USD) failed: %v", err)
+		}
+		if want := MustParseExchRate("EUR", "USD", "1.5"); got != want {
+			t.Errorf("Rate(EUR, USD) = %q, want %q", got, want)
+		}
+		// Inverse direction is not bounded
+		r := MustParseExchRate("USD", "EUR", "10")
+		if err := g.Add(r); err != nil {
+			t.Errorf("Add(%q) failed: %v", r, err)
+		}
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		g, err := NewConversionGraph(
+			MustParseExchRate("EUR", "USD", "1.25"),
+			MustParseExchRate("USD", "JPY", "150"),
+			MustParseExchRate("GBP", "EUR", "1.2"),
+			MustParseExchRate("CHF", "GBP", "0.8"),
+			MustParseExchRate("AUD", "NZD", "1.1"),
+		)
+		if err != nil {
+			t.Fatalf("NewConversionGraph() failed: %v", err)
+		}
+		if err := g.SetBounds(USD, JPY, decimal.MustParse("100"), decimal.MustParse("200")); err != nil {
+			t.Fatalf("SetBounds(USD, JPY) failed: %v", err)
+		}
+		s := `{"version":1,"rates":[{"base":"USD","quote":"JPY","rate":"1500"}]}`
+		if err := g.LoadJSON(strings.NewReader(s)); err == nil {
+			t.Errorf("LoadJSON(%q) did not fail", s)
+		}
+		s = `{"version":1,"rates":[{"base":"USD","quote":"JPY","rate":"155"}]}`
+		if err := g.LoadJSON(strings.NewReader(s)); err != nil {
+			t.Errorf("LoadJSON(%q) failed: %v", s, err)
+		}
+		if _, _, ok := g.Bounds(USD, JPY); !ok {
+			t.Errorf("LoadJSON(%q) discarded the bounds", s)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			base, quote Currency
+			min, max    string
+		}{
+			"currency 1": {USD, USD, "1", "2"},
+			"bounds 1":   {EUR, USD, "0", "2"},
+			"bounds 2":   {EUR, USD, "-1", "2"},
+			"bounds 3":   {EUR, USD, "2", "1"},
+			"existing 1": {EUR, USD, "1.3", "2"},
+			"existing 2": {EUR, USD, "1", "1.2"},
+		}
+		for name, tt := range tests {
+			g, err := NewConversionGraph(
+				MustParseExchRate("EUR", "USD", "1.25"),
+				MustParseExchRate("USD", "JPY", "150"),
+				MustParseExchRate("GBP", "EUR", "1.2"),
+				MustParseExchRate("CHF", "GBP", "0.8"),
+				MustParseExchRate("AUD", "NZD", "1.1"),
+			)
+			if err != nil {
+				t.Fatalf("NewConversionGraph() failed: %v", err)
+			}
+			min, max := decimal.MustParse(tt.min), decimal.MustParse(tt.max)
+			if err := g.SetBounds(tt.base, tt.quote, min, max); err == nil {
+				t.Errorf("SetBounds(%v, %v, %v, %v) did not fail: %v", tt.base, tt.quote, min, max, name)
+			}
+		}
+	})
+}
+
+func TestConversionGraph_Rate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			base, quote Currency
+			want        string
+			wantPath    []Currency
+		}{
+			{USD, USD, "1.00", []Currency{USD}},
+			{EUR, USD, "1.25", []Currency{EUR, USD}},
+			{USD, EUR, "0.8", []Currency{USD, EUR}},
+			{EUR, JPY, "187.5", []Currency{EUR, USD, JPY}},
+			{GBP, JPY, "225", []Currency{GBP, EUR, USD, JPY}},
+			{CHF, USD, "1.2", []Currency{CHF, GBP, EUR, USD}},
+			{NZD, AUD, "0.9090909090909090909", []Currency{NZD, AUD}},
+			{JPY, EUR, "0.0053333333333333334", []Currency{JPY, USD, EUR}}, // intermediate rounding
+		}
+		g, err := NewConversionGraph(
+			MustParseExchRate("EUR", "USD", "1.25"),
+			MustParseExchRate("USD", "JPY", "150"),
+			MustParseExchRate("GBP", "EUR", "1.2"),
+			MustParseExchRate("CHF", "GBP", "0.8"),
+			MustParseExchRate("AUD", "NZD", "1.1"),
+		)
+		if err != nil {
+			t.Fatalf("NewConversionGraph() failed: %v", err)
+		}
+		for _, tt := range tests {
+			got, gotPath, err := g.Rate(tt.base, tt.quote)
+			if err != nil {
+				t.Errorf("Rate(%v, %v) failed: %v", tt.base, tt.quote, err)
+				continue
+			}
+			want := MustParseExchRate(tt.base.Code(), tt.quote.Code(), tt.want)
+			if got.Decimal().Cmp(want.Decimal()) != 0 {
+				t.Errorf("Rate(%v, %v) = %q, want %q", tt.base, tt.quote, got, want)
+			}
+			if !slices.Equal(gotPath, tt.wantPath) {
+				t.Errorf("Rate(%v, %v) path = %v, want %v", tt.base, tt.quote, gotPath, tt.wantPath)
+			}
+		}
+	})
+
+	t.Run("shortest", func(t *testing.T) {
+		g, err := NewConversionGraph(
+			MustParseExchRate("EUR", "USD", "1.25"),
+			MustParseExchRate("USD", "JPY", "150"),
+			MustParseExchRate("GBP", "EUR", "1.2"),
+			MustParseExchRate("CHF", "GBP", "0.8"),
+			MustParseExchRate("AUD", "NZD", "1.1"),
+		)
+		if err != nil {
+			t.Fatalf("NewConversionGraph() failed: %v", err)
+		}
+		err = g.Add(MustParseExchRate("CHF", "JPY", "170"))
+		if err != nil {
+			t.Fatalf("Add() failed: %v", err)
+		}
+		got, path, err := g.Rate(GBP, JPY)
+		if err != nil {
+			t.Fatalf("Rate(GBP, JPY) failed: %v", err)
+		}
+		wantPath := []Currency{GBP, CHF, JPY}
+		if !slices.Equal(path, wantPath) {
+			t.Errorf("Rate(GBP, JPY) path = %v, want %v", path, wantPath)
+		}
+		want := MustParseExchRate("GBP", "JPY", "212.5")
+		if got.Decimal().Cmp(want.Decimal()) != 0 {
+			t.Errorf("Rate(GBP, JPY) = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			base, quote Currency
+		}{
+			{EUR, AUD},
+			{NZD, JPY},
+			{EUR, SEK},
+			{SEK, EUR},
+		}
+		g, err := NewConversionGraph(
+			MustParseExchRate("EUR", "USD", "1.25"),
+			MustParseExchRate("USD", "JPY", "150"),
+			MustParseExchRate("GBP", "EUR", "1.2"),
+			MustParseExchRate("CHF", "GBP", "0.8"),
+			MustParseExchRate("AUD", "NZD", "1.1"),
+		)
+		if err != nil {
+			t.Fatalf("NewConversionGraph() failed: %v", err)
+		}
+		for _, tt := range tests {
+			_, _, err := g.Rate(tt.base, tt.quote)
+			if err == nil {
+				t.Errorf("Rate(%v, %v) did not fail", tt.base, tt.quote)
+			}
+			_, err = g.Path(tt.base, tt.quote)
+			if err == nil {
+				t.Errorf("Path(%v, %v) did not fail", tt.base, tt.quote)
+			}
+		}
+	})
+}
+
+func TestConversionGraph_Conv(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, b  string
+			quote    Currency
+			want     string
+			wantPath []Currency
+		}{
+			{"EUR", "10", JPY, "1875.00", []Currency{EUR, USD, JPY}},
+			{"USD", "10", EUR, "8.00", []Currency{USD, EUR}},
+			{"USD", "5.67", USD, "5.67", []Currency{USD}},
+		}
+		g, err := NewConversionGraph(
+			MustParseExchRate("EUR", "USD", "1.25"),
+			MustParseExchRate("USD", "JPY", "150"),
+			MustParseExchRate("GBP", "EUR", "1.2"),
+			MustParseExchRate("CHF", "GBP", "0.8"),
+			MustParseExchRate("AUD", "NZD", "1.1"),
+		)
+		if err != nil {
+			t.Fatalf("NewConversionGraph() failed: %v", err)
+		}
+		for _, tt := range tests {
+			b := MustParseAmount(tt.curr, tt.b)
+			got, gotPath, err := g.Conv(b, tt.quote)
+			if err != nil {
+				t.Errorf("Conv(%q, %v) failed: %v", b, tt.quote, err)
+				continue
+			}
+			want := MustParseAmount(tt.quote.Code(), tt.want)
+			if got.Decimal().Cmp(want.Decimal()) != 0 || got.Curr() != want.Curr() {
+				t.Errorf("Conv(%q, %v) = %q, want %q", b, tt.quote, got, want)
+			}
+			if !slices.Equal(gotPath, tt.wantPath) {
+				t.Errorf("Conv(%q, %v) path = %v, want %v", b, tt.quote, gotPath, tt.wantPath)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		g, err := NewConversionGraph(
+			MustParseExchRate("EUR", "USD", "1.25"),
+			MustParseExchRate("USD", "JPY", "150"),
+			MustParseExchRate("GBP", "EUR", "1.2"),
+			MustParseExchRate("CHF", "GBP", "0.8"),
+			MustParseExchRate("AUD", "NZD", "1.1"),
+		)
+		if err != nil {
+			t.Fatalf("NewConversionGraph() failed: %v", err)
+		}
+		b := MustParseAmount("EUR", "10")
+		_, _, err = g.Conv(b, AUD)
+		if err == nil {
+			t.Errorf("Conv(%q, AUD) did not fail", b)
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/govalues/decimal"
+)
+
+// RateChange describes a rate replaced by [ConversionGraph.ApplyUpdate].
+type RateChange struct {
+	// Old is the replaced rate.
+	Old ExchangeRate
+	// New is the replacing rate.
+	New ExchangeRate
+	// Pct is the change of the rate as a percentage rounded to
+	// [RateChangeScale] digits after the decimal point,
+	// see [ExchangeRate.ChangeSince].
+	Pct decimal.Decimal
+}
+
+// RateChangeScale is the number of digits after the decimal point of
+// the percentages reported by [ConversionGraph.ApplyUpdate], which
+// corresponds to a hundredth of a basis point.
+const RateChangeScale = 4
+
+// RateUpdateReport describes the effect of [ConversionGraph.ApplyUpdate].
+// Rates equal to the rates already in the graph are not reported.
+type RateUpdateReport struct {
+	// Added holds the rates for currencies that were not quoted in the same
+	// direction in the graph.
+	Added []ExchangeRate
+	// Removed holds the rates replaced by rates quoted in the opposite
+	// direction, for example, "USD/EUR" replaced by "EUR/USD".
+	Removed []ExchangeRate
+	// Changed holds the rates replaced by different rates quoted in
+	// the same direction.
+	Changed []RateChange
+}
+
+// ApplyUpdate adds the rates to the graph as a single batch, replacing
+// the rates for the same currency pairs, and reports the changes.
+// A rate replaces the existing rate for the same currencies quoted in either
+// direction, so the graph never holds both "EUR/USD" and "USD/EUR".
+// The batch is applied atomically: if any rate is rejected, the graph is left
+// unchanged.
+// Like other modifications, ApplyUpdate must not be called concurrently with
+// reads of the graph.
+// See also methods [ConversionGraph.Add] and [ConversionGraph.SetBounds].
+//
+// ApplyUpdate returns an error if:
+//   - the batch contains more than one rate for the same currency pair;
+//   - any rate cannot be added, for example, because it is outside
+//     the plausibility bounds.
+func (g *ConversionGraph) ApplyUpdate(rates []ExchangeRate) (RateUpdateReport, error) {
+	rep, err := g.applyUpdate(rates)
+	if err != nil {
+		return RateUpdateReport{}, fmt.Errorf("applying rate update: %w", err)
+	}
+	return rep, nil
+}
+
+func (g *ConversionGraph) applyUpdate(rates []ExchangeRate) (RateUpdateReport, error) {
+	// Duplicates
+	seen := make(map[[2]Currency]bool, len(rates))
+	for _, r := range rates {
+		b, q := r.Base(), r.Quote()
+		if seen[[2]Currency{b, q}] || seen[[2]Currency{q, b}] {
+			return RateUpdateReport{}, fmt.Errorf("duplicate rate for %v/%v", b, q)
+		}
+		seen[[2]Currency{b, q}] = true
+	}
+
+	// New rates
+	var rep RateUpdateReport
+	next := make([]ExchangeRate, 0, len(g.rates)+len(rates))
+	for key, r := range g.rates {
+		if !seen[key] && !seen[[2]Currency{key[1], key[0]}] {
+			next = append(next, r)
+		}
+	}
+	for _, r := range rates {
+		b, q := r.Base(), r.Quote()
+		old, ok := g.rates[[2]Currency{b, q}]
+		switch {
+		case ok && old == r:
+			// unchanged
+		case ok:
+			pct, err := r.ChangeSince(old, RateChangeScale)
+			if err != nil {
+				return RateUpdateReport{}, err
+			}
+			rep.Changed = append(rep.Changed, RateChange{Old: old, New: r, Pct: pct})
+		default:
+			if inv, ok := g.rates[[2]Currency{q, b}]; ok {
+				rep.Removed = append(rep.Removed, inv)
+			}
+			rep.Added = append(rep.Added, r)
+		}
+		next = append(next, r)
+	}
+
+	// Atomic swap
+	if err := g.replace(next); err != nil {
+		return RateUpdateReport{}, err
+	}
+	return rep, nil
+}
@@ -0,0 +1,109 @@
+package money
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/govalues/decimal"
+)
+
+func TestConversionGraph_ApplyUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		g, err := NewConversionGraph(
+			MustParseExchRate("EUR", "USD", "1.25"),
+			MustParseExchRate("USD", "JPY", "150"),
+			MustParseExchRate("GBP", "EUR", "1.2"),
+			MustParseExchRate("CHF", "GBP", "0.8"),
+			MustParseExchRate("AUD", "NZD", "1.1"),
+		)
+		if err != nil {
+			t.Fatalf("NewConversionGraph() failed: %v", err)
+		}
+		rep, err := g.ApplyUpdate([]ExchangeRate{
+			MustParseExchRate("EUR", "USD", "1.25"),  // unchanged
+			MustParseExchRate("USD", "JPY", "151.5"), // changed
+			MustParseExchRate("NZD", "AUD", "0.9"),   // inverse of AUD/NZD
+			MustParseExchRate("USD", "CAD", "1.35"),  // new
+		})
+		if err != nil {
+			t.Fatalf("ApplyUpdate() failed: %v", err)
+		}
+
+		wantAdded := []ExchangeRate{
+			MustParseExchRate("NZD", "AUD", "0.9"),
+			MustParseExchRate("USD", "CAD", "1.35"),
+		}
+		if !slices.Equal(rep.Added, wantAdded) {
+			t.Errorf("ApplyUpdate().Added = %v, want %v", rep.Added, wantAdded)
+		}
+		wantRemoved := []ExchangeRate{MustParseExchRate("AUD", "NZD", "1.1")}
+		if !slices.Equal(rep.Removed, wantRemoved) {
+			t.Errorf("ApplyUpdate().Removed = %v, want %v", rep.Removed, wantRemoved)
+		}
+		wantChanged := []RateChange{{
+			Old: MustParseExchRate("USD", "JPY", "150"),
+			New: MustParseExchRate("USD", "JPY", "151.5"),
+			Pct: decimal.MustParse("1.0000"),
+		}}
+		if !slices.Equal(rep.Changed, wantChanged) {
+			t.Errorf("ApplyUpdate().Changed = %v, want %v", rep.Changed, wantChanged)
+		}
+
+		wantRates := []ExchangeRate{
+			MustParseExchRate("CHF", "GBP", "0.8"),
+			MustParseExchRate("EUR", "USD", "1.25"),
+			MustParseExchRate("GBP", "EUR", "1.2"),
+			MustParseExchRate("NZD", "AUD", "0.9"),
+			MustParseExchRate("USD", "CAD", "1.35"),
+			MustParseExchRate("USD", "JPY", "151.5"),
+		}
+		if got := g.Rates(); !slices.Equal(got, wantRates) {
+			t.Errorf("Rates() = %v, want %v", got, wantRates)
+		}
+		if _, _, err := g.Rate(AUD, NZD); err != nil {
+			t.Errorf("Rate(AUD, NZD) failed: %v", err)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string][]ExchangeRate{
+			"duplicate 1": {
+				MustParseExchRate("USD", "JPY", "151"),
+				MustParseExchRate("USD", "JPY", "152"),
+			},
+			"duplicate 2": {
+				MustParseExchRate("USD", "JPY", "151"),
+				MustParseExchRate("JPY", "USD", "0.0066"),
+			},
+			"bounds 1": {
+				MustParseExchRate("USD", "CAD", "1.35"),
+				MustParseExchRate("EUR", "USD", "12.5"),
+			},
+		}
+		for name, rates := range tests {
+			t.Run(name, func(t *testing.T) {
+				g, err := NewConversionGraph(
+					MustParseExchRate("EUR", "USD", "1.25"),
+					MustParseExchRate("USD", "JPY", "150"),
+					MustParseExchRate("GBP", "EUR", "1.2"),
+					MustParseExchRate("CHF", "GBP", "0.8"),
+					MustParseExchRate("AUD", "NZD", "1.1"),
+				)
+				if err != nil {
+					t.Fatalf("NewConversionGraph() failed: %v", err)
+				}
+				if err := g.SetBounds(EUR, USD, decimal.MustParse("1"), decimal.MustParse("2")); err != nil {
+					t.Fatalf("SetBounds() failed: %v", err)
+				}
+				want := g.Rates()
+				_, err = g.ApplyUpdate(rates)
+				if err == nil {
+					t.Errorf("ApplyUpdate(%v) did not fail", rates)
+				}
+				if got := g.Rates(); !slices.Equal(got, want) {
+					t.Errorf("ApplyUpdate(%v) modified the graph: Rates() = %v, want %v", rates, got, want)
+				}
+			})
+		}
+	})
+}
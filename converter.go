@@ -0,0 +1,80 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/govalues/decimal"
+)
+
+// Converter is a prepared form of an exchange rate for converting large
+// numbers of amounts using the same rate.
+// It produces exactly the same results as [ExchangeRate.Conv], but
+// precomputes the coefficient and the scale of the rate, so that conversions
+// of amounts whose product with the rate fits into a 64-bit integer avoid
+// most of the validation and the general decimal multiplication.
+// Other conversions fall back to [ExchangeRate.Conv].
+// The zero value converts nothing and returns an error for every amount.
+// This type is designed to be safe for concurrent use by multiple goroutines.
+type Converter struct {
+	rate  ExchangeRate
+	coef  uint64 // coefficient of the rate
+	scale int    // scale of the rate
+	ok    bool   // true if the rate can convert amounts in its base currency
+}
+
+// Converter returns a converter that converts amounts using the rate.
+// See also method [ExchangeRate.Conv].
+func (r ExchangeRate) Converter() Converter {
+	d := r.Decimal()
+	return Converter{
+		rate:  r,
+		coef:  d.Coef(),
+		scale: d.Scale(),
+		ok:    r.Base() != XXX && r.Quote() != XXX && r.IsPos(),
+	}
+}
+
+// Rate returns the exchange rate used by the converter.
+func (cv Converter) Rate() ExchangeRate {
+	return cv.rate
+}
+
+// Conv returns a (possibly rounded) amount converted from the base currency
+// to the quote currency of the rate.
+// See also method [ExchangeRate.Conv].
+//
+// Conv returns an error if:
+//   - the base currency of the exchange rate does not match the currency of the given amount.
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func (cv Converter) Conv(b Amount) (Amount, error) {
+	c, err := cv.conv(b)
+	if err != nil {
+		return Amount{}, fmt.Errorf("converting [%v] to [%v]: %w", b, cv.rate.Quote(), err)
+	}
+	return c, nil
+}
+
+func (cv Converter) conv(b Amount) (Amount, error) {
+	coef, scale, neg, curr := b.Components()
+	if !cv.ok || curr != cv.rate.Base() {
+		return Amount{}, mismatchError(cv.rate.Base(), curr)
+	}
+	// Fast path
+	hi, lo := bits.Mul64(coef, cv.coef)
+	scale += cv.scale
+	if hi == 0 && lo <= math.MaxInt64 && scale <= decimal.MaxScale {
+		v := int64(lo)
+		if neg {
+			v = -v
+		}
+		d, err := decimal.New(v, scale)
+		if err == nil {
+			return newAmountSafe(cv.rate.Quote(), d)
+		}
+	}
+	// Slow path
+	return cv.rate.conv(b)
+}
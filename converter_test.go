@@ -0,0 +1,92 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestExchangeRate_Converter(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			b, q, r string
+			amounts []string
+		}{
+			{"EUR", "USD", "1.2345", []string{"0", "0.01", "-0.01", "5.67", "-5.67", "1000000", "99999999999"}},
+			{"USD", "JPY", "149.876", []string{"0", "0.01", "5.67", "123456789.12", "-99999999999999.99"}},
+			{"USD", "EUR", "0.9876543210987654321", []string{"0.01", "5.67", "1000000", "-12345678.90"}},
+			{"JPY", "USD", "0.0067", []string{"1", "100", "-12345", "9999999999999999"}},
+			{"EUR", "OMR", "0.42", []string{"5.6789", "0.0001"}},
+			{"USD", "USD", "1", []string{"5.67", "-0.01"}},
+		}
+		for _, tt := range tests {
+			r := MustParseExchRate(tt.b, tt.q, tt.r)
+			cv := r.Converter()
+			if cv.Rate() != r {
+				t.Errorf("%q.Converter().Rate() = %q, want %q", r, cv.Rate(), r)
+			}
+			for _, b := range MustParseAmountSlice(tt.b, tt.amounts) {
+				want, wantErr := r.Conv(b)
+				got, gotErr := cv.Conv(b)
+				if (gotErr == nil) != (wantErr == nil) {
+					t.Errorf("%q.Converter().Conv(%q) error = %v, want %v", r, b, gotErr, wantErr)
+					continue
+				}
+				if got != want {
+					t.Errorf("%q.Converter().Conv(%q) = %q, want %q", r, b, got, want)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			r ExchangeRate
+			b Amount
+		}{
+			"zero rate":         {ExchangeRate{}, MustParseAmount("XXX", "1")},
+			"currency mismatch": {MustParseExchRate("EUR", "USD", "1.2"), MustParseAmount("JPY", "1")},
+			"overflow":          {MustParseExchRate("EUR", "USD", "1000"), MustParseAmount("EUR", "99999999999999999")},
+		}
+		for name, tt := range tests {
+			_, err := tt.r.Converter().Conv(tt.b)
+			if err == nil {
+				t.Errorf("%q.Converter().Conv(%q) did not fail: %v", tt.r, tt.b, name)
+			}
+		}
+	})
+}
+
+func BenchmarkExchangeRate_Conv(b *testing.B) {
+	r := MustParseExchRate("EUR", "USD", "1.0845")
+	amounts := MustParseAmountSlice("EUR", []string{"0.01", "5.67", "123.45", "-987654.32"})
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := r.Conv(amounts[i%len(amounts)])
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkExchangeRate_Conv_zero(b *testing.B) {
+	r := MustParseExchRate("EUR", "USD", "1.0845")
+	a := MustParseAmount("EUR", "0.00")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := r.Conv(a)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkConverter_Conv(b *testing.B) {
+	cv := MustParseExchRate("EUR", "USD", "1.0845").Converter()
+	amounts := MustParseAmountSlice("EUR", []string{"0.01", "5.67", "123.45", "-987654.32"})
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := cv.Conv(amounts[i%len(amounts)])
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"database/sql/driver"
 	"errors"
 	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
 )
 
 //go:generate go run scripts/currency/codegen.go
@@ -20,6 +23,9 @@ import (
 // the [Currency.Code] method, rather than the integer index, as mapping between
 // index and a particular currency may change in future versions.
 //
+// Currencies that are not defined by ISO 4217, such as commodities or
+// crypto assets, can be added using [RegisterCurr].
+//
 // [ISO 4217]: https://en.wikipedia.org/wiki/ISO_4217
 type Currency uint8
 
@@ -34,7 +40,7 @@ var errUnknownCurrency = errors.New("unknown currency")
 //
 // ParseCurr returns an error if the string does not represent a valid currency code.
 func ParseCurr(curr string) (Currency, error) {
-	c, ok := currLookup[curr]
+	c, ok := loadCurrencies().lookup[curr]
 	if !ok {
 		return XXX, errUnknownCurrency
 	}
@@ -65,7 +71,7 @@ func MustParseCurr(curr string) Currency {
 // [US Dollar]: https://en.wikipedia.org/wiki/United_States_dollar
 // [Omani Rial]: https://en.wikipedia.org/wiki/Omani_rial
 func (c Currency) Scale() int {
-	return int(scaleLookup[c])
+	return int(loadCurrencies().scales[c])
 }
 
 // Num returns the [3-digit code] assigned to the currency by the ISO 4217 standard.
@@ -74,7 +80,7 @@ func (c Currency) Scale() int {
 // [3-digit code]: https://en.wikipedia.org/wiki/ISO_4217#Numeric_codes
 // [code]: https://en.wikipedia.org/wiki/ISO_4217#X_currencies_(funds,_precious_metals,_supranationals,_other)
 func (c Currency) Num() string {
-	return numLookup[c]
+	return loadCurrencies().nums[c]
 }
 
 // Code returns the [3-letter code] assigned to the currency by the ISO 4217 standard.
@@ -84,7 +90,7 @@ func (c Currency) Num() string {
 //
 // [3-letter code]: https://en.wikipedia.org/wiki/ISO_4217#National_currencies
 func (c Currency) Code() string {
-	return codeLookup[c]
+	return loadCurrencies().codes[c]
 }
 
 // String method implements the [fmt.Stringer] interface and returns
@@ -139,6 +145,49 @@ func (c Currency) Value() (driver.Value, error) {
 	return c.String(), nil
 }
 
+// UnmarshalGQL implements the [graphql.Unmarshaler] interface of gqlgen,
+// so currencies can be used as custom GraphQL scalars.
+// It accepts strings supported by [ParseCurr].
+//
+// [graphql.Unmarshaler]: https://gqlgen.com/reference/scalars/
+func (c *Currency) UnmarshalGQL(v any) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("converting from %T to %T: type %T is not supported", v, c, v)
+	}
+	var err error
+	*c, err = ParseCurr(s)
+	return err
+}
+
+// MarshalGQL implements the [graphql.Marshaler] interface of gqlgen.
+// It writes the alphabetic code of the currency as a quoted string.
+//
+// [graphql.Marshaler]: https://gqlgen.com/reference/scalars/
+func (c Currency) MarshalGQL(w io.Writer) {
+	_, _ = io.WriteString(w, strconv.Quote(c.Code()))
+}
+
+// GormDataType returns the general data type used by [GORM] for storing
+// the currency, so that currencies can be used as model fields without
+// bespoke value converters.
+//
+// [GORM]: https://gorm.io/docs/data_types.html
+func (c Currency) GormDataType() string {
+	return "string"
+}
+
+// LogValue implements the [slog.LogValuer] interface.
+// It returns a group with the alphabetic code and the scale of the currency.
+//
+// [slog.LogValuer]: https://pkg.go.dev/log/slog#LogValuer
+func (c Currency) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("code", c.Code()),
+		slog.Int("scale", c.Scale()),
+	)
+}
+
 // Format implements the [fmt.Formatter] interface.
 // The following [format verbs] are available:
 //
@@ -0,0 +1,30 @@
+package money
+
+// G10Currencies returns the set of the ten most heavily traded currencies
+// of the foreign exchange market: AUD, CAD, CHF, EUR, GBP, JPY, NOK, NZD,
+// SEK, and USD.
+// It is useful for expressing rules such as "only G10 pairs are allowed".
+func G10Currencies() CurrencySet {
+	return NewCurrencySet(AUD, CAD, CHF, EUR, GBP, JPY, NOK, NZD, SEK, USD)
+}
+
+// EUCurrencies returns the set of currencies issued by the member states
+// of the European Union as of 2026: CZK, DKK, EUR, HUF, PLN, RON, and SEK.
+func EUCurrencies() CurrencySet {
+	return NewCurrencySet(CZK, DKK, EUR, HUF, PLN, RON, SEK)
+}
+
+// ZeroDecimalCurrencies returns the set of known currencies without minor
+// units, that is, the currencies whose scale is 0, such as JPY and KRW,
+// including registered ones, but excluding [XXX].
+// See also method [Currency.Scale].
+func ZeroDecimalCurrencies() CurrencySet {
+	var s CurrencySet
+	t := loadCurrencies()
+	for i := 1; i < t.size; i++ { // skipping XXX
+		if t.scales[i] == 0 {
+			s = s.With(Currency(i))
+		}
+	}
+	return s
+}
@@ -0,0 +1,104 @@
+package money
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/govalues/decimal"
+)
+
+// scaleChange describes a scale of a currency that was in effect
+// before a particular time.
+type scaleChange struct {
+	until time.Time // time when the scale was no longer in effect
+	scale int
+}
+
+// RegisterScaleChange records that the currency used the specified scale
+// before time until, which is useful for currencies whose minor units changed
+// over time.
+// The recorded scales are returned by [Currency.ScaleAt] and used by
+// [ParseAmountAt], while [Currency.Scale] always returns the current scale.
+// If several changes are recorded for the same currency, each scale is in
+// effect from the previous change until its own one.
+// RegisterScaleChange is safe for concurrent use by multiple goroutines, but
+// it is intended to be called during program initialization.
+// See also function [RegisterCurr].
+//
+// RegisterScaleChange returns an error if:
+//   - the currency code is not valid;
+//   - the scale is negative or greater than [decimal.MaxScale].
+func RegisterScaleChange(code string, until time.Time, scale int) error {
+	err := registerScaleChange(code, until, scale)
+	if err != nil {
+		return fmt.Errorf("registering scale change of %q: %w", code, err)
+	}
+	return nil
+}
+
+func registerScaleChange(code string, until time.Time, scale int) error {
+	if scale < 0 || scale > decimal.MaxScale {
+		return fmt.Errorf("scale %v out of range", scale)
+	}
+
+	currenciesMu.Lock()
+	defer currenciesMu.Unlock()
+
+	t := loadCurrencies().clone()
+	c, ok := t.lookup[code]
+	if !ok {
+		return errUnknownCurrency
+	}
+	h := slices.Clone(t.history[c])
+	i, found := slices.BinarySearchFunc(h, until, func(ch scaleChange, u time.Time) int {
+		return ch.until.Compare(u)
+	})
+	if found {
+		h[i].scale = scale
+	} else {
+		h = slices.Insert(h, i, scaleChange{until: until, scale: scale})
+	}
+	t.history[c] = h
+	currencies.Store(t)
+	return nil
+}
+
+// ScaleAt returns the number of digits after the decimal point that
+// the currency used at time t, as recorded by [RegisterScaleChange].
+// If no scale changes have been recorded for the currency after time t,
+// the result is the same as [Currency.Scale].
+// This method is useful for processing historical data.
+// See also function [ParseAmountAt].
+func (c Currency) ScaleAt(t time.Time) int {
+	for _, ch := range loadCurrencies().history[c] {
+		if t.Before(ch.until) {
+			return ch.scale
+		}
+	}
+	return c.Scale()
+}
+
+// ParseAmountAt is like [ParseAmount] but uses the scale that the currency
+// used at time t, as returned by [Currency.ScaleAt].
+// If the scale of the amount is less than the scale of the currency at time t,
+// the result will be zero-padded to the right.
+// The scale of the result is never less than the current scale of the currency.
+func ParseAmountAt(curr, amount string, t time.Time) (Amount, error) {
+	// Currency
+	c, err := ParseCurr(curr)
+	if err != nil {
+		return Amount{}, fmt.Errorf("parsing currency: %w", err)
+	}
+	// Decimal
+	scale := c.ScaleAt(t)
+	d, err := decimal.ParseExact(amount, scale)
+	if err != nil {
+		return Amount{}, fmt.Errorf("parsing amount: %w", err)
+	}
+	if d.Scale() < scale {
+		return Amount{}, fmt.Errorf("padding amount: %w", errAmountOverflow)
+	}
+	// Amount
+	return newAmountSafe(c, d)
+}
@@ -0,0 +1,169 @@
+package money
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRegisterScaleChange(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		defer resetCurrencies()
+		changes := []struct {
+			code  string
+			until time.Time
+			scale int
+		}{
+			{"JPY", time.Date(1954, time.January, 1, 0, 0, 0, 0, time.UTC), 2},
+			{"JPY", time.Date(1924, time.January, 1, 0, 0, 0, 0, time.UTC), 3},
+			{"KWD", time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), 2},
+		}
+		for _, ch := range changes {
+			err := RegisterScaleChange(ch.code, ch.until, ch.scale)
+			if err != nil {
+				t.Fatalf("RegisterScaleChange(%q, %v, %v) failed: %v", ch.code, ch.until, ch.scale, err)
+			}
+		}
+		// Replacing
+		until := time.Date(1954, time.January, 1, 0, 0, 0, 0, time.UTC)
+		err := RegisterScaleChange("JPY", until, 1)
+		if err != nil {
+			t.Fatalf("RegisterScaleChange(\"JPY\", %v, 1) failed: %v", until, err)
+		}
+		if got := JPY.ScaleAt(until.AddDate(-1, 0, 0)); got != 1 {
+			t.Errorf("JPY.ScaleAt(%v) = %v, want 1", until.AddDate(-1, 0, 0), got)
+		}
+		// Resetting
+		resetCurrencies()
+		if got := JPY.ScaleAt(until.AddDate(-1, 0, 0)); got != 0 {
+			t.Errorf("JPY.ScaleAt(%v) = %v, want 0", until.AddDate(-1, 0, 0), got)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		defer resetCurrencies()
+		until := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+		tests := map[string]struct {
+			code  string
+			scale int
+		}{
+			"currency 1": {"ZZZ", 2},
+			"currency 2": {"", 2},
+			"scale 1":    {"JPY", -1},
+			"scale 2":    {"JPY", 20},
+		}
+		for name, tt := range tests {
+			err := RegisterScaleChange(tt.code, until, tt.scale)
+			if err == nil {
+				t.Errorf("RegisterScaleChange(%q, %v, %v) did not fail: %v", tt.code, until, tt.scale, name)
+			}
+		}
+	})
+}
+
+func TestCurrency_ScaleAt(t *testing.T) {
+	defer resetCurrencies()
+	changes := []struct {
+		code  string
+		until time.Time
+		scale int
+	}{
+		{"JPY", time.Date(1954, time.January, 1, 0, 0, 0, 0, time.UTC), 2},
+		{"JPY", time.Date(1924, time.January, 1, 0, 0, 0, 0, time.UTC), 3},
+		{"KWD", time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), 2},
+	}
+	for _, ch := range changes {
+		err := RegisterScaleChange(ch.code, ch.until, ch.scale)
+		if err != nil {
+			t.Fatalf("RegisterScaleChange(%q, %v, %v) failed: %v", ch.code, ch.until, ch.scale, err)
+		}
+	}
+
+	tests := []struct {
+		c    Currency
+		t    time.Time
+		want int
+	}{
+		{JPY, time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC), 3},
+		{JPY, time.Date(1923, time.December, 31, 0, 0, 0, 0, time.UTC), 3},
+		{JPY, time.Date(1924, time.January, 1, 0, 0, 0, 0, time.UTC), 2},
+		{JPY, time.Date(1953, time.December, 31, 0, 0, 0, 0, time.UTC), 2},
+		{JPY, time.Date(1954, time.January, 1, 0, 0, 0, 0, time.UTC), 0},
+		{JPY, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), 0},
+		{KWD, time.Date(1999, time.January, 1, 0, 0, 0, 0, time.UTC), 2},
+		{KWD, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), 3},
+		{USD, time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC), 2},
+	}
+	for _, tt := range tests {
+		got := tt.c.ScaleAt(tt.t)
+		if got != tt.want {
+			t.Errorf("%v.ScaleAt(%v) = %v, want %v", tt.c, tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestParseAmountAt(t *testing.T) {
+	defer resetCurrencies()
+	changes := []struct {
+		code  string
+		until time.Time
+		scale int
+	}{
+		{"JPY", time.Date(1954, time.January, 1, 0, 0, 0, 0, time.UTC), 2},
+		{"JPY", time.Date(1924, time.January, 1, 0, 0, 0, 0, time.UTC), 3},
+		{"KWD", time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), 2},
+	}
+	for _, ch := range changes {
+		err := RegisterScaleChange(ch.code, ch.until, ch.scale)
+		if err != nil {
+			t.Fatalf("RegisterScaleChange(%q, %v, %v) failed: %v", ch.code, ch.until, ch.scale, err)
+		}
+	}
+
+	before := time.Date(1950, time.June, 1, 0, 0, 0, 0, time.UTC)
+	after := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, amount string
+			t            time.Time
+			want         string
+		}{
+			{"JPY", "12.5", before, "12.50"},
+			{"JPY", "12.505", before, "12.505"},
+			{"JPY", "12.5", after, "12.5"},
+			{"JPY", "12", after, "12"},
+			{"KWD", "1.5", before, "1.500"},
+			{"USD", "5.6", before, "5.60"},
+			{"USD", "5.6", after, "5.60"},
+		}
+		for _, tt := range tests {
+			got, err := ParseAmountAt(tt.curr, tt.amount, tt.t)
+			if err != nil {
+				t.Errorf("ParseAmountAt(%q, %q, %v) failed: %v", tt.curr, tt.amount, tt.t, err)
+				continue
+			}
+			want := MustParseAmount(tt.curr, tt.want)
+			if got != want {
+				t.Errorf("ParseAmountAt(%q, %q, %v) = %q, want %q", tt.curr, tt.amount, tt.t, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr, amount string
+			t            time.Time
+		}{
+			"currency 1": {"ZZZ", "1", after},
+			"amount 1":   {"JPY", "abc", before},
+			"overflow 1": {"JPY", "99999999999999999999", before},
+			"overflow 2": {"JPY", "9999999999999999999", before},
+		}
+		for name, tt := range tests {
+			_, err := ParseAmountAt(tt.curr, tt.amount, tt.t)
+			if err == nil {
+				t.Errorf("ParseAmountAt(%q, %q, %v) did not fail: %v", tt.curr, tt.amount, tt.t, name)
+			}
+		}
+	})
+}
@@ -0,0 +1,175 @@
+package money
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// currencyEntry represents an entry of the currency data loaded by
+// [LoadCurrencyData].
+type currencyEntry struct {
+	Code  string `json:"code"`
+	Num   string `json:"num"`
+	Scale *int   `json:"scale"`
+}
+
+// LoadCurrencyData reads currency data and registers all currencies in it,
+// augmenting the built-in table of currencies.
+// This allows scales to be fixed and codes to be added at startup without
+// a new release of the program.
+// The data is either a JSON array of objects:
+//
+//	[{"code": "USD", "scale": 2}, {"code": "ZZT", "num": "899", "scale": 6}]
+//
+// or a CSV file with a header, where the "Code" and "Scale" columns are
+// required, the "Num" column is optional, and any other columns, such as
+// "Name", are ignored:
+//
+//	Name,Code,Num,Scale
+//	Internal Token,ZZT,899,6
+//
+// The format is detected automatically: data starting with '[' is parsed as
+// JSON, any other data is parsed as CSV.
+// Each entry is registered as if by [RegisterCurr], and its numeric code,
+// if any, is assigned to the currency.
+// The data is applied atomically: if any entry is not valid, none of them
+// is registered.
+// LoadCurrencyData is safe for concurrent use by multiple goroutines, but it
+// is intended to be called during program initialization.
+//
+// LoadCurrencyData returns an error if:
+//   - the data is not a valid JSON array or CSV file;
+//   - any entry is not valid for [RegisterCurr] or has no scale;
+//   - any numeric code does not consist of 3 digits;
+//   - any numeric code is already assigned to another currency;
+//   - the numeric code of a known currency would change.
+func LoadCurrencyData(r io.Reader) error {
+	if err := loadCurrencyData(r); err != nil {
+		return fmt.Errorf("loading currency data: %w", err)
+	}
+	return nil
+}
+
+func loadCurrencyData(r io.Reader) error {
+	br := bufio.NewReader(r)
+	var entries []currencyEntry
+	var err error
+	if isJSONArray(br) {
+		entries, err = readCurrencyJSON(br)
+	} else {
+		entries, err = readCurrencyCSV(br)
+	}
+	if err != nil {
+		return err
+	}
+
+	currenciesMu.Lock()
+	defer currenciesMu.Unlock()
+
+	t := loadCurrencies().clone()
+	for i, e := range entries {
+		if err := t.load(e); err != nil {
+			return fmt.Errorf("entry %v (%q): %w", i+1, e.Code, err)
+		}
+	}
+	currencies.Store(t)
+	return nil
+}
+
+// isJSONArray returns true if the first non-space byte is '['.
+func isJSONArray(br *bufio.Reader) bool {
+	for {
+		b, err := br.Peek(1)
+		if err != nil {
+			return false
+		}
+		switch b[0] {
+		case ' ', '\t', '\r', '\n':
+			_, _ = br.ReadByte()
+		default:
+			return b[0] == '['
+		}
+	}
+}
+
+func readCurrencyJSON(r io.Reader) ([]currencyEntry, error) {
+	var entries []currencyEntry
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func readCurrencyCSV(r io.Reader) ([]currencyEntry, error) {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	code, num, scale := -1, -1, -1
+	for i, h := range header {
+		switch strings.TrimSpace(h) {
+		case "Code":
+			code = i
+		case "Num":
+			num = i
+		case "Scale":
+			scale = i
+		}
+	}
+	if code < 0 || scale < 0 {
+		return nil, fmt.Errorf("header %q must contain \"Code\" and \"Scale\" columns", strings.Join(header, ","))
+	}
+	recs, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]currencyEntry, 0, len(recs))
+	for i, rec := range recs {
+		s, err := strconv.Atoi(rec[scale])
+		if err != nil {
+			return nil, fmt.Errorf("row %v: invalid scale %q", i+2, rec[scale])
+		}
+		e := currencyEntry{Code: rec[code], Scale: &s}
+		if num >= 0 {
+			e.Num = rec[num]
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// load registers the entry in the table.
+// The table must not be shared, see method [currencyTable.clone].
+func (t *currencyTable) load(e currencyEntry) error {
+	if e.Scale == nil {
+		return fmt.Errorf("scale is missing")
+	}
+	if e.Num != "" {
+		if len(e.Num) != 3 || strings.Trim(e.Num, "0123456789") != "" {
+			return fmt.Errorf("numeric code %q must consist of 3 digits", e.Num)
+		}
+		if c, ok := t.lookup[e.Num]; ok && t.codes[c] != e.Code {
+			return fmt.Errorf("numeric code %q is already assigned to %v", e.Num, t.codes[c])
+		}
+		if c, ok := t.lookup[e.Code]; ok && t.nums[c] != "" && t.nums[c] != e.Num {
+			return fmt.Errorf("numeric code of %v is %q", e.Code, t.nums[c])
+		}
+	}
+	c, err := t.register(e.Code, *e.Scale)
+	if err != nil {
+		return err
+	}
+	if e.Num != "" {
+		t.nums[c] = e.Num
+		t.lookup[e.Num] = c
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package money
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadCurrencyData(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := map[string]string{
+			"json": `[
+				{"code": "USD", "num": "840", "scale": 4},
+				{"code": "ZZT", "num": "899", "scale": 6},
+				{"code": "ZZU", "scale": 0}
+			]`,
+			"csv": "Name,Code,Num,Scale\n" +
+				"US Dollar,USD,840,4\n" +
+				"Internal Token,ZZT,899,6\n" +
+				"Internal Unit,ZZU,,0\n",
+		}
+		for name, data := range tests {
+			resetCurrencies()
+			if err := LoadCurrencyData(strings.NewReader(data)); err != nil {
+				t.Errorf("LoadCurrencyData(%v) failed: %v", name, err)
+				continue
+			}
+			if got := USD.Scale(); got != 4 {
+				t.Errorf("%v: USD.Scale() = %v, want 4", name, got)
+			}
+			c, err := ParseCurr("899")
+			if err != nil {
+				t.Errorf("%v: ParseCurr(\"899\") failed: %v", name, err)
+				continue
+			}
+			if got := c.Code(); got != "ZZT" {
+				t.Errorf("%v: ParseCurr(\"899\").Code() = %q, want \"ZZT\"", name, got)
+			}
+			if got := c.Scale(); got != 6 {
+				t.Errorf("%v: ZZT.Scale() = %v, want 6", name, got)
+			}
+			c, err = ParseCurr("zzu")
+			if err != nil {
+				t.Errorf("%v: ParseCurr(\"zzu\") failed: %v", name, err)
+				continue
+			}
+			if got := c.Num(); got != "" {
+				t.Errorf("%v: ZZU.Num() = %q, want \"\"", name, got)
+			}
+		}
+		resetCurrencies()
+	})
+
+	t.Run("error", func(t *testing.T) {
+		defer resetCurrencies()
+		tests := map[string]string{
+			"json 1":   `[{"code": "ZZT", "scale": 2}`,
+			"json 2":   `[{"code": "ZZT", "scale": 2, "name": "Token"}]`,
+			"json 3":   `[{"code": "ZZT"}]`,
+			"csv 1":    "",
+			"csv 2":    "Name,Code,Num\nToken,ZZT,899\n",
+			"csv 3":    "Code,Scale\nZZT,two\n",
+			"csv 4":    "Code,Scale\nZZT,2,3\n",
+			"code 1":   "Code,Scale\nzzt,2\n",
+			"code 2":   "Code,Scale\nZZTT,2\n",
+			"scale 1":  "Code,Scale\nZZT,-1\n",
+			"scale 2":  "Code,Scale\nZZT,20\n",
+			"num 1":    "Code,Num,Scale\nZZT,90,2\n",
+			"num 2":    "Code,Num,Scale\nZZT,840,2\n",
+			"num 3":    "Code,Num,Scale\nUSD,841,2\n",
+			"atomic 1": "Code,Scale\nZZV,2\nZZW,-1\n",
+		}
+		for name, data := range tests {
+			err := LoadCurrencyData(strings.NewReader(data))
+			if err == nil {
+				t.Errorf("LoadCurrencyData(%q) did not fail: %v", data, name)
+			}
+		}
+		if _, err := ParseCurr("ZZV"); err == nil {
+			t.Errorf("ParseCurr(\"ZZV\") did not fail after failed LoadCurrencyData")
+		}
+	})
+}
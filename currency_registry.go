@@ -0,0 +1,143 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/govalues/decimal"
+)
+
+// maxCurrencies is the maximum number of currencies, including registered ones,
+// which is limited by the underlying type of [Currency].
+const maxCurrencies = math.MaxUint8 + 1
+
+// currencyTable holds the properties of all known currencies indexed by currency.
+type currencyTable struct {
+	size   int                   // number of known currencies
+	codes  [maxCurrencies]string // alphabetic codes
+	nums   [maxCurrencies]string // numeric codes
+	scales [maxCurrencies]int8   // scales
+	lookup map[string]Currency   // alphabetic and numeric codes to currencies
+
+	history map[Currency][]scaleChange // past scales ordered by date
+}
+
+// isoCurrencies is the table of currencies defined by ISO 4217.
+var isoCurrencies = newISOCurrencyTable()
+
+// currencies is the table of currencies, including registered ones.
+// A nil value means isoCurrencies.
+var currencies atomic.Pointer[currencyTable]
+
+// currenciesMu serializes modifications of the currency table.
+var currenciesMu sync.Mutex
+
+func newISOCurrencyTable() *currencyTable {
+	t := &currencyTable{
+		size:   len(codeLookup),
+		lookup: make(map[string]Currency, len(currLookup)),
+	}
+	copy(t.codes[:], codeLookup[:])
+	copy(t.nums[:], numLookup[:])
+	copy(t.scales[:], scaleLookup[:])
+	for s, c := range currLookup {
+		t.lookup[s] = c
+	}
+	return t
+}
+
+// loadCurrencies returns the current table of currencies.
+func loadCurrencies() *currencyTable {
+	if t := currencies.Load(); t != nil {
+		return t
+	}
+	return isoCurrencies
+}
+
+// clone returns a deep copy of the table.
+func (t *currencyTable) clone() *currencyTable {
+	u := *t
+	u.lookup = make(map[string]Currency, len(t.lookup))
+	for s, c := range t.lookup {
+		u.lookup[s] = c
+	}
+	u.history = make(map[Currency][]scaleChange, len(t.history))
+	for c, h := range t.history {
+		u.history[c] = h
+	}
+	return &u
+}
+
+// RegisterCurr registers a custom currency with the specified alphabetic code
+// and scale, or overrides the scale of an already known currency.
+// Scales up to [decimal.MaxScale] are allowed, which makes it possible to use
+// amounts with non-ISO minor units, such as fuel prices per liter with 4 digits
+// after the decimal point or crypto assets with 8 digits.
+// Registered currencies have no numeric code and are honored by all
+// constructors and methods, for example, [ParseCurr] and [ParseAmount].
+// RegisterCurr is safe for concurrent use by multiple goroutines, but it is
+// intended to be called during program initialization, before any amounts
+// in the currency are created: changing the scale of a currency does not
+// change the scale of existing amounts.
+// See also function [LoadCurrencyData].
+//
+// RegisterCurr returns an error if:
+//   - the code does not consist of 3 uppercase Latin letters;
+//   - the scale is negative or greater than [decimal.MaxScale];
+//   - the maximum number of currencies has been reached.
+func RegisterCurr(code string, scale int) (Currency, error) {
+	c, err := registerCurr(code, scale)
+	if err != nil {
+		return XXX, fmt.Errorf("registering currency %q: %w", code, err)
+	}
+	return c, nil
+}
+
+func registerCurr(code string, scale int) (Currency, error) {
+	currenciesMu.Lock()
+	defer currenciesMu.Unlock()
+
+	t := loadCurrencies().clone()
+	c, err := t.register(code, scale)
+	if err != nil {
+		return XXX, err
+	}
+	currencies.Store(t)
+	return c, nil
+}
+
+// register adds a currency to the table or overrides the scale of
+// an already known currency.
+// The table must not be shared, see method [currencyTable.clone].
+func (t *currencyTable) register(code string, scale int) (Currency, error) {
+	if len(code) != 3 || strings.Trim(code, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+		return XXX, fmt.Errorf("code must consist of 3 uppercase Latin letters")
+	}
+	if scale < 0 || scale > decimal.MaxScale {
+		return XXX, fmt.Errorf("scale %v out of range", scale)
+	}
+	c, ok := t.lookup[code]
+	if !ok {
+		if t.size >= maxCurrencies {
+			return XXX, fmt.Errorf("too many currencies")
+		}
+		c = Currency(t.size)
+		t.size++
+		t.codes[c] = code
+		t.lookup[code] = c
+		t.lookup[strings.ToLower(code)] = c
+	}
+	t.scales[c] = int8(scale)
+	return c, nil
+}
+
+// resetCurrencies removes all registered currencies, scale overrides,
+// and scale changes.
+func resetCurrencies() {
+	currenciesMu.Lock()
+	defer currenciesMu.Unlock()
+	currencies.Store(nil)
+}
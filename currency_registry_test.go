@@ -0,0 +1,149 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestRegisterCurr(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		defer resetCurrencies()
+
+		tests := []struct {
+			code  string
+			scale int
+			num   string
+			value string
+			want  string
+		}{
+			{"XFL", 4, "", "1.5", "XFL 1.5000"},
+			{"XBT", 8, "", "0.00000001", "XBT 0.00000001"},
+			{"XMX", 19, "", "0.1", "XMX 0.1000000000000000000"},
+			{"XZZ", 0, "", "1", "XZZ 1"},
+			{"USD", 4, "840", "1.5", "USD 1.5000"},
+			{"JPY", 2, "392", "1", "JPY 1.00"},
+		}
+		for _, tt := range tests {
+			got, err := RegisterCurr(tt.code, tt.scale)
+			if err != nil {
+				t.Errorf("RegisterCurr(%q, %v) failed: %v", tt.code, tt.scale, err)
+				continue
+			}
+			if got.Code() != tt.code {
+				t.Errorf("RegisterCurr(%q, %v).Code() = %q, want %q", tt.code, tt.scale, got.Code(), tt.code)
+			}
+			if got.Scale() != tt.scale {
+				t.Errorf("RegisterCurr(%q, %v).Scale() = %v, want %v", tt.code, tt.scale, got.Scale(), tt.scale)
+			}
+			if got.Num() != tt.num {
+				t.Errorf("RegisterCurr(%q, %v).Num() = %q, want %q", tt.code, tt.scale, got.Num(), tt.num)
+			}
+			for _, s := range []string{tt.code, got.Code()} {
+				c, err := ParseCurr(s)
+				if err != nil {
+					t.Errorf("ParseCurr(%q) failed: %v", s, err)
+					continue
+				}
+				if c != got {
+					t.Errorf("ParseCurr(%q) = %v, want %v", s, c, got)
+				}
+			}
+			a, err := ParseAmount(tt.code, tt.value)
+			if err != nil {
+				t.Errorf("ParseAmount(%q, %q) failed: %v", tt.code, tt.value, err)
+				continue
+			}
+			if a.String() != tt.want {
+				t.Errorf("ParseAmount(%q, %q) = %q, want %q", tt.code, tt.value, a, tt.want)
+			}
+		}
+	})
+
+	t.Run("lowercase", func(t *testing.T) {
+		defer resetCurrencies()
+		want, err := RegisterCurr("XFL", 4)
+		if err != nil {
+			t.Fatalf("RegisterCurr(\"XFL\", 4) failed: %v", err)
+		}
+		got, err := ParseCurr("xfl")
+		if err != nil {
+			t.Fatalf("ParseCurr(\"xfl\") failed: %v", err)
+		}
+		if got != want {
+			t.Errorf("ParseCurr(\"xfl\") = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("reregister", func(t *testing.T) {
+		defer resetCurrencies()
+		c, err := RegisterCurr("XFL", 4)
+		if err != nil {
+			t.Fatalf("RegisterCurr(\"XFL\", 4) failed: %v", err)
+		}
+		d, err := RegisterCurr("XFL", 6)
+		if err != nil {
+			t.Fatalf("RegisterCurr(\"XFL\", 6) failed: %v", err)
+		}
+		if c != d {
+			t.Errorf("RegisterCurr(\"XFL\", 6) = %v, want %v", d, c)
+		}
+		if d.Scale() != 6 {
+			t.Errorf("RegisterCurr(\"XFL\", 6).Scale() = %v, want 6", d.Scale())
+		}
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		if _, err := RegisterCurr("USD", 4); err != nil {
+			t.Fatalf("RegisterCurr(\"USD\", 4) failed: %v", err)
+		}
+		if _, err := RegisterCurr("XFL", 4); err != nil {
+			t.Fatalf("RegisterCurr(\"XFL\", 4) failed: %v", err)
+		}
+		resetCurrencies()
+		if got := USD.Scale(); got != 2 {
+			t.Errorf("USD.Scale() = %v, want 2", got)
+		}
+		if _, err := ParseCurr("XFL"); err == nil {
+			t.Errorf("ParseCurr(\"XFL\") did not fail after reset")
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		defer resetCurrencies()
+		tests := []struct {
+			code  string
+			scale int
+		}{
+			{"", 2},
+			{"XF", 2},
+			{"XFLL", 2},
+			{"xfl", 2},
+			{"X1L", 2},
+			{"840", 2},
+			{"XFL", -1},
+			{"XFL", 20},
+		}
+		for _, tt := range tests {
+			_, err := RegisterCurr(tt.code, tt.scale)
+			if err == nil {
+				t.Errorf("RegisterCurr(%q, %v) did not fail", tt.code, tt.scale)
+			}
+		}
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		defer resetCurrencies()
+		n := maxCurrencies - len(codeLookup)
+		for i := 0; i < n; i++ {
+			code := string([]byte{'Q', byte('E' + i/26), byte('A' + i%26)})
+			if _, err := RegisterCurr(code, 2); err != nil {
+				t.Fatalf("RegisterCurr(%q, 2) failed: %v", code, err)
+			}
+		}
+		if _, err := RegisterCurr("QZZ", 2); err == nil {
+			t.Errorf("RegisterCurr(\"QZZ\", 2) did not fail")
+		}
+		if _, err := RegisterCurr("QEA", 4); err != nil {
+			t.Errorf("RegisterCurr(\"QEA\", 4) failed: %v", err)
+		}
+	})
+}
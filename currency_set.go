@@ -0,0 +1,201 @@
+package money
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+
+	"github.com/govalues/decimal"
+)
+
+// CurrencySet represents a set of allowed currencies.
+// Its constructors and parsers, such as [CurrencySet.ParseAmount], behave like
+// the corresponding package-level functions but also fail if the currency is
+// not in the set.
+// This is useful for services that handle only a few currencies and need to
+// reject all others as early as possible.
+// The zero value is an empty set that does not allow any currency.
+// This type is designed to be safe for concurrent use by multiple goroutines.
+type CurrencySet struct {
+	bits [maxCurrencies / 64]uint64
+}
+
+// NewCurrencySet returns a set containing the specified currencies.
+func NewCurrencySet(currs ...Currency) CurrencySet {
+	var s CurrencySet
+	for _, c := range currs {
+		s = s.With(c)
+	}
+	return s
+}
+
+// ParseCurrencySet converts currency codes to a set of currencies.
+// See also constructor [ParseCurr].
+//
+// ParseCurrencySet returns an error if any of the currency codes is not valid.
+func ParseCurrencySet(currs ...string) (CurrencySet, error) {
+	var s CurrencySet
+	for _, curr := range currs {
+		c, err := ParseCurr(curr)
+		if err != nil {
+			return CurrencySet{}, fmt.Errorf("parsing currency set: %w", err)
+		}
+		s = s.With(c)
+	}
+	return s, nil
+}
+
+// MustParseCurrencySet is like [ParseCurrencySet] but panics if any of
+// the currency codes is not valid.
+// It simplifies safe initialization of global variables holding sets.
+func MustParseCurrencySet(currs ...string) CurrencySet {
+	s, err := ParseCurrencySet(currs...)
+	if err != nil {
+		panic(fmt.Sprintf("ParseCurrencySet(%q) failed: %v", currs, err))
+	}
+	return s
+}
+
+// With returns a set that contains all currencies of set s and currency c.
+func (s CurrencySet) With(c Currency) CurrencySet {
+	s.bits[c/64] |= 1 << (c % 64)
+	return s
+}
+
+// Contains returns true if the set contains currency c.
+func (s CurrencySet) Contains(c Currency) bool {
+	return s.bits[c/64]&(1<<(c%64)) != 0
+}
+
+// Union returns a set that contains the currencies of both sets s and t.
+func (s CurrencySet) Union(t CurrencySet) CurrencySet {
+	for i := range s.bits {
+		s.bits[i] |= t.bits[i]
+	}
+	return s
+}
+
+// Intersect returns a set that contains the currencies present in both
+// sets s and t.
+func (s CurrencySet) Intersect(t CurrencySet) CurrencySet {
+	for i := range s.bits {
+		s.bits[i] &= t.bits[i]
+	}
+	return s
+}
+
+// Difference returns a set that contains the currencies of set s that are
+// not present in set t.
+func (s CurrencySet) Difference(t CurrencySet) CurrencySet {
+	for i := range s.bits {
+		s.bits[i] &^= t.bits[i]
+	}
+	return s
+}
+
+// Len returns the number of currencies in the set.
+func (s CurrencySet) Len() int {
+	n := 0
+	for _, b := range s.bits {
+		n += bits.OnesCount64(b)
+	}
+	return n
+}
+
+// Currencies returns the currencies of the set ordered by index.
+func (s CurrencySet) Currencies() []Currency {
+	res := make([]Currency, 0, s.Len())
+	for i := 0; i < maxCurrencies; i++ {
+		if c := Currency(i); s.Contains(c) {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+// String method implements the [fmt.Stringer] interface and returns
+// a string representation of the set, for example, "[EUR GBP USD]".
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (s CurrencySet) String() string {
+	currs := s.Currencies()
+	codes := make([]string, len(currs))
+	for i, c := range currs {
+		codes[i] = c.Code()
+	}
+	return "[" + strings.Join(codes, " ") + "]"
+}
+
+// check returns an error if the set does not contain currency c.
+func (s CurrencySet) check(c Currency) error {
+	if !s.Contains(c) {
+		return fmt.Errorf("currency %v is not allowed, allowed currencies are %v", c, s)
+	}
+	return nil
+}
+
+// ParseCurr is like [ParseCurr] but also returns an error if the currency
+// is not in the set.
+func (s CurrencySet) ParseCurr(curr string) (Currency, error) {
+	c, err := ParseCurr(curr)
+	if err != nil {
+		return XXX, err
+	}
+	if err := s.check(c); err != nil {
+		return XXX, err
+	}
+	return c, nil
+}
+
+// NewAmount is like [NewAmount] but also returns an error if the currency
+// is not in the set.
+func (s CurrencySet) NewAmount(curr string, coef int64, scale int) (Amount, error) {
+	c, err := s.ParseCurr(curr)
+	if err != nil {
+		return Amount{}, fmt.Errorf("parsing currency: %w", err)
+	}
+	return NewAmount(c.Code(), coef, scale)
+}
+
+// NewAmountFromDecimal is like [NewAmountFromDecimal] but also returns
+// an error if the currency is not in the set.
+func (s CurrencySet) NewAmountFromDecimal(curr Currency, amount decimal.Decimal) (Amount, error) {
+	if err := s.check(curr); err != nil {
+		return Amount{}, err
+	}
+	return NewAmountFromDecimal(curr, amount)
+}
+
+// NewAmountFromMinorUnits is like [NewAmountFromMinorUnits] but also returns
+// an error if the currency is not in the set.
+func (s CurrencySet) NewAmountFromMinorUnits(curr string, units int64) (Amount, error) {
+	c, err := s.ParseCurr(curr)
+	if err != nil {
+		return Amount{}, fmt.Errorf("parsing currency: %w", err)
+	}
+	return NewAmountFromMinorUnits(c.Code(), units)
+}
+
+// ParseAmount is like [ParseAmount] but also returns an error if the currency
+// is not in the set.
+func (s CurrencySet) ParseAmount(curr, amount string) (Amount, error) {
+	c, err := s.ParseCurr(curr)
+	if err != nil {
+		return Amount{}, fmt.Errorf("parsing currency: %w", err)
+	}
+	return ParseAmount(c.Code(), amount)
+}
+
+// ParseExchRate is like [ParseExchRate] but also returns an error if
+// the base or the quote currency is not in the set.
+func (s CurrencySet) ParseExchRate(base, quote, rate string) (ExchangeRate, error) {
+	b, err := s.ParseCurr(base)
+	if err != nil {
+		return ExchangeRate{}, fmt.Errorf("parsing currency: %w", err)
+	}
+	q, err := s.ParseCurr(quote)
+	if err != nil {
+		return ExchangeRate{}, fmt.Errorf("parsing currency: %w", err)
+	}
+	return ParseExchRate(b.Code(), q.Code(), rate)
+}
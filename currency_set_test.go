@@ -0,0 +1,249 @@
+package money
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/govalues/decimal"
+)
+
+func TestCurrencySet_Interfaces(t *testing.T) {
+	var i any = CurrencySet{}
+	_, ok := i.(fmt.Stringer)
+	if !ok {
+		t.Errorf("%T does not implement fmt.Stringer", i)
+	}
+}
+
+func TestParseCurrencySet(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			currs []string
+			want  string
+			n     int
+		}{
+			{nil, "[]", 0},
+			{[]string{"USD"}, "[USD]", 1},
+			{[]string{"usd", "EUR", "826"}, "[EUR GBP USD]", 3},
+			{[]string{"USD", "USD"}, "[USD]", 1},
+			{[]string{"XXX", "ZWL"}, "[XXX ZWL]", 2},
+		}
+		for _, tt := range tests {
+			got, err := ParseCurrencySet(tt.currs...)
+			if err != nil {
+				t.Errorf("ParseCurrencySet(%q) failed: %v", tt.currs, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParseCurrencySet(%q) = %v, want %v", tt.currs, got, tt.want)
+			}
+			if got.Len() != tt.n {
+				t.Errorf("ParseCurrencySet(%q).Len() = %v, want %v", tt.currs, got.Len(), tt.n)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := [][]string{
+			{"USD", "UUU"},
+			{""},
+		}
+		for _, currs := range tests {
+			_, err := ParseCurrencySet(currs...)
+			if err == nil {
+				t.Errorf("ParseCurrencySet(%q) did not fail", currs)
+			}
+		}
+	})
+}
+
+func TestCurrencySet_Contains(t *testing.T) {
+	s := NewCurrencySet(USD, EUR, GBP)
+	tests := []struct {
+		curr Currency
+		want bool
+	}{
+		{USD, true},
+		{EUR, true},
+		{GBP, true},
+		{JPY, false},
+		{XXX, false},
+		{ZWL, false},
+	}
+	for _, tt := range tests {
+		got := s.Contains(tt.curr)
+		if got != tt.want {
+			t.Errorf("%v.Contains(%v) = %v, want %v", s, tt.curr, got, tt.want)
+		}
+	}
+	var zero CurrencySet
+	if zero.Contains(XXX) {
+		t.Errorf("CurrencySet{}.Contains(XXX) = true, want false")
+	}
+}
+
+func TestCurrencySet_Union(t *testing.T) {
+	tests := []struct {
+		s, u                     []string
+		wantUnion, wantIntersect string
+		wantDifference           string
+	}{
+		{nil, nil, "[]", "[]", "[]"},
+		{[]string{"USD"}, nil, "[USD]", "[]", "[USD]"},
+		{nil, []string{"USD"}, "[USD]", "[]", "[]"},
+		{[]string{"USD", "EUR"}, []string{"EUR", "JPY"}, "[EUR JPY USD]", "[EUR]", "[USD]"},
+		{[]string{"XTS", "USD"}, []string{"XTS", "USD"}, "[XTS USD]", "[XTS USD]", "[]"},
+	}
+	for _, tt := range tests {
+		s, u := MustParseCurrencySet(tt.s...), MustParseCurrencySet(tt.u...)
+		if got := s.Union(u).String(); got != tt.wantUnion {
+			t.Errorf("%v.Union(%v) = %v, want %v", s, u, got, tt.wantUnion)
+		}
+		if got := s.Intersect(u).String(); got != tt.wantIntersect {
+			t.Errorf("%v.Intersect(%v) = %v, want %v", s, u, got, tt.wantIntersect)
+		}
+		if got := s.Difference(u).String(); got != tt.wantDifference {
+			t.Errorf("%v.Difference(%v) = %v, want %v", s, u, got, tt.wantDifference)
+		}
+	}
+}
+
+func TestCurrencyGroups(t *testing.T) {
+	t.Run("G10Currencies", func(t *testing.T) {
+		got := G10Currencies().String()
+		want := "[AUD CAD CHF EUR GBP JPY NOK NZD SEK USD]"
+		if got != want {
+			t.Errorf("G10Currencies() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("EUCurrencies", func(t *testing.T) {
+		got := EUCurrencies().String()
+		want := "[CZK DKK EUR HUF PLN RON SEK]"
+		if got != want {
+			t.Errorf("EUCurrencies() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("ZeroDecimalCurrencies", func(t *testing.T) {
+		s := ZeroDecimalCurrencies()
+		for _, c := range []Currency{JPY, KRW, CLP, VND} {
+			if !s.Contains(c) {
+				t.Errorf("ZeroDecimalCurrencies() does not contain %v", c)
+			}
+		}
+		for _, c := range []Currency{XXX, USD, EUR, KWD} {
+			if s.Contains(c) {
+				t.Errorf("ZeroDecimalCurrencies() contains %v", c)
+			}
+		}
+		for _, c := range s.Currencies() {
+			if c.Scale() != 0 {
+				t.Errorf("ZeroDecimalCurrencies() contains %v with scale %v", c, c.Scale())
+			}
+		}
+	})
+}
+
+func TestCurrencySet_ParseAmount(t *testing.T) {
+	s := NewCurrencySet(USD, EUR, GBP)
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, amount, want string
+		}{
+			{"USD", "1", "USD 1.00"},
+			{"eur", "1.5", "EUR 1.50"},
+			{"826", "-0.01", "GBP -0.01"},
+		}
+		for _, tt := range tests {
+			got, err := s.ParseAmount(tt.curr, tt.amount)
+			if err != nil {
+				t.Errorf("%v.ParseAmount(%q, %q) failed: %v", s, tt.curr, tt.amount, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("%v.ParseAmount(%q, %q) = %q, want %q", s, tt.curr, tt.amount, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr, amount string
+		}{
+			"currency 1": {"JPY", "1"},
+			"currency 2": {"UUU", "1"},
+			"amount 1":   {"USD", "abc"},
+		}
+		for name, tt := range tests {
+			_, err := s.ParseAmount(tt.curr, tt.amount)
+			if err == nil {
+				t.Errorf("%v: %v.ParseAmount(%q, %q) did not fail", name, s, tt.curr, tt.amount)
+			}
+		}
+	})
+}
+
+func TestCurrencySet_NewAmount(t *testing.T) {
+	s := NewCurrencySet(USD, EUR)
+
+	got, err := s.NewAmount("USD", 150, 2)
+	if err != nil {
+		t.Fatalf("%v.NewAmount(\"USD\", 150, 2) failed: %v", s, err)
+	}
+	if want := MustParseAmount("USD", "1.50"); got != want {
+		t.Errorf("%v.NewAmount(\"USD\", 150, 2) = %q, want %q", s, got, want)
+	}
+	if _, err := s.NewAmount("JPY", 150, 0); err == nil {
+		t.Errorf("%v.NewAmount(\"JPY\", 150, 0) did not fail", s)
+	}
+
+	got, err = s.NewAmountFromMinorUnits("EUR", 150)
+	if err != nil {
+		t.Fatalf("%v.NewAmountFromMinorUnits(\"EUR\", 150) failed: %v", s, err)
+	}
+	if want := MustParseAmount("EUR", "1.50"); got != want {
+		t.Errorf("%v.NewAmountFromMinorUnits(\"EUR\", 150) = %q, want %q", s, got, want)
+	}
+	if _, err := s.NewAmountFromMinorUnits("JPY", 150); err == nil {
+		t.Errorf("%v.NewAmountFromMinorUnits(\"JPY\", 150) did not fail", s)
+	}
+
+	d := decimal.MustParse("1.5")
+	got, err = s.NewAmountFromDecimal(USD, d)
+	if err != nil {
+		t.Fatalf("%v.NewAmountFromDecimal(USD, %v) failed: %v", s, d, err)
+	}
+	if want := MustParseAmount("USD", "1.50"); got != want {
+		t.Errorf("%v.NewAmountFromDecimal(USD, %v) = %q, want %q", s, d, got, want)
+	}
+	if _, err := s.NewAmountFromDecimal(JPY, d); err == nil {
+		t.Errorf("%v.NewAmountFromDecimal(JPY, %v) did not fail", s, d)
+	}
+}
+
+func TestCurrencySet_ParseExchRate(t *testing.T) {
+	s := NewCurrencySet(USD, EUR)
+
+	got, err := s.ParseExchRate("EUR", "USD", "1.0850")
+	if err != nil {
+		t.Fatalf("%v.ParseExchRate(\"EUR\", \"USD\", \"1.0850\") failed: %v", s, err)
+	}
+	if want := MustParseExchRate("EUR", "USD", "1.0850"); got != want {
+		t.Errorf("%v.ParseExchRate(\"EUR\", \"USD\", \"1.0850\") = %q, want %q", s, got, want)
+	}
+
+	tests := []struct {
+		base, quote string
+	}{
+		{"EUR", "JPY"},
+		{"JPY", "USD"},
+	}
+	for _, tt := range tests {
+		_, err := s.ParseExchRate(tt.base, tt.quote, "1")
+		if err == nil {
+			t.Errorf("%v.ParseExchRate(%q, %q, \"1\") did not fail", s, tt.base, tt.quote)
+		}
+	}
+}
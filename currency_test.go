@@ -5,6 +5,8 @@ import (
 	"database/sql/driver"
 	"encoding"
 	"fmt"
+	"log/slog"
+	"strings"
 	"testing"
 )
 
@@ -28,6 +30,14 @@ func TestCurrency_Interfaces(t *testing.T) {
 	if !ok {
 		t.Errorf("%T does not implement driver.Valuer", c)
 	}
+	_, ok = c.(slog.LogValuer)
+	if !ok {
+		t.Errorf("%T does not implement slog.LogValuer", c)
+	}
+	_, ok = c.(gormDataTyper)
+	if !ok {
+		t.Errorf("%T does not implement schema.GormDataTypeInterface", c)
+	}
 
 	x := XXX
 	c = &x
@@ -664,6 +674,23 @@ func TestCurrency_Format(t *testing.T) {
 	}
 }
 
+func TestCurrency_LogValue(t *testing.T) {
+	tests := []struct {
+		c    Currency
+		want string
+	}{
+		{XXX, "[code=XXX scale=0]"},
+		{USD, "[code=USD scale=2]"},
+		{OMR, "[code=OMR scale=3]"},
+	}
+	for _, tt := range tests {
+		got := tt.c.LogValue().String()
+		if got != tt.want {
+			t.Errorf("%v.LogValue() = %q, want %q", tt.c, got, tt.want)
+		}
+	}
+}
+
 func TestCurrency_Scan(t *testing.T) {
 	t.Run("error", func(t *testing.T) {
 		tests := []any{"UUU", 840, []byte{0x08, 0x40}, nil}
@@ -677,6 +704,71 @@ func TestCurrency_Scan(t *testing.T) {
 	})
 }
 
+func TestCurrency_MarshalGQL(t *testing.T) {
+	tests := []struct {
+		curr Currency
+		want string
+	}{
+		{USD, `"USD"`},
+		{XXX, `"XXX"`},
+	}
+	for _, tt := range tests {
+		var buf strings.Builder
+		tt.curr.MarshalGQL(&buf)
+		got := buf.String()
+		if got != tt.want {
+			t.Errorf("%v.MarshalGQL() = %s, want %s", tt.curr, got, tt.want)
+		}
+	}
+}
+
+func TestCurrency_UnmarshalGQL(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			v    any
+			want Currency
+		}{
+			{"USD", USD},
+			{"usd", USD},
+			{"840", USD},
+		}
+		for _, tt := range tests {
+			var got Currency
+			err := got.UnmarshalGQL(tt.v)
+			if err != nil {
+				t.Errorf("UnmarshalGQL(%v) failed: %v", tt.v, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalGQL(%v) = %v, want %v", tt.v, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []any{nil, 840, "UUU", ""}
+		for _, v := range tests {
+			var got Currency
+			err := got.UnmarshalGQL(v)
+			if err == nil {
+				t.Errorf("UnmarshalGQL(%v) did not fail", v)
+			}
+		}
+	})
+}
+
+// gormDataTyper mirrors the GormDataTypeInterface of package gorm.io/gorm/schema.
+type gormDataTyper interface {
+	GormDataType() string
+}
+
+func TestCurrency_GormDataType(t *testing.T) {
+	got := USD.GormDataType()
+	if got != "string" {
+		t.Errorf("USD.GormDataType() = %q, want %q", got, "string")
+	}
+}
+
 func TestNullCurrency_Interfaces(t *testing.T) {
 	var i any = NullCurrency{}
 	_, ok := i.(driver.Valuer)
@@ -0,0 +1,70 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Denominate breaks down the amount into the given denominations, such as
+// banknotes and coins, and returns the number of pieces of each denomination
+// and the remainder that cannot be paid out with them.
+// Denominations are used greedily, from the largest to the smallest, which
+// produces the fewest pieces for all common currency systems.
+// For unusual sets of denominations, a remainder may be left even when
+// the amount could be paid out exactly, for example, USD 0.08 is broken down
+// into USD 0.05 and USD 0.02 with USD 0.01 remaining, not into 4 * USD 0.02.
+// The returned map contains only denominations with a positive number of
+// pieces, keyed exactly as they are passed in.
+// This function is useful for change-making in POS and ATM software.
+// See also method [Amount.DivMod].
+//
+// Denominate returns an error if:
+//   - the amount or any denomination are denominated in different currencies;
+//   - the amount is negative;
+//   - any denomination is not positive.
+func Denominate(a Amount, denominations []Amount) (map[Amount]int, Amount, error) {
+	pieces, rem, err := denominate(a, denominations)
+	if err != nil {
+		return nil, Amount{}, fmt.Errorf("denominating %v: %w", a, err)
+	}
+	return pieces, rem, nil
+}
+
+func denominate(a Amount, denominations []Amount) (map[Amount]int, Amount, error) {
+	if a.IsNeg() {
+		return nil, Amount{}, fmt.Errorf("amount must not be negative")
+	}
+	dens := make([]Amount, len(denominations))
+	copy(dens, denominations)
+	for _, d := range dens {
+		if !a.SameCurr(d) {
+			return nil, Amount{}, mismatchError(a.Curr(), d.Curr())
+		}
+		if !d.IsPos() {
+			return nil, Amount{}, fmt.Errorf("denomination %v must be positive", d)
+		}
+	}
+	sort.SliceStable(dens, func(i, j int) bool {
+		return dens[i].Decimal().Cmp(dens[j].Decimal()) > 0
+	})
+
+	pieces := make(map[Amount]int)
+	rem := a
+	for _, d := range dens {
+		n, r, err := rem.divMod(d)
+		if err != nil {
+			return nil, Amount{}, err
+		}
+		if n.IsZero() {
+			continue
+		}
+		m, _, ok := n.Int64(0)
+		if !ok || m > math.MaxInt {
+			return nil, Amount{}, fmt.Errorf("too many pieces of %v", d)
+		}
+		pieces[d] += int(m)
+		rem = r
+	}
+	return pieces, rem, nil
+}
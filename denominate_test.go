@@ -0,0 +1,72 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestDenominate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			a       string
+			dens    []string
+			want    map[string]int
+			wantRem string
+		}{
+			{"0", []string{"1"}, map[string]int{}, "0.00"},
+			{"3.10", nil, map[string]int{}, "3.10"},
+			{"3.10", []string{"0.25"}, map[string]int{"0.25": 12}, "0.10"},
+			{"3.10", []string{"0.05", "1", "0.25", "0.10"}, map[string]int{"1": 3, "0.10": 1}, "0.00"},
+			{"187.43", []string{"100", "50", "20", "10", "5", "1", "0.25", "0.10", "0.05", "0.01"}, map[string]int{"100": 1, "50": 1, "20": 1, "10": 1, "5": 1, "1": 2, "0.25": 1, "0.10": 1, "0.05": 1, "0.01": 3}, "0.00"},
+			{"0.07", []string{"0.05", "0.02"}, map[string]int{"0.05": 1, "0.02": 1}, "0.00"},
+			{"0.08", []string{"0.05", "0.02"}, map[string]int{"0.05": 1, "0.02": 1}, "0.01"},
+			{"40", []string{"20", "50"}, map[string]int{"20": 2}, "0.00"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount("USD", tt.a)
+			dens := MustParseAmountSlice("USD", tt.dens)
+			got, gotRem, err := Denominate(a, dens)
+			if err != nil {
+				t.Errorf("Denominate(%v, %v) failed: %v", a, dens, err)
+				continue
+			}
+			want := make(map[Amount]int, len(tt.want))
+			for d, n := range tt.want {
+				want[MustParseAmount("USD", d)] = n
+			}
+			wantRem := MustParseAmount("USD", tt.wantRem)
+			if len(got) != len(want) || gotRem != wantRem {
+				t.Errorf("Denominate(%v, %v) = [%v %v], want [%v %v]", a, dens, got, gotRem, want, wantRem)
+				continue
+			}
+			for d, n := range want {
+				if got[d] != n {
+					t.Errorf("Denominate(%v, %v) = [%v %v], want [%v %v]", a, dens, got, gotRem, want, wantRem)
+					break
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr, a string
+			dens    []string
+		}{
+			"currency 1": {"EUR", "1", []string{"USD 1"}},
+			"negative 1": {"USD", "-1", []string{"USD 1"}},
+			"zero 1":     {"USD", "1", []string{"USD 0"}},
+			"negative 2": {"USD", "1", []string{"USD -1"}},
+		}
+		for name, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.a)
+			dens := make([]Amount, len(tt.dens))
+			for i, s := range tt.dens {
+				dens[i] = MustParseAmount(s[:3], s[4:])
+			}
+			_, _, err := Denominate(a, dens)
+			if err == nil {
+				t.Errorf("%v: Denominate(%v, %v) did not fail", name, a, dens)
+			}
+		}
+	})
+}
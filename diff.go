@@ -0,0 +1,107 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/govalues/decimal"
+)
+
+// Direction specifies whether an amount has increased or decreased.
+// See also type [Change].
+type Direction int8
+
+const (
+	// Unchanged means that both amounts are numerically equal.
+	Unchanged Direction = iota
+	// Increase means that the new amount is greater than the old one.
+	Increase
+	// Decrease means that the new amount is less than the old one.
+	Decrease
+)
+
+// String implements the [fmt.Stringer] interface and returns
+// a string representation of the direction.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (d Direction) String() string {
+	switch d {
+	case Unchanged:
+		return "unchanged"
+	case Increase:
+		return "increase"
+	case Decrease:
+		return "decrease"
+	default:
+		return fmt.Sprintf("Direction(%d)", int8(d))
+	}
+}
+
+// Change describes the difference between two snapshots of an amount,
+// such as a balance before and after a transaction, in a form suitable for
+// audit logs.
+// See also function [Diff].
+type Change struct {
+	// From is the old amount.
+	From Amount
+	// To is the new amount.
+	To Amount
+	// Delta is the difference To - From.
+	Delta Amount
+	// Pct is the (possibly rounded) change as a percentage of the absolute
+	// value of From, for example, 12.5 for an increase by 12.5%.
+	// It is zero if HasPct is false.
+	Pct decimal.Decimal
+	// HasPct is false if From is zero and the percentage is undefined,
+	// or if the percentage has more than [decimal.MaxPrec] digits in
+	// its integer part.
+	HasPct bool
+	// Direction is the sign of Delta.
+	Direction Direction
+}
+
+// Diff returns the change from amount a to amount b.
+// The percentage is computed relative to the absolute value of a, so that
+// its sign always matches the direction, even for negative balances.
+// If the percentage overflows, Diff still returns the difference and
+// the direction, and sets field HasPct to false.
+//
+// Diff returns an error if:
+//   - amounts are denominated in different currencies;
+//   - the integer part of the difference has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func Diff(a, b Amount) (Change, error) {
+	c, err := diff(a, b)
+	if err != nil {
+		return Change{}, fmt.Errorf("comparing [%v] and [%v]: %w", a, b, err)
+	}
+	return c, nil
+}
+
+func diff(a, b Amount) (Change, error) {
+	if !a.SameCurr(b) {
+		return Change{}, mismatchError(a.Curr(), b.Curr())
+	}
+	delta, err := b.sub(a)
+	if err != nil {
+		return Change{}, err
+	}
+	c := Change{From: a, To: b, Delta: delta}
+	switch delta.Sign() {
+	case 1:
+		c.Direction = Increase
+	case -1:
+		c.Direction = Decrease
+	}
+	if a.IsZero() {
+		return c, nil
+	}
+	// An overflow of the percentage is not an error, see field HasPct.
+	d, err := delta.Decimal().Quo(a.Decimal().Abs())
+	if err == nil {
+		d, err = d.Mul(decimal.Hundred)
+	}
+	if err == nil {
+		c.Pct, c.HasPct = d, true
+	}
+	return c, nil
+}
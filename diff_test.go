@@ -0,0 +1,89 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestDirection_String(t *testing.T) {
+	tests := []struct {
+		d    Direction
+		want string
+	}{
+		{Unchanged, "unchanged"},
+		{Increase, "increase"},
+		{Decrease, "decrease"},
+		{Direction(-1), "Direction(-1)"},
+	}
+	for _, tt := range tests {
+		if got := tt.d.String(); got != tt.want {
+			t.Errorf("%v.String() = %q, want %q", int8(tt.d), got, tt.want)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, a, b    string
+			wantDelta     string
+			wantPct       string
+			wantHasPct    bool
+			wantDirection Direction
+		}{
+			{"USD", "100.00", "112.50", "12.50", "12.5", true, Increase},
+			{"USD", "100.00", "75.00", "-25.00", "-25", true, Decrease},
+			{"USD", "100.00", "100", "0.00", "0", true, Unchanged},
+			{"USD", "-100.00", "-50.00", "50.00", "50", true, Increase},
+			{"USD", "-100.00", "-150.00", "-50.00", "-50", true, Decrease},
+			{"USD", "0.00", "10.00", "10.00", "0", false, Increase},
+			{"USD", "3.00", "4.00", "1.00", "33.33333333333333333", true, Increase},
+			{"JPY", "200", "201", "1", "0.5", true, Increase},
+			{"USD", "0.01", "99999999999999999.99", "99999999999999999.98", "0", false, Increase},
+			{"USD", "0.01", "-99999999999999999.98", "-99999999999999999.99", "0", false, Decrease},
+			{"USD", "1.00", "99999999999999999.99", "99999999999999998.99", "9999999999999999899", true, Increase},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.a)
+			b := MustParseAmount(tt.curr, tt.b)
+			got, err := Diff(a, b)
+			if err != nil {
+				t.Errorf("Diff(%q, %q) failed: %v", a, b, err)
+				continue
+			}
+			if got.From != a || got.To != b {
+				t.Errorf("Diff(%q, %q) = [%v, %v], want [%v, %v]", a, b, got.From, got.To, a, b)
+			}
+			if want := MustParseAmount(tt.curr, tt.wantDelta); got.Delta != want {
+				t.Errorf("Diff(%q, %q).Delta = %q, want %q", a, b, got.Delta, want)
+			}
+			if got.Pct.Trim(0).String() != tt.wantPct {
+				t.Errorf("Diff(%q, %q).Pct = %v, want %v", a, b, got.Pct, tt.wantPct)
+			}
+			if got.HasPct != tt.wantHasPct {
+				t.Errorf("Diff(%q, %q).HasPct = %v, want %v", a, b, got.HasPct, tt.wantHasPct)
+			}
+			if got.Direction != tt.wantDirection {
+				t.Errorf("Diff(%q, %q).Direction = %v, want %v", a, b, got.Direction, tt.wantDirection)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curra, a, currb, b string
+		}{
+			"currency 1": {"USD", "1.00", "EUR", "1.00"},
+			"overflow 1": {"USD", "-99999999999999999.99", "USD", "99999999999999999.99"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				a := MustParseAmount(tt.curra, tt.a)
+				b := MustParseAmount(tt.currb, tt.b)
+				_, err := Diff(a, b)
+				if err == nil {
+					t.Errorf("Diff(%q, %q) did not fail", a, b)
+				}
+			})
+		}
+	})
+}
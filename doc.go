@@ -55,7 +55,7 @@ Consequently, amounts and exchange rates between -0.00000000000000000005 and
 The package provides methods for converting:
 
   - from/to string:
-    [ParseAmount], [Amount.String], [Amount.Format],
+    [ParseAmount], [ParseAmountRound], [Amount.String], [Amount.Format],
     [ParseExchRate], [ExchangeRate.String], [ExchangeRate.Format].
   - from/to float64:
     [NewAmountFromFloat64], [Amount.Float64],
@@ -125,6 +125,11 @@ explicit rounding:
   - rounding towards zero:
     [Amount.Trunc], [Amount.TruncToCurr], [ExchangeRate.Trunc].
 
+The behavior of [Amount.RoundToCurr], [Amount.CeilToCurr], [Amount.FloorToCurr],
+and [Amount.TruncToCurr] can be customized for a particular currency by
+registering a [RoundingPolicy] with [SetRoundingPolicy].
+For example, amounts in Swiss Francs can be rounded to multiples of 0.05.
+
 See the documentation for each method for more details.
 
 # Errors
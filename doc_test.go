@@ -1153,6 +1153,16 @@ func ExampleAmount_CeilToCurr() {
 	// OMR 5.678
 }
 
+func ExampleAmount_CeilToCurrChanged() {
+	a := money.MustParseAmount("USD", "5.678")
+	b := money.MustParseAmount("USD", "5.6")
+	fmt.Println(a.CeilToCurrChanged())
+	fmt.Println(b.CeilToCurrChanged())
+	// Output:
+	// USD 5.68 true
+	// USD 5.60 false
+}
+
 func ExampleAmount_Floor_currencies() {
 	a := money.MustParseAmount("JPY", "5.678")
 	b := money.MustParseAmount("USD", "5.678")
@@ -1263,6 +1273,19 @@ func ExampleAmount_Trim_scales() {
 	// USD 5.0000
 }
 
+func ExampleAmount_CanonicalString() {
+	a := money.MustParseAmount("USD", "5.6")
+	b := money.MustParseAmount("USD", "5.600")
+	c := money.MustParseAmount("USD", "5.601")
+	fmt.Println(a.CanonicalString())
+	fmt.Println(b.CanonicalString())
+	fmt.Println(c.CanonicalString())
+	// Output:
+	// USD 5.60
+	// USD 5.60
+	// USD 5.601
+}
+
 func ExampleAmount_TrimToCurr() {
 	a := money.MustParseAmount("JPY", "5.000")
 	b := money.MustParseAmount("USD", "5.000")
@@ -2180,3 +2203,25 @@ func ExampleExchangeRate_Ceil_scales() {
 	// EUR/USD 5.679 <nil>
 	// EUR/USD 5.6789 <nil>
 }
+
+func ExampleSQLAmount() {
+	type Product struct {
+		Name  string          `db:"name"`
+		Price money.SQLAmount `db:"price"`
+	}
+
+	// Scanning, as done by sqlx for each tagged field
+	var p Product
+	_ = p.Price.Scan([]byte("(USD,5.67)"))
+	fmt.Println(p.Price.Amount)
+
+	// Writing, as done by database/sql for each argument
+	p.Price.Layout = money.SQLComposite
+	fmt.Println(p.Price.Value())
+	p.Price.Layout = money.SQLText
+	fmt.Println(p.Price.Value())
+	// Output:
+	// USD 5.67
+	// (USD,5.67) <nil>
+	// USD 5.67 <nil>
+}
@@ -0,0 +1,134 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/govalues/decimal"
+)
+
+// DualAmount represents a monetary amount expressed in two currencies:
+// the original amount, the booked amount, and the exchange rate that was used
+// to convert the original amount into the booked amount.
+// In accounting, the original currency is often called the document
+// currency and the booked currency is called the account currency.
+// Its zero value corresponds to "XXX 0 (XXX 0 @ XXX/XXX 0)".
+// DualAmount is designed to be safe for concurrent use by multiple goroutines.
+type DualAmount struct {
+	orig   Amount       // amount in the original (document) currency
+	booked Amount       // amount in the booked (account) currency
+	rate   ExchangeRate // rate used to convert the original amount
+}
+
+// newDualAmountUnsafe creates a new dual amount without checking the consistency
+// of its components.
+// Use it only if you are absolutely sure that the arguments are valid.
+func newDualAmountUnsafe(orig, booked Amount, rate ExchangeRate) DualAmount {
+	return DualAmount{orig: orig, booked: booked, rate: rate}
+}
+
+// NewDualAmount returns a dual amount with the specified original amount,
+// booked amount, and exchange rate.
+// The booked amount is expected to be the result of applying the exchange rate
+// to the original amount, possibly rounded with any rounding method.
+// Therefore, the booked amount may differ from the exact converted amount
+// by less than one minor unit of the booked currency.
+// See also constructor [ConvDualAmount].
+//
+// NewDualAmount returns an error if:
+//   - the base currency of the rate does not match the currency of the original amount;
+//   - the quote currency of the rate does not match the currency of the booked amount;
+//   - the booked amount differs from the converted amount by one minor unit
+//     of the booked currency or more.
+func NewDualAmount(orig, booked Amount, rate ExchangeRate) (DualAmount, error) {
+	d, err := newDualAmountSafe(orig, booked, rate)
+	if err != nil {
+		return DualAmount{}, fmt.Errorf("validating [%v] as [%v] at [%v]: %w", orig, booked, rate, err)
+	}
+	return d, nil
+}
+
+func newDualAmountSafe(orig, booked Amount, rate ExchangeRate) (DualAmount, error) {
+	if rate.Quote() != booked.Curr() {
+		return DualAmount{}, mismatchError(rate.Quote(), booked.Curr())
+	}
+	conv, err := rate.conv(orig)
+	if err != nil {
+		return DualAmount{}, err
+	}
+	diff, err := booked.sub(conv)
+	if err != nil {
+		return DualAmount{}, err
+	}
+	c := booked.Curr()
+	tol, err := decimal.New(1, c.Scale())
+	if err != nil {
+		return DualAmount{}, err
+	}
+	if diff.Decimal().CmpAbs(tol) >= 0 {
+		return DualAmount{}, fmt.Errorf("booked amount is inconsistent with the exchange rate")
+	}
+	return newDualAmountUnsafe(orig, booked, rate), nil
+}
+
+// ConvDualAmount converts the original amount using the exchange rate and
+// returns a dual amount whose booked amount is rounded to the scale of the quote
+// currency using [rounding half to even] (banker's rounding).
+// See also constructor [NewDualAmount] and method [ExchangeRate.Conv].
+//
+// ConvDualAmount returns an error if:
+//   - the base currency of the rate does not match the currency of the original amount;
+//   - the integer part of the converted amount has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func ConvDualAmount(orig Amount, rate ExchangeRate) (DualAmount, error) {
+	booked, err := rate.Conv(orig)
+	if err != nil {
+		return DualAmount{}, err
+	}
+	booked = booked.RoundToCurr()
+	return newDualAmountUnsafe(orig, booked, rate), nil
+}
+
+// Orig returns the amount in the original (document) currency.
+func (d DualAmount) Orig() Amount {
+	return d.orig
+}
+
+// Booked returns the amount in the booked (account) currency.
+func (d DualAmount) Booked() Amount {
+	return d.booked
+}
+
+// Rate returns the exchange rate used to convert the original amount
+// into the booked amount.
+func (d DualAmount) Rate() ExchangeRate {
+	return d.rate
+}
+
+// Residual returns the difference between the booked amount and
+// the exact converted amount.
+// The residual is non-zero when the booked amount was rounded.
+//
+// Residual returns an error if the integer part of the converted amount has more than
+// ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func (d DualAmount) Residual() (Amount, error) {
+	conv, err := d.Rate().Conv(d.Orig())
+	if err != nil {
+		return Amount{}, err
+	}
+	res, err := d.Booked().Sub(conv)
+	if err != nil {
+		return Amount{}, err
+	}
+	return res, nil
+}
+
+// String implements the [fmt.Stringer] interface and returns a string
+// representation of the dual amount.
+// See also methods [Amount.String] and [ExchangeRate.String].
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (d DualAmount) String() string {
+	return d.Orig().String() + " (" + d.Booked().String() + " @ " + d.Rate().String() + ")"
+}
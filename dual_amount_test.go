@@ -0,0 +1,116 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestNewDualAmount(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			b, q, r, orig, booked string
+		}{
+			{"EUR", "USD", "1.0995", "100.00", "109.95"},
+			{"EUR", "USD", "1.0995", "100.01", "109.96"},
+			{"EUR", "USD", "1.0995", "100.01", "109.97"},
+			{"EUR", "USD", "1.0995", "100.01", "109.961"},
+			{"JPY", "USD", "0.0075", "1", "0.01"},
+			{"JPY", "USD", "0.0075", "1", "0.00"},
+			{"USD", "JPY", "149.53", "1.00", "150"},
+			{"USD", "JPY", "149.53", "1.00", "149"},
+		}
+		for _, tt := range tests {
+			r := MustParseExchRate(tt.b, tt.q, tt.r)
+			orig := MustParseAmount(tt.b, tt.orig)
+			booked := MustParseAmount(tt.q, tt.booked)
+			got, err := NewDualAmount(orig, booked, r)
+			if err != nil {
+				t.Errorf("NewDualAmount(%q, %q, %q) failed: %v", orig, booked, r, err)
+				continue
+			}
+			if got.Orig() != orig || got.Booked() != booked || got.Rate() != r {
+				t.Errorf("NewDualAmount(%q, %q, %q) = %v", orig, booked, r, got)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			b, q, r, origCurr, orig, bookedCurr, booked string
+		}{
+			"currency 1":     {"EUR", "USD", "1.0995", "USD", "100.00", "USD", "109.95"},
+			"currency 2":     {"EUR", "USD", "1.0995", "EUR", "100.00", "EUR", "109.95"},
+			"inconsistent 1": {"EUR", "USD", "1.0995", "EUR", "100.00", "USD", "109.94"},
+			"inconsistent 2": {"EUR", "USD", "1.0995", "EUR", "100.00", "USD", "109.96"},
+			"inconsistent 3": {"USD", "JPY", "149.53", "USD", "1.00", "JPY", "151"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				r := MustParseExchRate(tt.b, tt.q, tt.r)
+				orig := MustParseAmount(tt.origCurr, tt.orig)
+				booked := MustParseAmount(tt.bookedCurr, tt.booked)
+				_, err := NewDualAmount(orig, booked, r)
+				if err == nil {
+					t.Errorf("NewDualAmount(%q, %q, %q) did not fail", orig, booked, r)
+				}
+			})
+		}
+	})
+}
+
+func TestConvDualAmount(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			b, q, r, orig, wantBooked, wantResidual string
+		}{
+			{"EUR", "USD", "1.0995", "100.00", "109.95", "0.000000"},
+			{"EUR", "USD", "1.0995", "100.01", "109.96", "-0.000995"},
+			{"JPY", "USD", "0.0075", "1", "0.01", "0.0025"},
+			{"USD", "JPY", "149.53", "1.00", "150", "0.4700"},
+		}
+		for _, tt := range tests {
+			r := MustParseExchRate(tt.b, tt.q, tt.r)
+			orig := MustParseAmount(tt.b, tt.orig)
+			got, err := ConvDualAmount(orig, r)
+			if err != nil {
+				t.Errorf("ConvDualAmount(%q, %q) failed: %v", orig, r, err)
+				continue
+			}
+			wantBooked := MustParseAmount(tt.q, tt.wantBooked)
+			if got.Booked() != wantBooked {
+				t.Errorf("ConvDualAmount(%q, %q).Booked() = %q, want %q", orig, r, got.Booked(), wantBooked)
+			}
+			gotResidual, err := got.Residual()
+			if err != nil {
+				t.Errorf("%v.Residual() failed: %v", got, err)
+				continue
+			}
+			wantResidual := MustParseAmount(tt.q, tt.wantResidual)
+			if gotResidual != wantResidual {
+				t.Errorf("%v.Residual() = %q, want %q", got, gotResidual, wantResidual)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		r := MustParseExchRate("EUR", "USD", "1.0995")
+		orig := MustParseAmount("USD", "100.00")
+		_, err := ConvDualAmount(orig, r)
+		if err == nil {
+			t.Errorf("ConvDualAmount(%q, %q) did not fail", orig, r)
+		}
+	})
+}
+
+func TestDualAmount_String(t *testing.T) {
+	r := MustParseExchRate("EUR", "USD", "1.0995")
+	orig := MustParseAmount("EUR", "100.00")
+	d, err := ConvDualAmount(orig, r)
+	if err != nil {
+		t.Fatalf("ConvDualAmount(%q, %q) failed: %v", orig, r, err)
+	}
+	got := d.String()
+	want := "EUR 100.00 (USD 109.95 @ EUR/USD 1.0995)"
+	if got != want {
+		t.Errorf("%v.String() = %q, want %q", d, got, want)
+	}
+}
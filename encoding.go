@@ -0,0 +1,404 @@
+package money
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/govalues/decimal"
+)
+
+// EncodingVersion is the version of the binary layout produced by
+// [Amount.MarshalBinary].
+// It is incremented whenever the layout changes, and [DecodeAny] keeps
+// decoding all layouts produced by previous versions of this package,
+// so amounts stored in long-lived storage, such as event logs, remain readable.
+//
+// The layouts are:
+//
+//	| Version | Layout                                                     | Example                 |
+//	| ------- | ---------------------------------------------------------- | ----------------------- |
+//	| 0       | text produced by [Amount.String], without a version byte   | "USD 1.23"              |
+//	|         | or by the %m verb of [Amount.Format]                       | "USD1.23"               |
+//	| 1       | version byte, 3-byte currency code, decimal string         | "\x01USD1.23"           |
+const EncodingVersion = 1
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// It encodes the amount using the layout of [EncodingVersion].
+// The currency is encoded by its alphabetic code, so that encoded amounts
+// do not depend on the internal representation of currencies.
+// The scale of the amount is preserved, so numerically equal amounts may
+// have different encodings; use [Amount.Normalize] before encoding if
+// the encoded amounts are compared byte by byte.
+// See also function [DecodeAny].
+//
+// [encoding.BinaryMarshaler]: https://pkg.go.dev/encoding#BinaryMarshaler
+func (a Amount) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, 32)
+	data = append(data, EncodingVersion)
+	data = append(data, a.Curr().Code()...)
+	data = append(data, a.Decimal().String()...)
+	return data, nil
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// It accepts all layouts supported by [DecodeAny].
+//
+// [encoding.BinaryUnmarshaler]: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (a *Amount) UnmarshalBinary(data []byte) error {
+	var err error
+	*a, err = DecodeAny(data)
+	return err
+}
+
+// DecodeAny decodes an amount encoded using the current or any prior
+// layout described in [EncodingVersion].
+// The layout is detected automatically: version 0 data starts with
+// an uppercase letter, while later layouts start with their version byte.
+// The scale of the encoded amount is preserved.
+//
+// DecodeAny returns an error if:
+//   - the data is empty or truncated;
+//   - the version is greater than [EncodingVersion];
+//   - the currency code or the decimal string is not valid.
+func DecodeAny(data []byte) (Amount, error) {
+	a, err := decodeAny(data)
+	if err != nil {
+		return Amount{}, fmt.Errorf("decoding %q: %w", data, err)
+	}
+	return a, nil
+}
+
+func decodeAny(data []byte) (Amount, error) {
+	if len(data) == 0 {
+		return Amount{}, fmt.Errorf("no data")
+	}
+	switch v := data[0]; {
+	case 'A' <= v && v <= 'Z':
+		return decodeV0(data)
+	case v == 1:
+		return decodeV1(data)
+	default:
+		return Amount{}, fmt.Errorf("unsupported encoding version %v", v)
+	}
+}
+
+// decodeV0 decodes the text produced by [Amount.String] or by the %m verb
+// of [Amount.Format], where the amount immediately follows the currency code.
+func decodeV0(data []byte) (Amount, error) {
+	n := 0
+	for n < len(data) && ('A' <= data[n] && data[n] <= 'Z' || 'a' <= data[n] && data[n] <= 'z') {
+		n++
+	}
+	if n > 0 && n < len(data) && data[n] != ' ' {
+		return ParseAmount(string(data[:n]), string(data[n:]))
+	}
+	for i, b := range data {
+		if b == ' ' {
+			return ParseAmount(string(data[:i]), string(data[i+1:]))
+		}
+	}
+	return Amount{}, fmt.Errorf("missing delimiter")
+}
+
+// decodeV1 decodes the version byte, followed by the 3-byte currency code and
+// the decimal string.
+func decodeV1(data []byte) (Amount, error) {
+	if len(data) < 5 {
+		return Amount{}, fmt.Errorf("data is truncated")
+	}
+	return ParseAmount(string(data[1:4]), string(data[4:]))
+}
+
+// AppendCompact appends the compact text form of the amount to buf and
+// returns the extended buffer.
+// See also function [EncodeCompact].
+func AppendCompact(buf []byte, a Amount) []byte {
+	coef, scale, neg, c := a.Components()
+	if num := c.Num(); num != "" {
+		buf = append(buf, num...)
+	} else {
+		buf = append(buf, c.Code()...)
+	}
+	buf = append(buf, ':')
+	if neg {
+		buf = append(buf, '-')
+	}
+	buf = strconv.AppendUint(buf, coef, 10)
+	buf = append(buf, ':')
+	buf = strconv.AppendInt(buf, int64(scale), 10)
+	return buf
+}
+
+// EncodeCompact returns the compact text form of the amount, which consists
+// of the numeric currency code, the coefficient, and the scale separated by
+// colons, for example, "840:567:2" for "USD 5.67".
+// Currencies without a numeric code, such as those added using
+// [RegisterCurr], are encoded by their alphabetic code instead.
+// The form is shorter and cheaper to decode than [Amount.String], which makes
+// it suitable for high-throughput caches, and preserves the scale of
+// the amount; use [Amount.Normalize] before encoding if the encoded amounts
+// are used as cache keys.
+// See also functions [AppendCompact] and [DecodeCompact].
+func EncodeCompact(a Amount) string {
+	var buf [32]byte
+	return string(AppendCompact(buf[:0], a))
+}
+
+// DecodeCompact converts the compact text form produced by [EncodeCompact]
+// to an amount.
+//
+// DecodeCompact returns an error if:
+//   - the string does not consist of three parts separated by colons;
+//   - the currency code is not valid;
+//   - the coefficient is not an integer with at most [decimal.MaxPrec] digits;
+//   - the scale is negative or greater than [decimal.MaxScale];
+//   - the scale is less than the scale of the currency.
+func DecodeCompact(s string) (Amount, error) {
+	a, err := decodeCompact(s)
+	if err != nil {
+		return Amount{}, fmt.Errorf("decoding %q: %w", s, err)
+	}
+	return a, nil
+}
+
+func decodeCompact(s string) (Amount, error) {
+	curr, rest, ok := strings.Cut(s, ":")
+	if !ok {
+		return Amount{}, fmt.Errorf("missing delimiter")
+	}
+	coef, scale, ok := strings.Cut(rest, ":")
+	if !ok {
+		return Amount{}, fmt.Errorf("missing delimiter")
+	}
+
+	// Currency
+	c, err := ParseCurr(curr)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	// Coefficient
+	digits := strings.TrimPrefix(coef, "-")
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return Amount{}, fmt.Errorf("invalid coefficient %q", coef)
+	}
+	if len(strings.TrimLeft(digits, "0")) > decimal.MaxPrec {
+		return Amount{}, fmt.Errorf("coefficient %q has too many digits", coef)
+	}
+	d, err := decimal.Parse(coef)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	// Scale
+	n, err := strconv.Atoi(scale)
+	if err != nil {
+		return Amount{}, fmt.Errorf("invalid scale %q", scale)
+	}
+	if n < c.Scale() {
+		return Amount{}, fmt.Errorf("scale %v is less than the scale of %v", n, c)
+	}
+	p, err := decimal.New(1, n)
+	if err != nil {
+		return Amount{}, err
+	}
+	d, err = d.Mul(p)
+	if err != nil {
+		return Amount{}, err
+	}
+	return newAmountUnsafe(c, d), nil
+}
+
+// ScalePolicy specifies the scale of amounts encoded by the text and
+// the JSON codecs, since downstream systems have conflicting expectations
+// about trailing zeros.
+// See also type [CodecOptions].
+type ScalePolicy int8
+
+const (
+	// ScalePreserve encodes amounts with their stored scale,
+	// for example, "USD 5.670".
+	// This is the default policy.
+	ScalePreserve ScalePolicy = iota
+	// ScaleTrim removes trailing zeros, but never below the scale of
+	// the currency, for example, "USD 5.67" for "USD 5.670".
+	ScaleTrim
+	// ScaleCurr rounds amounts to the scale of their currency using
+	// [Amount.Round], for example, "USD 5.68" for "USD 5.675".
+	// Rounding policies registered using [SetRoundingPolicy] are not applied,
+	// so the encoded amount never moves by more than half a minor unit.
+	ScaleCurr
+)
+
+// String implements the [fmt.Stringer] interface and returns
+// a string representation of the scale policy.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (p ScalePolicy) String() string {
+	switch p {
+	case ScalePreserve:
+		return "preserve"
+	case ScaleTrim:
+		return "trim"
+	case ScaleCurr:
+		return "curr"
+	default:
+		return fmt.Sprintf("ScalePolicy(%d)", int8(p))
+	}
+}
+
+// CodecOptions specifies how amounts are encoded by [Amount.AppendText],
+// [Amount.MarshalText], and [JSONNumber.MarshalJSON], and hence by
+// the JSON codecs.
+// Decoding is not affected, and the binary and compact layouts always
+// preserve the scale.
+// The zero value corresponds to the default options.
+// See also function [SetCodecOptions].
+type CodecOptions struct {
+	// Scale is the scale policy of encoded amounts.
+	Scale ScalePolicy
+}
+
+// codecOptions holds the registered options.
+// A nil value means the default options.
+var codecOptions atomic.Pointer[CodecOptions]
+
+// SetCodecOptions registers the options of the text and the JSON codecs,
+// replacing any previously registered options.
+// Setting the zero value restores the default options.
+// SetCodecOptions is safe for concurrent use by multiple goroutines,
+// but it is intended to be called during program initialization.
+//
+// SetCodecOptions returns an error if the scale policy is not valid.
+func SetCodecOptions(o CodecOptions) error {
+	switch o.Scale {
+	case ScalePreserve, ScaleTrim, ScaleCurr:
+	default:
+		return fmt.Errorf("setting codec options: unknown scale policy %v", o.Scale)
+	}
+	if o == (CodecOptions{}) {
+		codecOptions.Store(nil)
+		return nil
+	}
+	codecOptions.Store(&o)
+	return nil
+}
+
+// encodable returns the amount rescaled according to the registered
+// scale policy.
+func (a Amount) encodable() Amount {
+	o := codecOptions.Load()
+	if o == nil {
+		return a
+	}
+	switch o.Scale {
+	case ScaleTrim:
+		return a.Trim(0)
+	case ScaleCurr:
+		return a.Round(a.Curr().Scale())
+	default:
+		return a
+	}
+}
+
+// AppendText implements the [encoding.TextAppender] interface.
+// It appends the text produced by [Amount.String] to buf and returns
+// the extended buffer.
+// The scale of the amount is adjusted according to the options registered
+// using [SetCodecOptions].
+//
+// [encoding.TextAppender]: https://pkg.go.dev/encoding#TextAppender
+func (a Amount) AppendText(buf []byte) ([]byte, error) {
+	return a.encodable().appendText(buf), nil
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// It returns the text produced by [Amount.String], so amounts are encoded
+// as JSON strings, for example, "USD 5.67".
+// The scale of the amount is adjusted according to the options registered
+// using [SetCodecOptions].
+// See also method [Amount.AppendText].
+//
+// [encoding.TextMarshaler]: https://pkg.go.dev/encoding#TextMarshaler
+func (a Amount) MarshalText() ([]byte, error) {
+	return a.encodable().appendText(make([]byte, 0, 32)), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// It accepts the text produced by [Amount.String] or by the %m verb of
+// [Amount.Format].
+// The scale of the encoded amount is preserved.
+//
+// [encoding.TextUnmarshaler]: https://pkg.go.dev/encoding#TextUnmarshaler
+func (a *Amount) UnmarshalText(text []byte) error {
+	var err error
+	*a, err = parseAmountText(text)
+	return err
+}
+
+// parseAmountText decodes the text form of an amount.
+// It is shared by the text and the JSON codecs, so all of them apply
+// the same validation.
+func parseAmountText(text []byte) (Amount, error) {
+	a, err := decodeV0(text)
+	if err != nil {
+		return Amount{}, fmt.Errorf("parsing %q: %w", text, err)
+	}
+	return a, nil
+}
+
+// AppendText implements the [encoding.TextAppender] interface.
+// It appends the text produced by [ExchangeRate.String] to buf and returns
+// the extended buffer.
+//
+// [encoding.TextAppender]: https://pkg.go.dev/encoding#TextAppender
+func (r ExchangeRate) AppendText(buf []byte) ([]byte, error) {
+	return r.appendText(buf), nil
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// It returns the text produced by [ExchangeRate.String], so rates are encoded
+// as JSON strings, for example, "EUR/USD 1.0845".
+// See also method [ExchangeRate.AppendText].
+//
+// [encoding.TextMarshaler]: https://pkg.go.dev/encoding#TextMarshaler
+func (r ExchangeRate) MarshalText() ([]byte, error) {
+	return r.appendText(make([]byte, 0, 32)), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// It accepts the text produced by [ExchangeRate.String].
+//
+// [encoding.TextUnmarshaler]: https://pkg.go.dev/encoding#TextUnmarshaler
+func (r *ExchangeRate) UnmarshalText(text []byte) error {
+	var err error
+	*r, err = parseExchRateText(text)
+	return err
+}
+
+// parseExchRateText decodes the text form of an exchange rate.
+// It is shared by the text and the JSON codecs, so all of them apply
+// the same validation.
+func parseExchRateText(text []byte) (ExchangeRate, error) {
+	pair, rate, ok := strings.Cut(string(text), " ")
+	if !ok {
+		return ExchangeRate{}, fmt.Errorf("parsing %q: missing delimiter", text)
+	}
+	r, err := parseExchRatePair(pair, rate)
+	if err != nil {
+		return ExchangeRate{}, fmt.Errorf("parsing %q: %w", text, err)
+	}
+	return r, nil
+}
+
+// parseExchRatePair converts a currency pair, such as "EUR/USD", and
+// a decimal string to a rate.
+func parseExchRatePair(pair, rate string) (ExchangeRate, error) {
+	base, quote, ok := strings.Cut(pair, "/")
+	if !ok {
+		return ExchangeRate{}, fmt.Errorf("currency pair must be separated by a slash")
+	}
+	return ParseExchRate(base, quote, rate)
+}
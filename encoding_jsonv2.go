@@ -0,0 +1,99 @@
+//go:build goexperiment.jsonv2 && go1.27
+
+package money
+
+import (
+	"bytes"
+	"encoding/json/jsontext"
+	"fmt"
+)
+
+// MarshalJSONTo implements the MarshalerTo interface of [encoding/json/v2].
+// It writes the text produced by [Amount.String] as a JSON string directly
+// to the encoder without allocating intermediate buffers.
+// The scale of the amount is adjusted according to the options registered
+// using [SetCodecOptions].
+// See also method [Amount.MarshalText].
+//
+// [encoding/json/v2]: https://pkg.go.dev/encoding/json/v2
+func (a Amount) MarshalJSONTo(enc *jsontext.Encoder) error {
+	var buf [40]byte
+	b := append(buf[:0], '"')
+	b = a.encodable().appendText(b)
+	b = append(b, '"')
+	return enc.WriteValue(b)
+}
+
+// UnmarshalJSONFrom implements the UnmarshalerFrom interface of
+// [encoding/json/v2].
+// It accepts the same JSON strings as [Amount.UnmarshalText] and sets
+// the amount to the zero value for a JSON null.
+//
+// [encoding/json/v2]: https://pkg.go.dev/encoding/json/v2
+func (a *Amount) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	text, err := readJSONString(dec)
+	if err != nil {
+		return err
+	}
+	if text == nil {
+		*a = Amount{}
+		return nil
+	}
+	*a, err = parseAmountText(text)
+	return err
+}
+
+// MarshalJSONTo implements the MarshalerTo interface of [encoding/json/v2].
+// It writes the text produced by [ExchangeRate.String] as a JSON string
+// directly to the encoder without allocating intermediate buffers.
+// See also method [ExchangeRate.MarshalText].
+//
+// [encoding/json/v2]: https://pkg.go.dev/encoding/json/v2
+func (r ExchangeRate) MarshalJSONTo(enc *jsontext.Encoder) error {
+	var buf [40]byte
+	b := append(buf[:0], '"')
+	b = r.appendText(b)
+	b = append(b, '"')
+	return enc.WriteValue(b)
+}
+
+// UnmarshalJSONFrom implements the UnmarshalerFrom interface of
+// [encoding/json/v2].
+// It accepts the same JSON strings as [ExchangeRate.UnmarshalText] and sets
+// the rate to the zero value for a JSON null.
+//
+// [encoding/json/v2]: https://pkg.go.dev/encoding/json/v2
+func (r *ExchangeRate) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	text, err := readJSONString(dec)
+	if err != nil {
+		return err
+	}
+	if text == nil {
+		*r = ExchangeRate{}
+		return nil
+	}
+	*r, err = parseExchRateText(text)
+	return err
+}
+
+// readJSONString reads the next JSON value from the decoder and returns
+// the contents of the string, or nil if the value is null.
+// The returned slice is only valid until the next read from the decoder.
+func readJSONString(dec *jsontext.Decoder) ([]byte, error) {
+	v, err := dec.ReadValue()
+	if err != nil {
+		return nil, err
+	}
+	switch v.Kind() {
+	case 'n':
+		return nil, nil
+	case '"':
+		// Fast path
+		if bytes.IndexByte(v, '\\') < 0 {
+			return v[1 : len(v)-1], nil
+		}
+		return jsontext.AppendUnquote(nil, v)
+	default:
+		return nil, fmt.Errorf("unmarshaling %s: expected JSON string", v)
+	}
+}
@@ -0,0 +1,100 @@
+//go:build goexperiment.jsonv2 && go1.27
+
+package money
+
+import (
+	"encoding/json/v2"
+	"testing"
+)
+
+func TestAmount_MarshalJSONTo(t *testing.T) {
+	type payment struct {
+		Amount Amount       `json:"amount"`
+		Rate   ExchangeRate `json:"rate"`
+	}
+	p := payment{
+		Amount: MustParseAmount("USD", "5.670"),
+		Rate:   MustParseExchRate("USD", "EUR", "0.9123"),
+	}
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("json.Marshal(%v) failed: %v", p, err)
+	}
+	want := `{"amount":"USD 5.670","rate":"USD/EUR 0.9123"}`
+	if got := string(data); got != want {
+		t.Errorf("json.Marshal(%v) = %s, want %s", p, got, want)
+	}
+	var got payment
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal(%s) failed: %v", data, err)
+	}
+	if got != p {
+		t.Errorf("json.Unmarshal(%s) = %v, want %v", data, got, p)
+	}
+}
+
+func TestAmount_UnmarshalJSONFrom(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			data string
+			want Amount
+		}{
+			{`"USD 1.23"`, MustParseAmount("USD", "1.23")},
+			{`"USD\u00201.23"`, MustParseAmount("USD", "1.23")},
+			{`"USD1.23"`, MustParseAmount("USD", "1.23")},
+			{`null`, Amount{}},
+		}
+		for _, tt := range tests {
+			got := MustParseAmount("EUR", "1")
+			if err := json.Unmarshal([]byte(tt.data), &got); err != nil {
+				t.Errorf("json.Unmarshal(%s) failed: %v", tt.data, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("json.Unmarshal(%s) = %q, want %q", tt.data, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{`1.23`, `"ZZZ 1.23"`, `"USD"`, `{}`, `"USD 1.2.3"`}
+		for _, data := range tests {
+			var got Amount
+			if err := json.Unmarshal([]byte(data), &got); err == nil {
+				t.Errorf("json.Unmarshal(%s) did not fail", data)
+			}
+		}
+	})
+}
+
+func TestExchangeRate_UnmarshalJSONFrom(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			data string
+			want ExchangeRate
+		}{
+			{`"EUR/USD 1.0845"`, MustParseExchRate("EUR", "USD", "1.0845")},
+			{`null`, ExchangeRate{}},
+		}
+		for _, tt := range tests {
+			got := MustParseExchRate("USD", "JPY", "150")
+			if err := json.Unmarshal([]byte(tt.data), &got); err != nil {
+				t.Errorf("json.Unmarshal(%s) failed: %v", tt.data, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("json.Unmarshal(%s) = %q, want %q", tt.data, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{`1.0845`, `"EUR/USD"`, `"EUR/USD 0"`, `[]`}
+		for _, data := range tests {
+			var got ExchangeRate
+			if err := json.Unmarshal([]byte(data), &got); err == nil {
+				t.Errorf("json.Unmarshal(%s) did not fail", data)
+			}
+		}
+	})
+}
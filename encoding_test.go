@@ -0,0 +1,445 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAmount_MarshalBinary(t *testing.T) {
+	tests := []struct {
+		curr, a string
+		want    string
+	}{
+		{"USD", "1.23", "\x01USD1.23"},
+		{"USD", "-0.5", "\x01USD-0.50"},
+		{"JPY", "100", "\x01JPY100"},
+		{"OMR", "0.0000000000000000001", "\x01OMR0.0000000000000000001"},
+		{"XXX", "0", "\x01XXX0"},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.curr, tt.a)
+		got, err := a.MarshalBinary()
+		if err != nil {
+			t.Errorf("%q.MarshalBinary() failed: %v", a, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("%q.MarshalBinary() = %q, want %q", a, got, tt.want)
+		}
+		var b Amount
+		err = b.UnmarshalBinary(got)
+		if err != nil {
+			t.Errorf("UnmarshalBinary(%q) failed: %v", got, err)
+			continue
+		}
+		if b != a {
+			t.Errorf("UnmarshalBinary(%q) = %q, want %q", got, b, a)
+		}
+	}
+}
+
+func TestDecodeAny(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			data, curr, want string
+		}{
+			// Version 0
+			{"USD 1.23", "USD", "1.23"},
+			{"USD -1.230", "USD", "-1.230"},
+			{"JPY 100", "JPY", "100"},
+			{"USD1.23", "USD", "1.23"},
+			{"USD-1.230", "USD", "-1.230"},
+			// Version 1
+			{"\x01USD1.23", "USD", "1.23"},
+			{"\x01USD-1.230", "USD", "-1.230"},
+			{"\x01JPY100", "JPY", "100"},
+		}
+		for _, tt := range tests {
+			got, err := DecodeAny([]byte(tt.data))
+			if err != nil {
+				t.Errorf("DecodeAny(%q) failed: %v", tt.data, err)
+				continue
+			}
+			want := MustParseAmount(tt.curr, tt.want)
+			if got != want {
+				t.Errorf("DecodeAny(%q) = %q, want %q", tt.data, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"empty 1":     "",
+			"version 1":   "\x00USD1.23",
+			"version 2":   "\x02USD1.23",
+			"truncated 1": "\x01USD",
+			"truncated 2": "\x01US",
+			"delimiter 1": "USD",
+			"currency 1":  "\x01ZZZ1.23",
+			"currency 2":  "ZZZ 1.23",
+			"decimal 1":   "\x01USDabc",
+			"decimal 2":   "USD 1.2.3",
+		}
+		for name, data := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := DecodeAny([]byte(data))
+				if err == nil {
+					t.Errorf("DecodeAny(%q) did not fail", data)
+				}
+			})
+		}
+	})
+}
+
+func TestEncodeCompact(t *testing.T) {
+	tests := []struct {
+		curr, amount, want string
+	}{
+		{"USD", "5.67", "840:567:2"},
+		{"USD", "5.670", "840:5670:3"},
+		{"USD", "-5.67", "840:-567:2"},
+		{"USD", "0", "840:0:2"},
+		{"JPY", "100", "392:100:0"},
+		{"XXX", "1", "999:1:0"},
+		{"USD", "99999999999999999.99", "840:9999999999999999999:2"},
+		{"USD", "0.0000000000000000001", "840:1:19"},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.curr, tt.amount)
+		got := EncodeCompact(a)
+		if got != tt.want {
+			t.Errorf("EncodeCompact(%q) = %q, want %q", a, got, tt.want)
+		}
+		b, err := DecodeCompact(got)
+		if err != nil {
+			t.Errorf("DecodeCompact(%q) failed: %v", got, err)
+			continue
+		}
+		if b != a {
+			t.Errorf("DecodeCompact(%q) = %q, want %q", got, b, a)
+		}
+	}
+
+	t.Run("registered", func(t *testing.T) {
+		defer resetCurrencies()
+		if _, err := RegisterCurr("XFL", 4); err != nil {
+			t.Fatalf("RegisterCurr(\"XFL\", 4) failed: %v", err)
+		}
+		a := MustParseAmount("XFL", "1.5")
+		got := EncodeCompact(a)
+		if want := "XFL:15000:4"; got != want {
+			t.Errorf("EncodeCompact(%q) = %q, want %q", a, got, want)
+		}
+		b, err := DecodeCompact(got)
+		if err != nil {
+			t.Fatalf("DecodeCompact(%q) failed: %v", got, err)
+		}
+		if b != a {
+			t.Errorf("DecodeCompact(%q) = %q, want %q", got, b, a)
+		}
+	})
+}
+
+func TestDecodeCompact(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s, want string
+		}{
+			{"USD:567:2", "USD 5.67"},
+			{"840:0567:2", "USD 5.67"},
+			{"840:-0:2", "USD 0.00"},
+		}
+		for _, tt := range tests {
+			got, err := DecodeCompact(tt.s)
+			if err != nil {
+				t.Errorf("DecodeCompact(%q) failed: %v", tt.s, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("DecodeCompact(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			"",
+			"840",
+			"840:567",
+			"000:567:2",
+			"840::2",
+			"840:-:2",
+			"840:5.67:2",
+			"840:+567:2",
+			"840:567:",
+			"840:567:x",
+			"840:567:1",
+			"840:567:-1",
+			"840:567:20",
+			"840:567:2:1",
+			"840:10000000000000000000:2",
+		}
+		for _, s := range tests {
+			_, err := DecodeCompact(s)
+			if err == nil {
+				t.Errorf("DecodeCompact(%q) did not fail", s)
+			}
+		}
+	})
+}
+
+func TestAmount_MarshalText(t *testing.T) {
+	tests := []struct {
+		curr, a string
+		want    string
+	}{
+		{"USD", "1.23", "USD 1.23"},
+		{"USD", "-1.230", "USD -1.230"},
+		{"JPY", "100", "JPY 100"},
+		{"OMR", "0", "OMR 0.000"},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.curr, tt.a)
+		data, err := a.MarshalText()
+		if err != nil {
+			t.Errorf("%q.MarshalText() failed: %v", a, err)
+			continue
+		}
+		if got := string(data); got != tt.want {
+			t.Errorf("%q.MarshalText() = %q, want %q", a, got, tt.want)
+		}
+		data, err = a.AppendText([]byte("x"))
+		if err != nil {
+			t.Errorf("%q.AppendText() failed: %v", a, err)
+			continue
+		}
+		if got := string(data); got != "x"+tt.want {
+			t.Errorf("%q.AppendText(\"x\") = %q, want %q", a, got, "x"+tt.want)
+		}
+	}
+}
+
+func TestScalePolicy_String(t *testing.T) {
+	tests := []struct {
+		p    ScalePolicy
+		want string
+	}{
+		{ScalePreserve, "preserve"},
+		{ScaleTrim, "trim"},
+		{ScaleCurr, "curr"},
+		{ScalePolicy(-1), "ScalePolicy(-1)"},
+	}
+	for _, tt := range tests {
+		if got := tt.p.String(); got != tt.want {
+			t.Errorf("%v.String() = %q, want %q", int8(tt.p), got, tt.want)
+		}
+	}
+}
+
+func TestSetCodecOptions(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		defer SetCodecOptions(CodecOptions{})
+		tests := []struct {
+			scale   ScalePolicy
+			curr, a string
+			want    string
+		}{
+			{ScalePreserve, "USD", "5.670", "USD 5.670"},
+			{ScaleTrim, "USD", "5.670", "USD 5.67"},
+			{ScaleTrim, "USD", "5.000", "USD 5.00"},
+			{ScaleTrim, "USD", "5.675", "USD 5.675"},
+			{ScaleTrim, "JPY", "100.00", "JPY 100"},
+			{ScaleCurr, "USD", "5.670", "USD 5.67"},
+			{ScaleCurr, "USD", "5.675", "USD 5.68"},
+			{ScaleCurr, "USD", "5.6", "USD 5.60"},
+			{ScaleCurr, "OMR", "1", "OMR 1.000"},
+		}
+		for _, tt := range tests {
+			if err := SetCodecOptions(CodecOptions{Scale: tt.scale}); err != nil {
+				t.Fatalf("SetCodecOptions(%v) failed: %v", tt.scale, err)
+			}
+			a := MustParseAmount(tt.curr, tt.a)
+			data, err := a.MarshalText()
+			if err != nil {
+				t.Errorf("%q.MarshalText() failed: %v", a, err)
+				continue
+			}
+			if got := string(data); got != tt.want {
+				t.Errorf("%v: %q.MarshalText() = %q, want %q", tt.scale, a, got, tt.want)
+			}
+			data, err = json.Marshal(a)
+			if err != nil {
+				t.Errorf("json.Marshal(%q) failed: %v", a, err)
+				continue
+			}
+			if got, want := string(data), "\""+tt.want+"\""; got != want {
+				t.Errorf("%v: json.Marshal(%q) = %s, want %s", tt.scale, a, got, want)
+			}
+			data, err = json.Marshal(JSONNumber(a))
+			if err != nil {
+				t.Errorf("json.Marshal(%q) failed: %v", a, err)
+				continue
+			}
+			if got, want := string(data), `{"currency":"`+tt.curr+`","amount":`+tt.want[4:]+`}`; got != want {
+				t.Errorf("%v: json.Marshal(JSONNumber(%q)) = %s, want %s", tt.scale, a, got, want)
+			}
+			if got := a.String(); got != MustParseAmount(tt.curr, tt.a).String() {
+				t.Errorf("%v: %q.String() = %q, want unchanged", tt.scale, a, got)
+			}
+		}
+	})
+
+	t.Run("policy", func(t *testing.T) {
+		defer SetCodecOptions(CodecOptions{})
+		defer ResetRoundingPolicy(CHF)
+		err := SetRoundingPolicy(CHF, RoundingPolicy{Mode: HalfUp, Increment: MustParseAmount("CHF", "0.05")})
+		if err != nil {
+			t.Fatalf("SetRoundingPolicy() failed: %v", err)
+		}
+		if err := SetCodecOptions(CodecOptions{Scale: ScaleCurr}); err != nil {
+			t.Fatalf("SetCodecOptions() failed: %v", err)
+		}
+		a := MustParseAmount("CHF", "5.674")
+		data, err := a.MarshalText()
+		if err != nil {
+			t.Fatalf("%q.MarshalText() failed: %v", a, err)
+		}
+		if got, want := string(data), "CHF 5.67"; got != want {
+			t.Errorf("%q.MarshalText() = %q, want %q", a, got, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		defer SetCodecOptions(CodecOptions{})
+		tests := map[string]CodecOptions{
+			"scale 1": {Scale: ScalePolicy(-1)},
+			"scale 2": {Scale: ScalePolicy(3)},
+		}
+		for name, o := range tests {
+			err := SetCodecOptions(o)
+			if err == nil {
+				t.Errorf("SetCodecOptions(%v) did not fail: %v", o, name)
+			}
+		}
+	})
+}
+
+func TestAmount_UnmarshalText(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			text, curr, want string
+		}{
+			{"USD 1.23", "USD", "1.23"},
+			{"USD -1.230", "USD", "-1.230"},
+			{"USD1.23", "USD", "1.23"},
+			{"JPY 100", "JPY", "100"},
+		}
+		for _, tt := range tests {
+			var got Amount
+			if err := got.UnmarshalText([]byte(tt.text)); err != nil {
+				t.Errorf("UnmarshalText(%q) failed: %v", tt.text, err)
+				continue
+			}
+			if want := MustParseAmount(tt.curr, tt.want); got != want {
+				t.Errorf("UnmarshalText(%q) = %q, want %q", tt.text, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{"", "USD", "ZZZ 1.23", "USD 1.2.3", "1.23"}
+		for _, text := range tests {
+			var got Amount
+			if err := got.UnmarshalText([]byte(text)); err == nil {
+				t.Errorf("UnmarshalText(%q) did not fail", text)
+			}
+		}
+	})
+}
+
+func TestExchangeRate_MarshalText(t *testing.T) {
+	tests := []struct {
+		b, q, r string
+		want    string
+	}{
+		{"EUR", "USD", "1.0845", "EUR/USD 1.0845"},
+		{"USD", "JPY", "150", "USD/JPY 150"},
+		{"EUR", "USD", "1", "EUR/USD 1.00"},
+	}
+	for _, tt := range tests {
+		r := MustParseExchRate(tt.b, tt.q, tt.r)
+		data, err := r.MarshalText()
+		if err != nil {
+			t.Errorf("%q.MarshalText() failed: %v", r, err)
+			continue
+		}
+		if got := string(data); got != tt.want {
+			t.Errorf("%q.MarshalText() = %q, want %q", r, got, tt.want)
+		}
+		data, err = r.AppendText([]byte("x"))
+		if err != nil {
+			t.Errorf("%q.AppendText() failed: %v", r, err)
+			continue
+		}
+		if got := string(data); got != "x"+tt.want {
+			t.Errorf("%q.AppendText(\"x\") = %q, want %q", r, got, "x"+tt.want)
+		}
+	}
+}
+
+func TestExchangeRate_UnmarshalText(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			text, b, q, want string
+		}{
+			{"EUR/USD 1.0845", "EUR", "USD", "1.0845"},
+			{"USD/JPY 150", "USD", "JPY", "150"},
+		}
+		for _, tt := range tests {
+			var got ExchangeRate
+			if err := got.UnmarshalText([]byte(tt.text)); err != nil {
+				t.Errorf("UnmarshalText(%q) failed: %v", tt.text, err)
+				continue
+			}
+			if want := MustParseExchRate(tt.b, tt.q, tt.want); got != want {
+				t.Errorf("UnmarshalText(%q) = %q, want %q", tt.text, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{"", "EUR/USD", "EURUSD 1.0845", "EUR/ZZZ 1.0845", "EUR/USD 0", "EUR/USD -1", "EUR/USD abc"}
+		for _, text := range tests {
+			var got ExchangeRate
+			if err := got.UnmarshalText([]byte(text)); err == nil {
+				t.Errorf("UnmarshalText(%q) did not fail", text)
+			}
+		}
+	})
+}
+
+func TestAmount_JSON(t *testing.T) {
+	type payment struct {
+		Amount Amount       `json:"amount"`
+		Rate   ExchangeRate `json:"rate"`
+	}
+	p := payment{
+		Amount: MustParseAmount("USD", "5.670"),
+		Rate:   MustParseExchRate("USD", "EUR", "0.9123"),
+	}
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("json.Marshal(%v) failed: %v", p, err)
+	}
+	want := `{"amount":"USD 5.670","rate":"USD/EUR 0.9123"}`
+	if got := string(data); got != want {
+		t.Errorf("json.Marshal(%v) = %s, want %s", p, got, want)
+	}
+	var got payment
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal(%s) failed: %v", data, err)
+	}
+	if got != p {
+		t.Errorf("json.Unmarshal(%s) = %v, want %v", data, got, p)
+	}
+}
@@ -0,0 +1,42 @@
+package money
+
+import "fmt"
+
+// CurrencyMismatchError is the error returned when an operation requires
+// amounts or exchange rates denominated in the same currency, but they are
+// denominated in different currencies.
+// Use [errors.As] to obtain the currencies, for example, to produce
+// a message such as "expected USD, got EUR" without parsing the error string.
+type CurrencyMismatchError struct {
+	Expected Currency // currency required by the operation
+	Got      Currency // currency actually provided
+}
+
+// mismatchError returns a currency mismatch error for the given currencies.
+func mismatchError(expected, got Currency) error {
+	return &CurrencyMismatchError{Expected: expected, Got: got}
+}
+
+// rateMismatchError returns a currency mismatch error for the first pair of
+// different currencies of the exchange rates.
+func rateMismatchError(expected, got ExchangeRate) error {
+	if expected.Base() != got.Base() {
+		return mismatchError(expected.Base(), got.Base())
+	}
+	return mismatchError(expected.Quote(), got.Quote())
+}
+
+// Currencies returns the expected and the actual currencies.
+func (e *CurrencyMismatchError) Currencies() (expected, got Currency) {
+	return e.Expected, e.Got
+}
+
+// Error implements the [error] interface.
+func (e *CurrencyMismatchError) Error() string {
+	return fmt.Sprintf("%v: expected %v, got %v", errCurrencyMismatch, e.Expected, e.Got)
+}
+
+// Unwrap returns the underlying currency mismatch error.
+func (e *CurrencyMismatchError) Unwrap() error {
+	return errCurrencyMismatch
+}
@@ -0,0 +1,54 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCurrencyMismatchError(t *testing.T) {
+	usd := MustParseAmount("USD", "1")
+	eur := MustParseAmount("EUR", "1")
+	rate := MustParseExchRate("EUR", "USD", "1.2")
+	tests := []struct {
+		name         string
+		f            func() error
+		wantExpected Currency
+		wantGot      Currency
+	}{
+		{"Add", func() error { _, err := usd.Add(eur); return err }, USD, EUR},
+		{"Sub", func() error { _, err := eur.Sub(usd); return err }, EUR, USD},
+		{"Cmp", func() error { _, err := usd.Cmp(eur); return err }, USD, EUR},
+		{"Conv", func() error { _, err := rate.Conv(usd); return err }, EUR, USD},
+		{"Mid", func() error {
+			_, err := MidRate(rate, MustParseExchRate("EUR", "JPY", "160"))
+			return err
+		}, USD, JPY},
+	}
+	for _, tt := range tests {
+		err := tt.f()
+		if err == nil {
+			t.Errorf("%v did not fail", tt.name)
+			continue
+		}
+		var e *CurrencyMismatchError
+		if !errors.As(err, &e) {
+			t.Errorf("%v error %q is not a CurrencyMismatchError", tt.name, err)
+			continue
+		}
+		gotExpected, gotGot := e.Currencies()
+		if gotExpected != tt.wantExpected || gotGot != tt.wantGot {
+			t.Errorf("%v error Currencies() = %v, %v, want %v, %v", tt.name, gotExpected, gotGot, tt.wantExpected, tt.wantGot)
+		}
+		if !errors.Is(err, errCurrencyMismatch) {
+			t.Errorf("%v error %q does not wrap errCurrencyMismatch", tt.name, err)
+		}
+	}
+
+	t.Run("Error", func(t *testing.T) {
+		err := mismatchError(USD, EUR)
+		want := "currency mismatch: expected USD, got EUR"
+		if got := err.Error(); got != want {
+			t.Errorf("Error() = %q, want %q", got, want)
+		}
+	})
+}
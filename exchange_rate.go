@@ -2,8 +2,11 @@ package money
 
 import (
 	"fmt"
+	"log/slog"
 	"math"
+	"math/big"
 	"strconv"
+	"sync/atomic"
 
 	"github.com/govalues/decimal"
 )
@@ -20,6 +23,62 @@ type ExchangeRate struct {
 	value decimal.Decimal // how many units of the quote currency are needed to exchange for 1 unit of the base currency
 }
 
+// SameCurrRatePolicy specifies which rates between identical currencies are
+// accepted by the constructors and methods of [ExchangeRate].
+// By default, such rates must be equal to 1, but some ledgers model
+// same-currency conversions, such as internal transfers with a fee, using
+// factors close to 1.
+// The zero value corresponds to the default policy.
+// See also function [SetSameCurrRatePolicy].
+type SameCurrRatePolicy struct {
+	// Tolerance is the largest allowed difference between the rate and 1,
+	// for example, 0.01 allows rates from 0.99 to 1.01.
+	Tolerance decimal.Decimal
+	// AllowAny disables the check, so any positive rate is allowed.
+	AllowAny bool
+}
+
+// allows returns true if the policy allows the rate.
+// A nil policy is the default policy.
+func (p *SameCurrRatePolicy) allows(d decimal.Decimal) bool {
+	if p == nil {
+		return d.IsOne()
+	}
+	if p.AllowAny {
+		return true
+	}
+	diff, err := d.Sub(decimal.One)
+	if err != nil {
+		return false
+	}
+	return diff.CmpAbs(p.Tolerance) <= 0
+}
+
+// sameCurrRatePolicy holds the registered policy.
+// A nil value means the default policy.
+var sameCurrRatePolicy atomic.Pointer[SameCurrRatePolicy]
+
+// SetSameCurrRatePolicy registers the policy for rates between identical
+// currencies, replacing any previously registered policy.
+// Setting the zero value restores the default policy.
+// The policy affects only rates constructed after the call.
+// SetSameCurrRatePolicy is safe for concurrent use by multiple goroutines,
+// but it is intended to be called during program initialization.
+// See also method [ExchangeRate.IsIdentity].
+//
+// SetSameCurrRatePolicy returns an error if the tolerance is negative.
+func SetSameCurrRatePolicy(p SameCurrRatePolicy) error {
+	if p.Tolerance.IsNeg() {
+		return fmt.Errorf("setting same-currency rate policy: tolerance %v must not be negative", p.Tolerance)
+	}
+	if p == (SameCurrRatePolicy{}) {
+		sameCurrRatePolicy.Store(nil)
+		return nil
+	}
+	sameCurrRatePolicy.Store(&p)
+	return nil
+}
+
 // newExchRateUnsafe creates a new rate without checking the sign and the scale.
 // Use it only if you are absolutely sure that the arguments are valid.
 func newExchRateUnsafe(b, q Currency, d decimal.Decimal) ExchangeRate {
@@ -34,7 +93,7 @@ func newExchRateSafe(b, q Currency, d decimal.Decimal) (ExchangeRate, error) {
 	if d.IsNeg() {
 		return ExchangeRate{}, fmt.Errorf("exchange rate must be positive")
 	}
-	if b == q && !d.IsOne() {
+	if b == q && !d.IsOne() && !sameCurrRatePolicy.Load().allows(d) {
 		return ExchangeRate{}, fmt.Errorf("exchange rate between identical currencies must be equal to 1")
 	}
 	if d.Scale() < q.Scale() {
@@ -221,6 +280,16 @@ func MustParseExchRate(base, quote, rate string) ExchangeRate {
 	return r
 }
 
+// IdentityRate returns the rate of 1 between currency c and itself,
+// zero-padded to the scale of the currency, for example, "USD/USD 1.00".
+// Converting an amount using this rate leaves the amount unchanged, which
+// allows handling conversions within the same currency the same way as
+// conversions between different currencies.
+// See also method [ExchangeRate.IsIdentity].
+func IdentityRate(c Currency) ExchangeRate {
+	return newExchRateUnsafe(c, c, decimal.One.Pad(c.Scale()))
+}
+
 // Base returns the currency being exchanged.
 func (r ExchangeRate) Base() Currency {
 	return r.base
@@ -279,6 +348,13 @@ func (r ExchangeRate) CanConv(b Amount) bool {
 
 // Conv returns a (possibly rounded) amount converted from the base currency to
 // the quote currency.
+// The scale of the result is the sum of the scales of the rate and the amount,
+// but not greater than [decimal.MaxScale], in which case the result is rounded
+// half to even.
+// Converting a zero amount returns zero with the same scale without performing
+// any multiplication.
+// Use [ExchangeRate.ConvRoundToCurr] to obtain results, including zeros, with
+// the scale of the quote currency.
 // See also method [ExchangeRate.CanConv].
 //
 // Conv returns an error if:
@@ -297,9 +373,13 @@ func (r ExchangeRate) Conv(b Amount) (Amount, error) {
 
 func (r ExchangeRate) conv(b Amount) (Amount, error) {
 	if !r.CanConv(b) {
-		return Amount{}, errCurrencyMismatch
+		return Amount{}, mismatchError(r.Base(), b.Curr())
 	}
 	q, d, e := r.Quote(), r.Decimal(), b.Decimal()
+	if e.IsZero() {
+		scale := min(r.Scale()+b.Scale(), decimal.MaxScale)
+		return newAmountUnsafe(q, decimal.Zero.Pad(scale)), nil
+	}
 	d, err := d.MulExact(e, q.Scale())
 	if err != nil {
 		return Amount{}, err
@@ -307,6 +387,158 @@ func (r ExchangeRate) conv(b Amount) (Amount, error) {
 	return newAmountSafe(q, d)
 }
 
+// ConvRange returns the bounds of the amount converted from the base currency
+// to the quote currency, assuming that the actual rate may differ from
+// the exchange rate by up to the tolerance expressed in basis points
+// (1 basis point = 0.01%).
+// The lower bound is rounded down and the upper bound is rounded up to the scale
+// of the quote currency, so the bounds are suitable for quoting ranges such as
+// "you will receive approximately X–Y".
+// See also method [ExchangeRate.Conv].
+//
+// ConvRange returns an error if:
+//   - the base currency of the exchange rate does not match the currency of the given amount;
+//   - the tolerance is negative or greater than 10000 basis points;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func (r ExchangeRate) ConvRange(b Amount, tolBps int) (low, high Amount, err error) {
+	low, high, err = r.convRange(b, tolBps)
+	if err != nil {
+		return Amount{}, Amount{}, fmt.Errorf("converting [%v] to [%v] with tolerance %v bps: %w", b, r.Quote(), tolBps, err)
+	}
+	return low, high, nil
+}
+
+func (r ExchangeRate) convRange(b Amount, tolBps int) (low, high Amount, err error) {
+	if tolBps < 0 || tolBps > 10000 {
+		return Amount{}, Amount{}, fmt.Errorf("tolerance must be between 0 and 10000 basis points")
+	}
+	c, err := r.conv(b)
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+
+	// Factors
+	tol, err := decimal.New(int64(tolBps), 4)
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+	lf, err := decimal.One.Sub(tol)
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+	hf, err := decimal.One.Add(tol)
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+
+	// Bounds
+	low, err = c.mul(lf)
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+	high, err = c.mul(hf)
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+	if c.IsNeg() {
+		low, high = high, low
+	}
+	q := r.Quote()
+	return low.Floor(q.Scale()), high.Ceil(q.Scale()), nil
+}
+
+// Side specifies whether a customer buys or sells the base currency of
+// an exchange rate.
+// See also method [ExchangeRate.WithMarginBps].
+type Side int8
+
+const (
+	// Buy means that the customer buys the base currency and pays with
+	// the quote currency.
+	Buy Side = iota
+	// Sell means that the customer sells the base currency and receives
+	// the quote currency.
+	Sell
+)
+
+// String implements the [fmt.Stringer] interface and returns
+// a string representation of the side.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (s Side) String() string {
+	switch s {
+	case Buy:
+		return "buy"
+	case Sell:
+		return "sell"
+	default:
+		return fmt.Sprintf("Side(%d)", int8(s))
+	}
+}
+
+// WithMarginBps returns the customer rate obtained by applying a margin
+// expressed in basis points (1 basis point = 0.01%) to the rate:
+//
+//	r * (1 + margin) if the customer buys the base currency
+//	r * (1 - margin) if the customer sells the base currency
+//
+// The result is rounded to the scale of rate r in favor of the party applying
+// the margin, that is, up when the customer buys and down when the customer
+// sells, so the effective margin is never less than the requested one.
+// See also method [ExchangeRate.Mul].
+//
+// WithMarginBps returns an error if:
+//   - the margin is negative or greater than 10000 basis points;
+//   - the side is not valid;
+//   - the result is 0;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func (r ExchangeRate) WithMarginBps(n int, side Side) (ExchangeRate, error) {
+	q, err := r.withMarginBps(n, side)
+	if err != nil {
+		return ExchangeRate{}, fmt.Errorf("applying %v bps %v margin to %v: %w", n, side, r, err)
+	}
+	return q, nil
+}
+
+func (r ExchangeRate) withMarginBps(n int, side Side) (ExchangeRate, error) {
+	if n < 0 || n > 10000 {
+		return ExchangeRate{}, fmt.Errorf("margin must be between 0 and 10000 basis points")
+	}
+	m, err := decimal.New(int64(n), 4)
+	if err != nil {
+		return ExchangeRate{}, err
+	}
+	b, q, d := r.Base(), r.Quote(), r.Decimal()
+	scale := d.Scale()
+	switch side {
+	case Buy:
+		f, err := decimal.One.Add(m)
+		if err != nil {
+			return ExchangeRate{}, err
+		}
+		d, err = d.Mul(f)
+		if err != nil {
+			return ExchangeRate{}, err
+		}
+		d = d.Ceil(scale)
+	case Sell:
+		f, err := decimal.One.Sub(m)
+		if err != nil {
+			return ExchangeRate{}, err
+		}
+		d, err = d.Mul(f)
+		if err != nil {
+			return ExchangeRate{}, err
+		}
+		d = d.Floor(scale)
+	default:
+		return ExchangeRate{}, fmt.Errorf("invalid side %v", side)
+	}
+	return newExchRateSafe(b, q, d.Pad(scale))
+}
+
 // Mul returns an exchange rate with the same base and quote currencies,
 // but with the rate multiplied by a factor.
 //
@@ -333,6 +565,185 @@ func (r ExchangeRate) mul(e decimal.Decimal) (ExchangeRate, error) {
 	return newExchRateSafe(b, q, d)
 }
 
+// FMA returns the (possibly rounded) [fused multiply-addition] of rates r, q, and factor e.
+// It computes r * e + q without any intermediate rounding.
+// This method is useful for computing blended rates, such as weighted averages
+// of execution rates across partial fills.
+// See also function [BlendExchRates].
+//
+// FMA returns an error if:
+//   - rates are denominated in different base or quote currencies;
+//   - the result is 0 or negative;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+//     For example, when the quote currency is US Dollars, FMA will return an error
+//     if the integer part of the result has more than 17 digits (19 - 2 = 17).
+//
+// [fused multiply-addition]: https://en.wikipedia.org/wiki/Multiply%E2%80%93accumulate_operation#Fused_multiply%E2%80%93add
+func (r ExchangeRate) FMA(e decimal.Decimal, q ExchangeRate) (ExchangeRate, error) {
+	p, err := r.fma(e, q)
+	if err != nil {
+		return ExchangeRate{}, fmt.Errorf("computing [%v * %v + %v]: %w", r, e, q, err)
+	}
+	return p, nil
+}
+
+func (r ExchangeRate) fma(e decimal.Decimal, q ExchangeRate) (ExchangeRate, error) {
+	if !r.SameCurr(q) {
+		return ExchangeRate{}, rateMismatchError(r, q)
+	}
+	b, c, d, f := r.Base(), r.Quote(), r.Decimal(), q.Decimal()
+	d, err := d.FMAExact(e, f, c.Scale())
+	if err != nil {
+		return ExchangeRate{}, err
+	}
+	return newExchRateSafe(b, c, d)
+}
+
+// ApplyForwardPoints returns the forward rate obtained by adding the quoted
+// forward points to the spot rate r.
+// The scale is the number of digits after the decimal point of one point,
+// for example, 4 for EUR/USD, where 1 point is 0.0001, or 2 for USD/JPY,
+// where 1 point is 0.01.
+// The points are positive when the base currency trades at a forward premium
+// and negative when it trades at a forward discount.
+// For example, EUR/USD 1.0850 with 25.5 points at scale 4 gives 1.08755.
+// The result is computed without any rounding.
+// See also method [ExchangeRate.ConventionalScale].
+//
+// ApplyForwardPoints returns an error if:
+//   - the scale is negative or greater than [decimal.MaxScale];
+//   - the result is 0 or negative;
+//   - the result cannot be represented exactly, that is, it has more than
+//     [decimal.MaxPrec] digits or more than [decimal.MaxScale] digits after
+//     the decimal point.
+func (r ExchangeRate) ApplyForwardPoints(points decimal.Decimal, scale int) (ExchangeRate, error) {
+	q, err := r.applyForwardPoints(points, scale)
+	if err != nil {
+		return ExchangeRate{}, fmt.Errorf("applying %v forward points at scale %v to %v: %w", points, scale, r, err)
+	}
+	return q, nil
+}
+
+func (r ExchangeRate) applyForwardPoints(points decimal.Decimal, scale int) (ExchangeRate, error) {
+	pip, err := decimal.New(1, scale)
+	if err != nil {
+		return ExchangeRate{}, err
+	}
+	b, q, d := r.Base(), r.Quote(), r.Decimal()
+	e, err := points.FMAExact(pip, d, q.Scale())
+	if err != nil {
+		return ExchangeRate{}, err
+	}
+	want := new(big.Rat).Mul(decimalRat(points), decimalRat(pip))
+	want.Add(want, decimalRat(d))
+	if decimalRat(e).Cmp(want) != 0 {
+		return ExchangeRate{}, fmt.Errorf("result %v cannot be represented exactly", want.FloatString(decimal.MaxScale))
+	}
+	return newExchRateSafe(b, q, e)
+}
+
+// BlendExchRates returns the (possibly rounded) weighted average of the rates,
+// computed as sum(weights[i] * rates[i]) / sum(weights).
+// The products are accumulated without any intermediate rounding.
+// This function is useful for computing the average execution rate across
+// partial fills, where the weights are the filled amounts.
+// See also method [ExchangeRate.FMA].
+//
+// BlendExchRates returns an error if:
+//   - the number of rates and weights are different or zero;
+//   - rates are denominated in different base or quote currencies;
+//   - any of the weights is negative or the sum of the weights is 0;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func BlendExchRates(rates []ExchangeRate, weights []decimal.Decimal) (ExchangeRate, error) {
+	r, err := blendExchRates(rates, weights)
+	if err != nil {
+		return ExchangeRate{}, fmt.Errorf("blending %v with weights %v: %w", rates, weights, err)
+	}
+	return r, nil
+}
+
+func blendExchRates(rates []ExchangeRate, weights []decimal.Decimal) (ExchangeRate, error) {
+	if len(rates) == 0 {
+		return ExchangeRate{}, fmt.Errorf("no rates")
+	}
+	if len(rates) != len(weights) {
+		return ExchangeRate{}, fmt.Errorf("number of rates and weights must be equal")
+	}
+	b, q := rates[0].Base(), rates[0].Quote()
+	num, den := decimal.Zero, decimal.Zero
+	var err error
+	for i, r := range rates {
+		if !r.SameCurr(rates[0]) {
+			return ExchangeRate{}, rateMismatchError(rates[0], r)
+		}
+		w := weights[i]
+		if w.IsNeg() {
+			return ExchangeRate{}, fmt.Errorf("weight must not be negative")
+		}
+		num, err = r.Decimal().FMA(w, num)
+		if err != nil {
+			return ExchangeRate{}, err
+		}
+		den, err = den.Add(w)
+		if err != nil {
+			return ExchangeRate{}, err
+		}
+	}
+	if den.IsZero() {
+		return ExchangeRate{}, fmt.Errorf("sum of weights cannot be 0")
+	}
+	d, err := num.QuoExact(den, q.Scale())
+	if err != nil {
+		return ExchangeRate{}, err
+	}
+	return newExchRateSafe(b, q, d)
+}
+
+// MidRate returns the mid-market rate, which is the arithmetic mean of the bid
+// and ask rates.
+// The result is rounded to the larger of the scales of the bid and ask rates
+// using [rounding half to even] (banker's rounding), so that mid-rates are
+// computed consistently regardless of the order of the arguments.
+// See also function [BlendExchRates].
+//
+// MidRate returns an error if:
+//   - rates are denominated in different base or quote currencies;
+//   - the bid rate is greater than the ask rate;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func MidRate(bid, ask ExchangeRate) (ExchangeRate, error) {
+	r, err := midRate(bid, ask)
+	if err != nil {
+		return ExchangeRate{}, fmt.Errorf("computing mid-rate of [%v] and [%v]: %w", bid, ask, err)
+	}
+	return r, nil
+}
+
+func midRate(bid, ask ExchangeRate) (ExchangeRate, error) {
+	if !bid.SameCurr(ask) {
+		return ExchangeRate{}, rateMismatchError(bid, ask)
+	}
+	b, q, d, e := bid.Base(), bid.Quote(), bid.Decimal(), ask.Decimal()
+	if d.Cmp(e) > 0 {
+		return ExchangeRate{}, fmt.Errorf("bid rate must not be greater than ask rate")
+	}
+	d, err := d.AddExact(e, q.Scale())
+	if err != nil {
+		return ExchangeRate{}, err
+	}
+	d, err = d.QuoExact(decimal.Two, q.Scale())
+	if err != nil {
+		return ExchangeRate{}, err
+	}
+	scale := max(bid.Scale(), ask.Scale())
+	d = d.Round(scale).Pad(q.Scale())
+	return newExchRateSafe(b, q, d)
+}
+
 // Inv returns the inverse of the exchange rate.
 //
 // Inv returns an error if:
@@ -373,6 +784,99 @@ func (r ExchangeRate) SameScale(q ExchangeRate) bool {
 	return d.SameScale(e)
 }
 
+// WithinTolerance returns true if rates r and q differ by no more than
+// the relative tolerance tol, that is, if |r - q| <= tol * r.
+// The tolerance is expressed as a fraction of rate r, for example,
+// 0.0005 corresponds to 5 basis points.
+// This method is useful for sanity-checking rates received from different
+// providers before accepting them.
+// See also method [ExchangeRate.SameCurr].
+//
+// WithinTolerance returns an error if:
+//   - rates are denominated in different base or quote currencies;
+//   - the tolerance is negative.
+func (r ExchangeRate) WithinTolerance(q ExchangeRate, tol decimal.Decimal) (bool, error) {
+	ok, err := r.withinTolerance(q, tol)
+	if err != nil {
+		return false, fmt.Errorf("comparing [%v] and [%v] within %v: %w", r, q, tol, err)
+	}
+	return ok, nil
+}
+
+func (r ExchangeRate) withinTolerance(q ExchangeRate, tol decimal.Decimal) (bool, error) {
+	if !r.SameCurr(q) {
+		return false, rateMismatchError(r, q)
+	}
+	if tol.IsNeg() {
+		return false, fmt.Errorf("tolerance must not be negative")
+	}
+	d, e := r.Decimal(), q.Decimal()
+	diff, err := d.Sub(e)
+	if err != nil {
+		return false, err
+	}
+	lim, err := d.Mul(tol)
+	if err != nil {
+		return false, err
+	}
+	return diff.CmpAbs(lim) <= 0, nil
+}
+
+// ChangeSince returns the change of rate r relative to the reference rate
+// as a percentage rounded half to even to the specified number of digits
+// after the decimal point, for example, 1.25 for an appreciation of 1.25%.
+// The result is positive if the base currency has appreciated against the
+// quote currency and negative if it has depreciated.
+// This method is useful for dashboards showing daily rate movements.
+// See also method [ExchangeRate.WithinTolerance].
+//
+// ChangeSince returns an error if:
+//   - rates are denominated in different base or quote currencies;
+//   - the reference rate is zero;
+//   - the scale is negative or greater than [decimal.MaxScale];
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - scale) digits.
+func (r ExchangeRate) ChangeSince(ref ExchangeRate, scale int) (decimal.Decimal, error) {
+	d, err := r.changeSince(ref, scale)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing change of [%v] since [%v]: %w", r, ref, err)
+	}
+	return d, nil
+}
+
+func (r ExchangeRate) changeSince(ref ExchangeRate, scale int) (decimal.Decimal, error) {
+	if !r.SameCurr(ref) {
+		return decimal.Decimal{}, rateMismatchError(ref, r)
+	}
+	if ref.IsZero() {
+		return decimal.Decimal{}, fmt.Errorf("reference rate must not be zero")
+	}
+	if scale < 0 || scale > decimal.MaxScale {
+		return decimal.Decimal{}, fmt.Errorf("scale must be between 0 and %v", decimal.MaxScale)
+	}
+	// Exact ratio 100 * (r - ref) / ref, scaled by 10^scale
+	s := max(r.Scale(), ref.Scale())
+	num := new(big.Int).SetUint64(r.Decimal().Coef())
+	num.Mul(num, pow10Big(s-r.Scale()))
+	den := new(big.Int).SetUint64(ref.Decimal().Coef())
+	den.Mul(den, pow10Big(s-ref.Scale()))
+	num.Sub(num, den)
+	neg := num.Sign() < 0
+	num.Abs(num)
+	num.Mul(num, pow10Big(scale+2))
+
+	// Rounding half to even
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	switch c := rem.Lsh(rem, 1).Cmp(den); {
+	case c > 0, c == 0 && quo.Bit(0) != 0:
+		quo.Add(quo, big.NewInt(1))
+	}
+	if neg {
+		quo.Neg(quo)
+	}
+	return bigDecimalExact(quo, scale)
+}
+
 // Scale returns the number of digits after the decimal point.
 // See also method [ExchangeRate.MinScale].
 func (r ExchangeRate) Scale() int {
@@ -418,6 +922,13 @@ func (r ExchangeRate) IsOne() bool {
 	return r.Decimal().IsOne()
 }
 
+// IsIdentity returns true if the base and quote currencies of the rate are
+// the same and the rate is equal to 1.
+// See also function [IdentityRate].
+func (r ExchangeRate) IsIdentity() bool {
+	return r.Base() == r.Quote() && r.IsOne()
+}
+
 // WithinOne returns:
 //
 //	true  if 0 <= r < 1
@@ -529,6 +1040,118 @@ func (r ExchangeRate) rescale(scale int) (ExchangeRate, error) {
 	return newExchRateSafe(b, q, d)
 }
 
+// CeilToCurr returns a rate rounded up to the scale of its quote currency
+// using [rounding toward positive infinity].
+// See also methods [ExchangeRate.Ceil], [ExchangeRate.SameScaleAsCurr].
+//
+// CeilToCurr returns an error if the result is 0.
+//
+// [rounding toward positive infinity]: https://en.wikipedia.org/wiki/Rounding#Rounding_up
+func (r ExchangeRate) CeilToCurr() (ExchangeRate, error) {
+	return r.Ceil(r.Quote().Scale())
+}
+
+// FloorToCurr returns a rate rounded down to the scale of its quote currency
+// using [rounding toward negative infinity].
+// See also methods [ExchangeRate.Floor], [ExchangeRate.SameScaleAsCurr].
+//
+// FloorToCurr returns an error if the result is 0.
+//
+// [rounding toward negative infinity]: https://en.wikipedia.org/wiki/Rounding#Rounding_down
+func (r ExchangeRate) FloorToCurr() (ExchangeRate, error) {
+	return r.Floor(r.Quote().Scale())
+}
+
+// TruncToCurr returns a rate truncated to the scale of its quote currency
+// using [rounding toward zero].
+// See also methods [ExchangeRate.Trunc], [ExchangeRate.SameScaleAsCurr].
+//
+// TruncToCurr returns an error if the result is 0.
+//
+// [rounding toward zero]: https://en.wikipedia.org/wiki/Rounding#Rounding_toward_zero
+func (r ExchangeRate) TruncToCurr() (ExchangeRate, error) {
+	return r.Trunc(r.Quote().Scale())
+}
+
+// RoundToCurr returns a rate rounded to the scale of its quote currency
+// using [rounding half to even] (banker's rounding).
+// See also methods [ExchangeRate.Round], [ExchangeRate.SameScaleAsCurr].
+//
+// RoundToCurr returns an error if the result is 0.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (r ExchangeRate) RoundToCurr() (ExchangeRate, error) {
+	return r.Round(r.Quote().Scale())
+}
+
+// TrimToCurr returns a rate with trailing zeros removed up to the scale of
+// its quote currency.
+// See also method [ExchangeRate.Trim].
+func (r ExchangeRate) TrimToCurr() ExchangeRate {
+	return r.Trim(r.Quote().Scale())
+}
+
+// SameScaleAsCurr returns true if the scale of the rate is equal to the scale
+// of its quote currency.
+// See also methods [ExchangeRate.Scale], [Currency.Scale], [ExchangeRate.RoundToCurr].
+func (r ExchangeRate) SameScaleAsCurr() bool {
+	return r.Scale() == r.Quote().Scale()
+}
+
+// conventionalScales maps quote currencies to the number of digits after
+// the decimal point used by the market for quoting rates in these currencies.
+// Quote currencies not listed here use defaultConventionalScale.
+var conventionalScales = map[Currency]int{
+	JPY: 3,
+	HUF: 3,
+	ISK: 3,
+	THB: 3,
+	PHP: 3,
+	KRW: 2,
+	CLP: 2,
+	COP: 2,
+	VND: 0,
+}
+
+// defaultConventionalScale is the number of digits after the decimal point
+// used by the market for quoting most rates, which is a tenth of a pip.
+const defaultConventionalScale = 5
+
+// ConventionalScale returns the number of digits after the decimal point
+// used by the market for quoting the rate, which depends on the quote
+// currency.
+// Most pairs are quoted with 5 digits (a tenth of a pip), JPY, HUF, ISK,
+// THB, and PHP pairs with 3 digits, KRW, CLP, and COP pairs with 2 digits,
+// and VND pairs with no digits after the decimal point.
+// The result is never less than the scale of the quote currency.
+// See also method [ExchangeRate.RoundConventional].
+func (r ExchangeRate) ConventionalScale() int {
+	q := r.Quote()
+	scale, ok := conventionalScales[q]
+	if !ok {
+		scale = defaultConventionalScale
+	}
+	return max(scale, q.Scale())
+}
+
+// RoundConventional returns a rate rounded or zero-padded to the number of
+// digits after the decimal point used by the market for quoting the rate.
+// Rounding uses [rounding half to even] (banker's rounding).
+// This method is useful for publishing rates without hard-coding pip
+// precision for each currency pair.
+// See also methods [ExchangeRate.ConventionalScale] and [ExchangeRate.Rescale].
+//
+// RoundConventional returns an error if the result is 0.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (r ExchangeRate) RoundConventional() (ExchangeRate, error) {
+	q, err := r.rescale(r.ConventionalScale())
+	if err != nil {
+		return ExchangeRate{}, fmt.Errorf("rounding %v to conventional scale: %w", r, err)
+	}
+	return q, nil
+}
+
 // String method implements the [fmt.Stringer] interface and returns a string
 // representation of the exchange rate.
 // See also methods [Currency.String] and [Decimal.String].
@@ -536,6 +1159,13 @@ func (r ExchangeRate) rescale(scale int) (ExchangeRate, error) {
 // [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
 // [Decimal.String]: https://pkg.go.dev/github.com/govalues/decimal#Decimal.String
 func (r ExchangeRate) String() string {
+	var buf [32]byte
+	return string(r.appendText(buf[:0]))
+}
+
+// appendText appends the text produced by [ExchangeRate.String] to dst and
+// returns the extended buffer.
+func (r ExchangeRate) appendText(dst []byte) []byte {
 	var buf [32]byte
 	pos := len(buf) - 1
 	coef := r.Decimal().Coef()
@@ -586,7 +1216,21 @@ func (r ExchangeRate) String() string {
 		pos--
 	}
 
-	return string(buf[pos+1:])
+	return append(dst, buf[pos+1:]...)
+}
+
+// LogValue implements the [slog.LogValuer] interface.
+// It returns a group with the base and quote currency codes, the decimal value
+// as a string to avoid any loss of precision, and the scale of the rate.
+//
+// [slog.LogValuer]: https://pkg.go.dev/log/slog#LogValuer
+func (r ExchangeRate) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("base", r.Base().Code()),
+		slog.String("quote", r.Quote().Code()),
+		slog.String("value", r.Decimal().String()),
+		slog.Int("scale", r.Scale()),
+	)
 }
 
 // Format implements the [fmt.Formatter] interface.
@@ -599,9 +1243,12 @@ func (r ExchangeRate) String() string {
 //	| %f     | 1.2500           | Rate                          |
 //	| %b     | EUR              | Base currency                 |
 //	| %c     | USD              | Quote currency                |
+//	| %P     | EUR/USD          | Currency pair                 |
 //
+// The currency pair uses the %P verb, because the %p verb is reserved
+// by the fmt package for pointers.
 // The '-' format flag can be used with all verbs.
-// The '0' format flags can be used with all verbs except %c.
+// The '0' format flags can be used with all verbs except %b, %c, and %P.
 //
 // Precision is only supported for the %f verb.
 // The default precision is equal to the actual scale of the exchange rate.
@@ -635,7 +1282,7 @@ func (r ExchangeRate) Format(state fmt.State, verb rune) {
 	// Integer and fractional digits
 	var intdigs, fracdigs int
 	switch rprec := d.Prec(); verb {
-	case 'b', 'B', 'c', 'C':
+	case 'b', 'B', 'c', 'C', 'P':
 		// skip
 	default:
 		fracdigs = d.Scale()
@@ -665,6 +1312,12 @@ func (r ExchangeRate) Format(state fmt.State, verb rune) {
 	case 'c', 'C':
 		quocode = q.Code()
 		quosyms = len(quocode)
+	case 'P':
+		basecode = b.Code()
+		quocode = q.Code()
+		basesyms = len(basecode)
+		quosyms = len(quocode)
+		pairdel = 1
 	default:
 		basecode = b.Code()
 		quocode = q.Code()
@@ -687,7 +1340,7 @@ func (r ExchangeRate) Format(state fmt.State, verb rune) {
 		switch {
 		case state.Flag('-'):
 			tspaces = w - width
-		case state.Flag('0') && verb != 'c' && verb != 'C' && verb != 'b' && verb != 'B':
+		case state.Flag('0') && verb != 'c' && verb != 'C' && verb != 'b' && verb != 'B' && verb != 'P':
 			lzeros = w - width
 		default:
 			lspaces = w - width
@@ -782,7 +1435,7 @@ func (r ExchangeRate) Format(state fmt.State, verb rune) {
 	// Writing result
 	//nolint:errcheck
 	switch verb {
-	case 'q', 'Q', 's', 'S', 'v', 'V', 'f', 'F', 'b', 'B', 'c', 'C':
+	case 'q', 'Q', 's', 'S', 'v', 'V', 'f', 'F', 'b', 'B', 'c', 'C', 'P':
 		state.Write(buf)
 	default:
 		state.Write([]byte("%!"))
@@ -2,6 +2,7 @@ package money
 
 import (
 	"fmt"
+	"log/slog"
 	"math"
 	"testing"
 	"unsafe"
@@ -43,6 +44,10 @@ func TestExchangeRate_Interfaces(t *testing.T) {
 	if !ok {
 		t.Errorf("%T does not implement fmt.Formatter", i)
 	}
+	_, ok = i.(slog.LogValuer)
+	if !ok {
+		t.Errorf("%T does not implement slog.LogValuer", i)
+	}
 }
 
 func TestMustNewExchRate(t *testing.T) {
@@ -67,6 +72,58 @@ func TestMustParseExchRate(t *testing.T) {
 	})
 }
 
+func TestIdentityRate(t *testing.T) {
+	tests := []struct {
+		curr Currency
+		want string
+	}{
+		{XXX, "XXX/XXX 1"},
+		{JPY, "JPY/JPY 1"},
+		{USD, "USD/USD 1.00"},
+		{OMR, "OMR/OMR 1.000"},
+	}
+	for _, tt := range tests {
+		got := IdentityRate(tt.curr)
+		if got.String() != tt.want {
+			t.Errorf("IdentityRate(%v) = %q, want %q", tt.curr, got, tt.want)
+		}
+		if !got.IsIdentity() {
+			t.Errorf("IdentityRate(%v).IsIdentity() = false, want true", tt.curr)
+		}
+		if tt.curr == XXX {
+			continue
+		}
+		a := MustParseAmount(tt.curr.Code(), "5.678")
+		b, err := got.Conv(a)
+		if err != nil {
+			t.Errorf("%q.Conv(%q) failed: %v", got, a, err)
+			continue
+		}
+		if b.Decimal().Cmp(a.Decimal()) != 0 {
+			t.Errorf("%q.Conv(%q) = %q, want %q", got, a, b, a)
+		}
+	}
+}
+
+func TestExchangeRate_IsIdentity(t *testing.T) {
+	tests := []struct {
+		r    ExchangeRate
+		want bool
+	}{
+		{ExchangeRate{}, false},
+		{MustParseExchRate("USD", "USD", "1"), true},
+		{MustParseExchRate("USD", "USD", "1.0000"), true},
+		{MustParseExchRate("EUR", "USD", "1"), false},
+		{MustParseExchRate("EUR", "USD", "1.2"), false},
+	}
+	for _, tt := range tests {
+		got := tt.r.IsIdentity()
+		if got != tt.want {
+			t.Errorf("%q.IsIdentity() = %v, want %v", tt.r, got, tt.want)
+		}
+	}
+}
+
 func TestNewExchRate(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -333,6 +390,134 @@ func TestParseExchRate(t *testing.T) {
 	})
 }
 
+func TestSetSameCurrRatePolicy(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		defer SetSameCurrRatePolicy(SameCurrRatePolicy{})
+		tests := []struct {
+			p      SameCurrRatePolicy
+			r      string
+			wantOK bool
+		}{
+			{SameCurrRatePolicy{}, "1", true},
+			{SameCurrRatePolicy{}, "0.9999", false},
+			{SameCurrRatePolicy{Tolerance: decimal.MustParse("0.01")}, "0.99", true},
+			{SameCurrRatePolicy{Tolerance: decimal.MustParse("0.01")}, "1.01", true},
+			{SameCurrRatePolicy{Tolerance: decimal.MustParse("0.01")}, "0.989", false},
+			{SameCurrRatePolicy{Tolerance: decimal.MustParse("0.01")}, "1.0101", false},
+			{SameCurrRatePolicy{AllowAny: true}, "0.5", true},
+			{SameCurrRatePolicy{AllowAny: true}, "1000", true},
+		}
+		for _, tt := range tests {
+			err := SetSameCurrRatePolicy(tt.p)
+			if err != nil {
+				t.Errorf("SetSameCurrRatePolicy(%v) failed: %v", tt.p, err)
+				continue
+			}
+			r, err := ParseExchRate("USD", "USD", tt.r)
+			if (err == nil) != tt.wantOK {
+				t.Errorf("ParseExchRate(\"USD\", \"USD\", %q) with policy %v: error = %v, want ok = %v", tt.r, tt.p, err, tt.wantOK)
+				continue
+			}
+			if err != nil {
+				continue
+			}
+			a := MustParseAmount("USD", "100")
+			got, err := r.Conv(a)
+			if err != nil {
+				t.Errorf("%q.Conv(%q) failed: %v", r, a, err)
+				continue
+			}
+			want, err := a.Mul(r.Decimal())
+			if err != nil {
+				t.Fatalf("%q.Mul(%v) failed: %v", a, r.Decimal(), err)
+			}
+			if got != want {
+				t.Errorf("%q.Conv(%q) = %q, want %q", r, a, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		defer SetSameCurrRatePolicy(SameCurrRatePolicy{})
+		p := SameCurrRatePolicy{Tolerance: decimal.MustParse("-0.01")}
+		err := SetSameCurrRatePolicy(p)
+		if err == nil {
+			t.Errorf("SetSameCurrRatePolicy(%v) did not fail", p)
+		}
+	})
+}
+
+func TestSide_String(t *testing.T) {
+	tests := []struct {
+		s    Side
+		want string
+	}{
+		{Buy, "buy"},
+		{Sell, "sell"},
+		{Side(-1), "Side(-1)"},
+	}
+	for _, tt := range tests {
+		got := tt.s.String()
+		if got != tt.want {
+			t.Errorf("Side(%d).String() = %q, want %q", int8(tt.s), got, tt.want)
+		}
+	}
+}
+
+func TestExchangeRate_WithMarginBps(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			b, q, r string
+			n       int
+			side    Side
+			want    string
+		}{
+			{"EUR", "USD", "1.0845", 0, Buy, "1.0845"},
+			{"EUR", "USD", "1.0845", 0, Sell, "1.0845"},
+			{"EUR", "USD", "1.0845", 150, Buy, "1.1008"},
+			{"EUR", "USD", "1.0845", 150, Sell, "1.0682"},
+			{"EUR", "USD", "1.0000", 1, Buy, "1.0001"},
+			{"EUR", "USD", "1.0000", 1, Sell, "0.9999"},
+			{"USD", "JPY", "149.876", 200, Buy, "152.874"},
+			{"USD", "JPY", "149.876", 200, Sell, "146.878"},
+			{"EUR", "USD", "1.0845", 10000, Buy, "2.1690"},
+		}
+		for _, tt := range tests {
+			r := MustParseExchRate(tt.b, tt.q, tt.r)
+			got, err := r.WithMarginBps(tt.n, tt.side)
+			if err != nil {
+				t.Errorf("%q.WithMarginBps(%v, %v) failed: %v", r, tt.n, tt.side, err)
+				continue
+			}
+			want := MustParseExchRate(tt.b, tt.q, tt.want)
+			if got != want {
+				t.Errorf("%q.WithMarginBps(%v, %v) = %q, want %q", r, tt.n, tt.side, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			b, q, r string
+			n       int
+			side    Side
+		}{
+			"margin 1":    {"EUR", "USD", "1.0845", -1, Buy},
+			"margin 2":    {"EUR", "USD", "1.0845", 10001, Buy},
+			"side 1":      {"EUR", "USD", "1.0845", 100, Side(2)},
+			"zero rate 1": {"EUR", "USD", "1.0845", 10000, Sell},
+			"zero rate 2": {"EUR", "USD", "0.01", 9999, Sell},
+		}
+		for name, tt := range tests {
+			r := MustParseExchRate(tt.b, tt.q, tt.r)
+			_, err := r.WithMarginBps(tt.n, tt.side)
+			if err == nil {
+				t.Errorf("%q.WithMarginBps(%v, %v) did not fail: %v", r, tt.n, tt.side, name)
+			}
+		}
+	})
+}
+
 func TestExchangeRate_Mul(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -385,6 +570,216 @@ func TestExchangeRate_Mul(t *testing.T) {
 	})
 }
 
+func TestExchangeRate_FMA(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			b, q, r, e, s, want string
+		}{
+			{"EUR", "USD", "1.1000", "0.5", "1.2000", "1.75000"},
+			{"EUR", "USD", "1.1000", "0", "1.2000", "1.2000"},
+			{"EUR", "USD", "1.1000", "-0.5", "1.2000", "0.65000"},
+			{"USD", "JPY", "149.53", "2", "0.01", "299.07"},
+		}
+		for _, tt := range tests {
+			r := MustParseExchRate(tt.b, tt.q, tt.r)
+			e := decimal.MustParse(tt.e)
+			s := MustParseExchRate(tt.b, tt.q, tt.s)
+			got, err := r.FMA(e, s)
+			if err != nil {
+				t.Errorf("%q.FMA(%v, %q) failed: %v", r, e, s, err)
+				continue
+			}
+			want := MustParseExchRate(tt.b, tt.q, tt.want)
+			if got != want {
+				t.Errorf("%q.FMA(%v, %q) = %q, want %q", r, e, s, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			b1, q1, r, e, b2, q2, s string
+		}{
+			"currency 1": {"EUR", "USD", "1.1000", "1", "USD", "EUR", "0.9000"},
+			"negative 1": {"EUR", "USD", "1.1000", "-1", "EUR", "USD", "1.1000"},
+			"negative 2": {"EUR", "USD", "1.1000", "-2", "EUR", "USD", "1.1000"},
+			"overflow 1": {"EUR", "USD", "99999999999999999", "10", "EUR", "USD", "1"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				r := MustParseExchRate(tt.b1, tt.q1, tt.r)
+				e := decimal.MustParse(tt.e)
+				s := MustParseExchRate(tt.b2, tt.q2, tt.s)
+				_, err := r.FMA(e, s)
+				if err == nil {
+					t.Errorf("%q.FMA(%v, %q) did not fail", r, e, s)
+				}
+			})
+		}
+	})
+}
+
+func TestExchangeRate_ApplyForwardPoints(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			b, q, r, points string
+			scale           int
+			want            string
+		}{
+			{"EUR", "USD", "1.0850", "25.5", 4, "1.08755"},
+			{"EUR", "USD", "1.0850", "25", 4, "1.0875"},
+			{"EUR", "USD", "1.0850", "-25", 4, "1.0825"},
+			{"EUR", "USD", "1.0850", "0", 4, "1.0850"},
+			{"USD", "JPY", "149.53", "-45.2", 2, "149.078"},
+			{"USD", "JPY", "149.53", "120", 2, "150.73"},
+		}
+		for _, tt := range tests {
+			r := MustParseExchRate(tt.b, tt.q, tt.r)
+			points := decimal.MustParse(tt.points)
+			got, err := r.ApplyForwardPoints(points, tt.scale)
+			if err != nil {
+				t.Errorf("%q.ApplyForwardPoints(%v, %v) failed: %v", r, points, tt.scale, err)
+				continue
+			}
+			want := MustParseExchRate(tt.b, tt.q, tt.want)
+			if got != want {
+				t.Errorf("%q.ApplyForwardPoints(%v, %v) = %q, want %q", r, points, tt.scale, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			b, q, r, points string
+			scale           int
+		}{
+			"scale 1":    {"EUR", "USD", "1.0850", "25", -1},
+			"scale 2":    {"EUR", "USD", "1.0850", "25", 20},
+			"negative 1": {"EUR", "USD", "1.0850", "-10850", 4},
+			"negative 2": {"EUR", "USD", "1.0850", "-20000", 4},
+			"overflow 1": {"EUR", "USD", "99999999999999999", "1", 0},
+			"inexact 1":  {"EUR", "USD", "12345678.0850", "0.5", 12},
+			"inexact 2":  {"EUR", "USD", "1.0850", "0.05", 19},
+		}
+		for name, tt := range tests {
+			r := MustParseExchRate(tt.b, tt.q, tt.r)
+			points := decimal.MustParse(tt.points)
+			_, err := r.ApplyForwardPoints(points, tt.scale)
+			if err == nil {
+				t.Errorf("%v: %q.ApplyForwardPoints(%v, %v) did not fail", name, r, points, tt.scale)
+			}
+		}
+	})
+}
+
+func TestBlendExchRates(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			b, q    string
+			rates   []string
+			weights []string
+			want    string
+		}{
+			{"EUR", "USD", []string{"1.1000"}, []string{"1"}, "1.1000"},
+			{"EUR", "USD", []string{"1.1000", "1.2000"}, []string{"100", "300"}, "1.1750"},
+			{"EUR", "USD", []string{"1.1000", "1.2000"}, []string{"0", "300"}, "1.2000"},
+			{"EUR", "USD", []string{"1.1000", "1.2000", "1.3000"}, []string{"1", "1", "1"}, "1.2000"},
+			{"EUR", "USD", []string{"1.1000", "1.2000", "1.3000"}, []string{"1", "1", "2"}, "1.2250"},
+			{"USD", "JPY", []string{"150", "151"}, []string{"1", "2"}, "150.6666666666666667"},
+		}
+		for _, tt := range tests {
+			rates := make([]ExchangeRate, len(tt.rates))
+			for i, r := range tt.rates {
+				rates[i] = MustParseExchRate(tt.b, tt.q, r)
+			}
+			weights := make([]decimal.Decimal, len(tt.weights))
+			for i, w := range tt.weights {
+				weights[i] = decimal.MustParse(w)
+			}
+			got, err := BlendExchRates(rates, weights)
+			if err != nil {
+				t.Errorf("BlendExchRates(%v, %v) failed: %v", rates, weights, err)
+				continue
+			}
+			want := MustParseExchRate(tt.b, tt.q, tt.want)
+			if got != want {
+				t.Errorf("BlendExchRates(%v, %v) = %q, want %q", rates, weights, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		eurusd := MustParseExchRate("EUR", "USD", "1.1000")
+		usdeur := MustParseExchRate("USD", "EUR", "0.9000")
+		one, zero, neg := decimal.One, decimal.Zero, decimal.NegOne
+		tests := map[string]struct {
+			rates   []ExchangeRate
+			weights []decimal.Decimal
+		}{
+			"empty 1":    {nil, nil},
+			"length 1":   {[]ExchangeRate{eurusd}, []decimal.Decimal{one, one}},
+			"currency 1": {[]ExchangeRate{eurusd, usdeur}, []decimal.Decimal{one, one}},
+			"weight 1":   {[]ExchangeRate{eurusd, eurusd}, []decimal.Decimal{one, neg}},
+			"weight 2":   {[]ExchangeRate{eurusd, eurusd}, []decimal.Decimal{zero, zero}},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := BlendExchRates(tt.rates, tt.weights)
+				if err == nil {
+					t.Errorf("BlendExchRates(%v, %v) did not fail", tt.rates, tt.weights)
+				}
+			})
+		}
+	})
+}
+
+func TestMidRate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			b, q, bid, ask, want string
+		}{
+			{"EUR", "USD", "1.0995", "1.0997", "1.0996"},
+			{"EUR", "USD", "1.0995", "1.0996", "1.0996"},
+			{"EUR", "USD", "1.0994", "1.0995", "1.0994"},
+			{"EUR", "USD", "1.1", "1.1002", "1.1001"},
+			{"EUR", "USD", "1.2", "1.2", "1.20"},
+			{"USD", "JPY", "149", "150", "150"},
+			{"USD", "JPY", "150", "151", "150"},
+		}
+		for _, tt := range tests {
+			bid := MustParseExchRate(tt.b, tt.q, tt.bid)
+			ask := MustParseExchRate(tt.b, tt.q, tt.ask)
+			got, err := MidRate(bid, ask)
+			if err != nil {
+				t.Errorf("MidRate(%q, %q) failed: %v", bid, ask, err)
+				continue
+			}
+			want := MustParseExchRate(tt.b, tt.q, tt.want)
+			if got != want {
+				t.Errorf("MidRate(%q, %q) = %q, want %q", bid, ask, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			bid, ask ExchangeRate
+		}{
+			"currency 1": {MustParseExchRate("EUR", "USD", "1.0995"), MustParseExchRate("USD", "EUR", "1.0997")},
+			"currency 2": {MustParseExchRate("EUR", "USD", "1.0995"), MustParseExchRate("EUR", "GBP", "1.0997")},
+			"crossed 1":  {MustParseExchRate("EUR", "USD", "1.0997"), MustParseExchRate("EUR", "USD", "1.0995")},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := MidRate(tt.bid, tt.ask)
+				if err == nil {
+					t.Errorf("MidRate(%q, %q) did not fail", tt.bid, tt.ask)
+				}
+			})
+		}
+	})
+}
+
 func TestExchangeRate_Inv(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -423,6 +818,11 @@ func TestExchangeRate_Conv(t *testing.T) {
 			{"JPY", "USD", "0.0075", "100", "0.7500"},
 			{"EUR", "USD", "1.0995", "100.00", "109.950000"},
 			{"OMR", "USD", "2.59765", "100.000", "259.76500000"},
+			// Zero amounts
+			{"EUR", "USD", "1.0995", "0", "0.000000"},
+			{"EUR", "USD", "1.0995", "-0.000", "0.0000000"},
+			{"OMR", "USD", "2.59765", "0", "0.00000000"},
+			{"EUR", "USD", "0.9999999999999999999", "0", "0.0000000000000000000"},
 		}
 		for _, tt := range tests {
 			r := MustParseExchRate(tt.b, tt.q, tt.r)
@@ -460,6 +860,75 @@ func TestExchangeRate_Conv(t *testing.T) {
 	})
 }
 
+func TestExchangeRate_ConvRange(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			b, q, r, a string
+			tol        int
+			low, high  string
+		}{
+			{"EUR", "USD", "1.0995", "100.00", 0, "109.95", "109.95"},
+			{"EUR", "USD", "1.0995", "100.00", 50, "109.40", "110.50"},
+			{"EUR", "USD", "1.0995", "-100.00", 50, "-110.50", "-109.40"},
+			{"EUR", "USD", "1.0995", "100.00", 10000, "0.00", "219.90"},
+			{"USD", "JPY", "150.25", "10.00", 100, "1487", "1518"},
+		}
+		for _, tt := range tests {
+			r := MustParseExchRate(tt.b, tt.q, tt.r)
+			a := MustParseAmount(tt.b, tt.a)
+			gotLow, gotHigh, err := r.ConvRange(a, tt.tol)
+			if err != nil {
+				t.Errorf("%q.ConvRange(%q, %v) failed: %v", r, a, tt.tol, err)
+				continue
+			}
+			wantLow := MustParseAmount(tt.q, tt.low)
+			wantHigh := MustParseAmount(tt.q, tt.high)
+			if gotLow != wantLow || gotHigh != wantHigh {
+				t.Errorf("%q.ConvRange(%q, %v) = [%q %q], want [%q %q]", r, a, tt.tol, gotLow, gotHigh, wantLow, wantHigh)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			b, q, r, c, a string
+			tol           int
+		}{
+			"tolerance 1": {"EUR", "USD", "1.0995", "EUR", "100", -1},
+			"tolerance 2": {"EUR", "USD", "1.0995", "EUR", "100", 10001},
+			"currency 1":  {"EUR", "USD", "1.0995", "JPY", "100", 50},
+			"overflow 1":  {"USD", "JPY", "1000.00", "USD", "10000000000000000.00", 50},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				r := MustParseExchRate(tt.b, tt.q, tt.r)
+				a := MustParseAmount(tt.c, tt.a)
+				_, _, err := r.ConvRange(a, tt.tol)
+				if err == nil {
+					t.Errorf("%q.ConvRange(%q, %v) did not fail", r, a, tt.tol)
+				}
+			})
+		}
+	})
+}
+
+func TestExchangeRate_LogValue(t *testing.T) {
+	tests := []struct {
+		b, q, r, want string
+	}{
+		{"EUR", "USD", "1.0995", "[base=EUR quote=USD value=1.0995 scale=4]"},
+		{"USD", "JPY", "150", "[base=USD quote=JPY value=150 scale=0]"},
+		{"EUR", "USD", "1", "[base=EUR quote=USD value=1.00 scale=2]"},
+	}
+	for _, tt := range tests {
+		r := MustParseExchRate(tt.b, tt.q, tt.r)
+		got := r.LogValue().String()
+		if got != tt.want {
+			t.Errorf("%q.LogValue() = %q, want %q", r, got, tt.want)
+		}
+	}
+}
+
 func TestExchangeRate_Format(t *testing.T) {
 	tests := []struct {
 		b, q, r, format, want string
@@ -556,6 +1025,14 @@ func TestExchangeRate_Format(t *testing.T) {
 		{"USD", "EUR", "100.00", "%#9c", "      EUR"}, // '#' is ignored
 		{"USD", "EUR", "100.00", "%-9c", "EUR      "},
 		{"USD", "EUR", "100.00", "%-#9c", "EUR      "}, // '#' is ignored
+		// %P verb
+		{"USD", "EUR", "100.00", "%P", "USD/EUR"},
+		{"USD", "EUR", "100.00", "%+P", "USD/EUR"},  // '+' is ignored
+		{"USD", "EUR", "100.00", "% P", "USD/EUR"},  // ' ' is ignored
+		{"USD", "EUR", "100.00", "%.2P", "USD/EUR"}, // precision is ignored
+		{"USD", "EUR", "100.00", "%9P", "  USD/EUR"},
+		{"USD", "EUR", "100.00", "%09P", "  USD/EUR"}, // '0' is ignored
+		{"USD", "EUR", "100.00", "%-9P", "USD/EUR  "},
 		// wrong verbs
 		{"USD", "EUR", "12.3400", "%d", "%!d(money.ExchangeRate=USD/EUR 12.3400)"},
 		{"USD", "EUR", "12.3400", "%e", "%!e(money.ExchangeRate=USD/EUR 12.3400)"},
@@ -839,3 +1316,247 @@ func TestExchangeRate_Quantize(t *testing.T) {
 		}
 	})
 }
+
+func TestExchangeRate_RoundConventional(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			base, quote, r string
+			wantScale      int
+			want           string
+		}{
+			{"EUR", "USD", "1.0845349", 5, "1.08453"},
+			{"EUR", "USD", "1.08455", 5, "1.08455"},
+			{"EUR", "USD", "1.1", 5, "1.10000"},
+			{"USD", "JPY", "149.87654", 3, "149.877"},
+			{"EUR", "HUF", "391.2345", 3, "391.234"},
+			{"USD", "KRW", "1335.678", 2, "1335.68"},
+			{"USD", "VND", "24350.5", 0, "24350"},
+			{"USD", "IDR", "15623.5678", 5, "15623.56780"},
+			{"USD", "KWD", "0.30712345", 5, "0.30712"},
+		}
+		for _, tt := range tests {
+			r := MustParseExchRate(tt.base, tt.quote, tt.r)
+			gotScale := r.ConventionalScale()
+			if gotScale != tt.wantScale {
+				t.Errorf("%q.ConventionalScale() = %v, want %v", r, gotScale, tt.wantScale)
+			}
+			got, err := r.RoundConventional()
+			if err != nil {
+				t.Errorf("%q.RoundConventional() failed: %v", r, err)
+				continue
+			}
+			want := MustParseExchRate(tt.base, tt.quote, tt.want)
+			if got != want {
+				t.Errorf("%q.RoundConventional() = %q, want %q", r, got, want)
+			}
+		}
+	})
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			base, quote, r string
+		}{
+			"zero rate 1": {"USD", "EUR", "0.000001"},
+			"zero rate 2": {"USD", "JPY", "0.0004"},
+		}
+		for _, tt := range tests {
+			r := MustParseExchRate(tt.base, tt.quote, tt.r)
+			_, err := r.RoundConventional()
+			if err == nil {
+				t.Errorf("%q.RoundConventional() did not fail", r)
+			}
+		}
+	})
+}
+
+func TestExchangeRate_ToCurr(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			base, quote, r                 string
+			wantCeil, wantFloor, wantTrunc string
+			wantRound, wantTrim            string
+			wantSameScale                  bool
+		}{
+			{"EUR", "USD", "1.08", "1.08", "1.08", "1.08", "1.08", "1.08", true},
+			{"EUR", "USD", "1.0800", "1.08", "1.08", "1.08", "1.08", "1.08", false},
+			{"EUR", "USD", "1.0850", "1.09", "1.08", "1.08", "1.08", "1.085", false},
+			{"EUR", "USD", "1.0851", "1.09", "1.08", "1.08", "1.09", "1.0851", false},
+			{"USD", "JPY", "149.5", "150", "149", "149", "150", "149.5", false},
+			{"USD", "OMR", "0.3845", "0.385", "0.384", "0.384", "0.384", "0.3845", false},
+		}
+		for _, tt := range tests {
+			r := MustParseExchRate(tt.base, tt.quote, tt.r)
+			methods := []struct {
+				name string
+				fn   func() (ExchangeRate, error)
+				want string
+			}{
+				{"CeilToCurr", r.CeilToCurr, tt.wantCeil},
+				{"FloorToCurr", r.FloorToCurr, tt.wantFloor},
+				{"TruncToCurr", r.TruncToCurr, tt.wantTrunc},
+				{"RoundToCurr", r.RoundToCurr, tt.wantRound},
+			}
+			for _, m := range methods {
+				got, err := m.fn()
+				if err != nil {
+					t.Errorf("%q.%v() failed: %v", r, m.name, err)
+					continue
+				}
+				want := MustParseExchRate(tt.base, tt.quote, m.want)
+				if got != want {
+					t.Errorf("%q.%v() = %q, want %q", r, m.name, got, want)
+				}
+				if !got.SameScaleAsCurr() {
+					t.Errorf("%q.%v().SameScaleAsCurr() = false, want true", r, m.name)
+				}
+			}
+			got := r.TrimToCurr()
+			want := MustParseExchRate(tt.base, tt.quote, tt.wantTrim)
+			if got != want {
+				t.Errorf("%q.TrimToCurr() = %q, want %q", r, got, want)
+			}
+			gotSameScale := r.SameScaleAsCurr()
+			if gotSameScale != tt.wantSameScale {
+				t.Errorf("%q.SameScaleAsCurr() = %v, want %v", r, gotSameScale, tt.wantSameScale)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			base, quote, r string
+		}{
+			"zero rate 1": {"USD", "EUR", "0.004"},
+			"zero rate 2": {"EUR", "JPY", "0.4"},
+		}
+		for _, tt := range tests {
+			r := MustParseExchRate(tt.base, tt.quote, tt.r)
+			_, err := r.FloorToCurr()
+			if err == nil {
+				t.Errorf("%q.FloorToCurr() did not fail", r)
+			}
+			_, err = r.TruncToCurr()
+			if err == nil {
+				t.Errorf("%q.TruncToCurr() did not fail", r)
+			}
+			_, err = r.RoundToCurr()
+			if err == nil {
+				t.Errorf("%q.RoundToCurr() did not fail", r)
+			}
+		}
+		var z ExchangeRate
+		_, err := z.CeilToCurr()
+		if err == nil {
+			t.Errorf("%q.CeilToCurr() did not fail", z)
+		}
+	})
+}
+
+func TestExchangeRate_WithinTolerance(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			b, q, r, s, tol string
+			want            bool
+		}{
+			{"EUR", "USD", "1.1000", "1.1000", "0", true},
+			{"EUR", "USD", "1.1000", "1.1001", "0", false},
+			{"EUR", "USD", "1.1000", "1.10055", "0.0005", true},
+			{"EUR", "USD", "1.1000", "1.09945", "0.0005", true},
+			{"EUR", "USD", "1.1000", "1.10056", "0.0005", false},
+			{"EUR", "USD", "1.1000", "1.09944", "0.0005", false},
+			{"USD", "JPY", "149.53", "149.67", "0.001", true},
+			{"USD", "JPY", "149.53", "149.69", "0.001", false},
+		}
+		for _, tt := range tests {
+			r := MustParseExchRate(tt.b, tt.q, tt.r)
+			s := MustParseExchRate(tt.b, tt.q, tt.s)
+			tol := decimal.MustParse(tt.tol)
+			got, err := r.WithinTolerance(s, tol)
+			if err != nil {
+				t.Errorf("%q.WithinTolerance(%q, %v) failed: %v", r, s, tol, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%q.WithinTolerance(%q, %v) = %v, want %v", r, s, tol, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			b1, q1, r, b2, q2, s, tol string
+		}{
+			"currency 1":  {"EUR", "USD", "1.1000", "USD", "EUR", "1.1000", "0.0005"},
+			"currency 2":  {"EUR", "USD", "1.1000", "EUR", "JPY", "1.1000", "0.0005"},
+			"tolerance 1": {"EUR", "USD", "1.1000", "EUR", "USD", "1.1000", "-0.0005"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				r := MustParseExchRate(tt.b1, tt.q1, tt.r)
+				s := MustParseExchRate(tt.b2, tt.q2, tt.s)
+				tol := decimal.MustParse(tt.tol)
+				_, err := r.WithinTolerance(s, tol)
+				if err == nil {
+					t.Errorf("%q.WithinTolerance(%q, %v) did not fail", r, s, tol)
+				}
+			})
+		}
+	})
+}
+
+func TestExchangeRate_ChangeSince(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			b, q, r, ref string
+			scale        int
+			want         string
+		}{
+			{"EUR", "USD", "1.1000", "1.1000", 2, "0.00"},
+			{"EUR", "USD", "1.1110", "1.1000", 2, "1.00"},
+			{"EUR", "USD", "1.0890", "1.1000", 2, "-1.00"},
+			{"EUR", "USD", "1.1000", "1.2000", 4, "-8.3333"},
+			{"EUR", "USD", "1.1000", "1.2000", 0, "-8"},
+			{"EUR", "USD", "1.1000", "1.0000", 0, "10"},
+			{"EUR", "USD", "1.0025", "1.0000", 1, "0.2"},
+			{"EUR", "USD", "1.0035", "1.0000", 1, "0.4"},
+			{"USD", "JPY", "151.00", "150.00", 3, "0.667"},
+			{"USD", "JPY", "300", "150", 2, "100.00"},
+			{"EUR", "USD", "1.10005", "1.1000", 19, "0.0045454545454545455"},
+			{"EUR", "USD", "1.25", "1.00", 17, "25.00000000000000000"},
+		}
+		for _, tt := range tests {
+			r := MustParseExchRate(tt.b, tt.q, tt.r)
+			ref := MustParseExchRate(tt.b, tt.q, tt.ref)
+			got, err := r.ChangeSince(ref, tt.scale)
+			if err != nil {
+				t.Errorf("%q.ChangeSince(%q, %v) failed: %v", r, ref, tt.scale, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("%q.ChangeSince(%q, %v) = %q, want %q", r, ref, tt.scale, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			r, ref ExchangeRate
+			scale  int
+		}{
+			"currency 1": {MustParseExchRate("EUR", "USD", "1.1000"), MustParseExchRate("USD", "EUR", "1.1000"), 2},
+			"currency 2": {MustParseExchRate("EUR", "USD", "1.1000"), MustParseExchRate("EUR", "JPY", "1.1000"), 2},
+			"zero 1":     {ExchangeRate{}, ExchangeRate{}, 2},
+			"scale 1":    {MustParseExchRate("EUR", "USD", "1.1000"), MustParseExchRate("EUR", "USD", "1.1000"), -1},
+			"scale 2":    {MustParseExchRate("EUR", "USD", "1.1000"), MustParseExchRate("EUR", "USD", "1.1000"), 20},
+			"overflow 1": {MustParseExchRate("EUR", "USD", "1.25"), MustParseExchRate("EUR", "USD", "1.00"), 19},
+			"overflow 2": {MustParseExchRate("EUR", "USD", "1.25"), MustParseExchRate("EUR", "USD", "1.00"), 18},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := tt.r.ChangeSince(tt.ref, tt.scale)
+				if err == nil {
+					t.Errorf("%q.ChangeSince(%q, %v) did not fail", tt.r, tt.ref, tt.scale)
+				}
+			})
+		}
+	})
+}
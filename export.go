@@ -0,0 +1,58 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// AppendMinorUnitsCSV appends the amounts in minor units of their currency
+// (e.g. cents, pennies, fens) to dst, one integer per line, and returns
+// the extended buffer.
+// It is intended for generating large settlement and export files, where
+// all amounts of a batch are denominated in the same currency: amounts with
+// the scale of their currency are written directly from their coefficients,
+// without any rounding or intermediate allocations.
+// See also method [Amount.MinorUnitsExact].
+//
+// AppendMinorUnitsCSV returns an error and the original buffer if:
+//   - amounts are denominated in different currencies;
+//   - any amount has non-zero digits beyond the scale of its currency;
+//   - any amount in minor units cannot be represented as an int64.
+func AppendMinorUnitsCSV(dst []byte, amounts []Amount) ([]byte, error) {
+	buf, err := appendMinorUnitsCSV(dst, amounts)
+	if err != nil {
+		return dst, fmt.Errorf("exporting minor units: %w", err)
+	}
+	return buf, nil
+}
+
+func appendMinorUnitsCSV(dst []byte, amounts []Amount) ([]byte, error) {
+	if len(amounts) == 0 {
+		return dst, nil
+	}
+	c := amounts[0].Curr()
+	scale := c.Scale()
+	for _, a := range amounts {
+		if a.Curr() != c {
+			return nil, fmt.Errorf("[%v] and [%v]: %w", amounts[0], a, mismatchError(c, a.Curr()))
+		}
+		d := a.Decimal()
+		if d.Scale() == scale && d.Coef() <= math.MaxInt64 {
+			// Fast path
+			if d.IsNeg() {
+				dst = append(dst, '-')
+			}
+			dst = strconv.AppendUint(dst, d.Coef(), 10)
+		} else {
+			// Slow path
+			units, err := a.MinorUnitsExact()
+			if err != nil {
+				return nil, err
+			}
+			dst = strconv.AppendInt(dst, units, 10)
+		}
+		dst = append(dst, '\n')
+	}
+	return dst, nil
+}
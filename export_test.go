@@ -0,0 +1,67 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestAppendMinorUnitsCSV(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr    string
+			amounts []string
+			want    string
+		}{
+			{"USD", nil, ""},
+			{"USD", []string{"0"}, "0\n"},
+			{"USD", []string{"5.67", "-5.67", "0.01", "100"}, "567\n-567\n1\n10000\n"},
+			{"USD", []string{"5.670", "5.6700000"}, "567\n567\n"},
+			{"JPY", []string{"1", "-1000"}, "1\n-1000\n"},
+			{"OMR", []string{"1.5", "0.001"}, "1500\n1\n"},
+			{"USD", []string{"92233720368547758.07", "-92233720368547758.07"}, "9223372036854775807\n-9223372036854775807\n"},
+		}
+		for _, tt := range tests {
+			amounts := MustParseAmountSlice(tt.curr, tt.amounts)
+			got, err := AppendMinorUnitsCSV([]byte("units\n"), amounts)
+			if err != nil {
+				t.Errorf("AppendMinorUnitsCSV(%v) failed: %v", amounts, err)
+				continue
+			}
+			want := "units\n" + tt.want
+			if string(got) != want {
+				t.Errorf("AppendMinorUnitsCSV(%v) = %q, want %q", amounts, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string][]Amount{
+			"currency 1": {MustParseAmount("USD", "1"), MustParseAmount("EUR", "1")},
+			"rounding 1": {MustParseAmount("USD", "1"), MustParseAmount("USD", "0.001")},
+			"overflow 1": {MustParseAmount("USD", "92233720368547758.08")},
+		}
+		for name, amounts := range tests {
+			dst := []byte("units\n")
+			got, err := AppendMinorUnitsCSV(dst, amounts)
+			if err == nil {
+				t.Errorf("AppendMinorUnitsCSV(%v) did not fail: %v", amounts, name)
+				continue
+			}
+			if string(got) != "units\n" {
+				t.Errorf("AppendMinorUnitsCSV(%v) = %q, want original buffer", amounts, got)
+			}
+		}
+	})
+}
+
+func BenchmarkAppendMinorUnitsCSV(b *testing.B) {
+	amounts := MustParseAmountSlice("USD", []string{"0.01", "5.67", "123.45", "-987654.32"})
+	buf := make([]byte, 0, 1024)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		buf, err = AppendMinorUnitsCSV(buf[:0], amounts)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/govalues/decimal"
+)
+
+// SignConvention specifies how the sign of an amount is represented in
+// a fixed-width numeric field of legacy file formats, such as mainframe
+// and banking batch files.
+// See also method [Amount.EncodeFixedWidth] and function [ParseFixedWidth].
+type SignConvention int8
+
+const (
+	// SignLeading places '+' or '-' before the digits, for example, "-0012345".
+	SignLeading SignConvention = iota
+	// SignTrailing places '+' or '-' after the digits, for example, "0012345-".
+	SignTrailing
+	// SignZoned encodes the sign in the last digit using the zoned decimal
+	// (overpunch) convention, where the digits 0-9 become '{' and 'A'-'I'
+	// for positive amounts, and '}' and 'J'-'R' for negative ones,
+	// for example, "001234N".
+	SignZoned
+	// SignNone encodes only the digits, so negative amounts are not allowed,
+	// for example, "0012345".
+	SignNone
+)
+
+// String implements the [fmt.Stringer] interface and returns
+// a string representation of the sign convention.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (s SignConvention) String() string {
+	switch s {
+	case SignLeading:
+		return "leading"
+	case SignTrailing:
+		return "trailing"
+	case SignZoned:
+		return "zoned"
+	case SignNone:
+		return "none"
+	default:
+		return fmt.Sprintf("SignConvention(%d)", int8(s))
+	}
+}
+
+const (
+	zonedPos = "{ABCDEFGHI" // overpunched digits 0-9 of positive amounts
+	zonedNeg = "}JKLMNOPQR" // overpunched digits 0-9 of negative amounts
+)
+
+// EncodeFixedWidth returns the amount as a zero-padded numeric field of
+// the given width with the given number of implied decimal places and
+// without a decimal point, as used by legacy file formats.
+// For example, USD -123.45 with width 8, 2 implied decimals, and
+// [SignTrailing] is encoded as "0012345-".
+// The width includes the sign character for [SignLeading] and [SignTrailing].
+// The currency is not encoded.
+// See also function [ParseFixedWidth].
+//
+// EncodeFixedWidth returns an error if:
+//   - the number of implied decimals is negative or greater than [decimal.MaxScale];
+//   - the amount has non-zero digits beyond the implied decimals;
+//   - the amount is negative and the sign convention is [SignNone];
+//   - the sign convention is not valid;
+//   - the digits do not fit into the width.
+func (a Amount) EncodeFixedWidth(width, decimals int, sign SignConvention) (string, error) {
+	s, err := a.encodeFixedWidth(width, decimals, sign)
+	if err != nil {
+		return "", fmt.Errorf("encoding %v as fixed-width field: %w", a, err)
+	}
+	return s, nil
+}
+
+func (a Amount) encodeFixedWidth(width, decimals int, sign SignConvention) (string, error) {
+	if decimals < 0 || decimals > decimal.MaxScale {
+		return "", fmt.Errorf("implied decimals %v out of range", decimals)
+	}
+	d := a.Decimal()
+	if d.MinScale() > decimals {
+		return "", fmt.Errorf("rounding is necessary")
+	}
+	digits := width
+	switch sign {
+	case SignLeading, SignTrailing:
+		digits--
+	case SignZoned:
+	case SignNone:
+		if d.IsNeg() {
+			return "", fmt.Errorf("negative amount without a sign")
+		}
+	default:
+		return "", fmt.Errorf("invalid sign convention %v", sign)
+	}
+
+	// Digits
+	s := d.Trim(decimals).Abs().String()
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		s = s[:i] + s[i+1:] + strings.Repeat("0", decimals-(len(s)-i-1))
+	} else {
+		s += strings.Repeat("0", decimals)
+	}
+	s = strings.TrimLeft(s, "0")
+	if len(s) > digits || digits < 1 {
+		return "", fmt.Errorf("width %v is too small", width)
+	}
+	s = strings.Repeat("0", digits-len(s)) + s
+
+	// Sign
+	neg := d.IsNeg()
+	switch sign {
+	case SignLeading:
+		if neg {
+			return "-" + s, nil
+		}
+		return "+" + s, nil
+	case SignTrailing:
+		if neg {
+			return s + "-", nil
+		}
+		return s + "+", nil
+	case SignZoned:
+		zones := zonedPos
+		if neg {
+			zones = zonedNeg
+		}
+		last := len(s) - 1
+		return s[:last] + string(zones[s[last]-'0']), nil
+	default:
+		return s, nil
+	}
+}
+
+// ParseFixedWidth converts a zero-padded numeric field with the given number
+// of implied decimal places, as produced by [Amount.EncodeFixedWidth],
+// to an amount in the given currency.
+// For [SignTrailing], a space is accepted in place of '+'.
+// For [SignZoned], a plain digit is accepted in place of a positive
+// overpunched digit.
+// If the number of implied decimals is less than the scale of the currency,
+// the result will be zero-padded to the right.
+//
+// ParseFixedWidth returns an error if:
+//   - the currency code is not valid;
+//   - the number of implied decimals is negative or greater than [decimal.MaxScale];
+//   - the sign convention is not valid;
+//   - the field is empty or contains unexpected characters;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func ParseFixedWidth(curr, field string, decimals int, sign SignConvention) (Amount, error) {
+	a, err := parseFixedWidth(curr, field, decimals, sign)
+	if err != nil {
+		return Amount{}, fmt.Errorf("parsing fixed-width field %q: %w", field, err)
+	}
+	return a, nil
+}
+
+func parseFixedWidth(curr, field string, decimals int, sign SignConvention) (Amount, error) {
+	// Currency
+	c, err := ParseCurr(curr)
+	if err != nil {
+		return Amount{}, err
+	}
+	if decimals < 0 || decimals > decimal.MaxScale {
+		return Amount{}, fmt.Errorf("implied decimals %v out of range", decimals)
+	}
+
+	// Sign
+	s, neg := field, false
+	switch sign {
+	case SignLeading:
+		if s == "" || (s[0] != '+' && s[0] != '-') {
+			return Amount{}, fmt.Errorf("missing leading sign")
+		}
+		s, neg = s[1:], s[0] == '-'
+	case SignTrailing:
+		last := len(s) - 1
+		if last < 0 || (s[last] != '+' && s[last] != '-' && s[last] != ' ') {
+			return Amount{}, fmt.Errorf("missing trailing sign")
+		}
+		s, neg = s[:last], s[last] == '-'
+	case SignZoned:
+		last := len(s) - 1
+		if last < 0 {
+			return Amount{}, fmt.Errorf("empty field")
+		}
+		switch z := s[last]; {
+		case '0' <= z && z <= '9':
+		case strings.IndexByte(zonedPos, z) >= 0:
+			s = s[:last] + string(rune('0'+strings.IndexByte(zonedPos, z)))
+		case strings.IndexByte(zonedNeg, z) >= 0:
+			s, neg = s[:last]+string(rune('0'+strings.IndexByte(zonedNeg, z))), true
+		default:
+			return Amount{}, fmt.Errorf("invalid zoned digit %q", z)
+		}
+	case SignNone:
+	default:
+		return Amount{}, fmt.Errorf("invalid sign convention %v", sign)
+	}
+
+	// Digits
+	if s == "" {
+		return Amount{}, fmt.Errorf("missing digits")
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return Amount{}, fmt.Errorf("invalid digit %q", s[i])
+		}
+	}
+	s = strings.TrimLeft(s, "0")
+	if len(s) > decimal.MaxPrec {
+		return Amount{}, errAmountOverflow
+	}
+	if len(s) <= decimals {
+		s = strings.Repeat("0", decimals-len(s)+1) + s
+	}
+	if decimals > 0 {
+		s = s[:len(s)-decimals] + "." + s[len(s)-decimals:]
+	}
+	if neg {
+		s = "-" + s
+	}
+	d, err := decimal.Parse(s)
+	if err != nil {
+		return Amount{}, err
+	}
+	return newAmountSafe(c, d)
+}
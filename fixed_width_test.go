@@ -0,0 +1,153 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestSignConvention_String(t *testing.T) {
+	tests := []struct {
+		s    SignConvention
+		want string
+	}{
+		{SignLeading, "leading"},
+		{SignTrailing, "trailing"},
+		{SignZoned, "zoned"},
+		{SignNone, "none"},
+		{SignConvention(-1), "SignConvention(-1)"},
+	}
+	for _, tt := range tests {
+		if got := tt.s.String(); got != tt.want {
+			t.Errorf("%v.String() = %q, want %q", int8(tt.s), got, tt.want)
+		}
+	}
+}
+
+func TestAmount_EncodeFixedWidth(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, a         string
+			width, decimals int
+			sign            SignConvention
+			want            string
+		}{
+			{"USD", "123.45", 10, 2, SignLeading, "+000012345"},
+			{"USD", "-123.45", 10, 2, SignLeading, "-000012345"},
+			{"USD", "123.45", 10, 2, SignTrailing, "000012345+"},
+			{"USD", "-123.45", 10, 2, SignTrailing, "000012345-"},
+			{"USD", "123.45", 10, 2, SignZoned, "000001234E"},
+			{"USD", "-123.45", 10, 2, SignZoned, "000001234N"},
+			{"USD", "-123.40", 10, 2, SignZoned, "000001234}"},
+			{"USD", "123.40", 10, 2, SignZoned, "000001234{"},
+			{"USD", "123.45", 10, 2, SignNone, "0000012345"},
+			{"USD", "123.45", 10, 4, SignNone, "0001234500"},
+			{"USD", "123.4500", 5, 2, SignNone, "12345"},
+			{"USD", "0", 3, 2, SignNone, "000"},
+			{"USD", "0", 3, 2, SignTrailing, "00+"},
+			{"JPY", "100", 6, 0, SignLeading, "+00100"},
+			{"USD", "99999999999999999.99", 19, 2, SignNone, "9999999999999999999"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.a)
+			got, err := a.EncodeFixedWidth(tt.width, tt.decimals, tt.sign)
+			if err != nil {
+				t.Errorf("%q.EncodeFixedWidth(%v, %v, %v) failed: %v", a, tt.width, tt.decimals, tt.sign, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%q.EncodeFixedWidth(%v, %v, %v) = %q, want %q", a, tt.width, tt.decimals, tt.sign, got, tt.want)
+			}
+			b, err := ParseFixedWidth(tt.curr, got, tt.decimals, tt.sign)
+			if err != nil {
+				t.Errorf("ParseFixedWidth(%q, %q, %v, %v) failed: %v", tt.curr, got, tt.decimals, tt.sign, err)
+				continue
+			}
+			if c, err := b.Cmp(a); err != nil || c != 0 {
+				t.Errorf("ParseFixedWidth(%q, %q, %v, %v) = %q, want %q", tt.curr, got, tt.decimals, tt.sign, b, a)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			a               string
+			width, decimals int
+			sign            SignConvention
+		}{
+			"decimals 1": {"1", 10, -1, SignNone},
+			"decimals 2": {"1", 10, 20, SignNone},
+			"rounding 1": {"1.234", 10, 2, SignNone},
+			"sign 1":     {"-1", 10, 2, SignNone},
+			"sign 2":     {"1", 10, 2, SignConvention(-1)},
+			"width 1":    {"123.45", 4, 2, SignNone},
+			"width 2":    {"123.45", 5, 2, SignLeading},
+			"width 3":    {"0", 1, 0, SignTrailing},
+		}
+		for name, tt := range tests {
+			a := MustParseAmount("USD", tt.a)
+			_, err := a.EncodeFixedWidth(tt.width, tt.decimals, tt.sign)
+			if err == nil {
+				t.Errorf("%q.EncodeFixedWidth(%v, %v, %v) did not fail: %v", a, tt.width, tt.decimals, tt.sign, name)
+			}
+		}
+	})
+}
+
+func TestParseFixedWidth(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, field string
+			decimals    int
+			sign        SignConvention
+			want        string
+		}{
+			{"USD", "0000012345 ", 2, SignTrailing, "123.45"},
+			{"USD", "0000012345", 2, SignZoned, "123.45"},
+			{"USD", "00000000000000000000000012345", 2, SignNone, "123.45"},
+			{"USD", "00012345", 3, SignNone, "12.345"},
+			{"USD", "12345", 0, SignNone, "12345.00"},
+			{"USD", "-00000", 2, SignLeading, "0.00"},
+			{"JPY", "12345", 2, SignNone, "123.45"},
+		}
+		for _, tt := range tests {
+			got, err := ParseFixedWidth(tt.curr, tt.field, tt.decimals, tt.sign)
+			if err != nil {
+				t.Errorf("ParseFixedWidth(%q, %q, %v, %v) failed: %v", tt.curr, tt.field, tt.decimals, tt.sign, err)
+				continue
+			}
+			want := MustParseAmount(tt.curr, tt.want)
+			if got != want {
+				t.Errorf("ParseFixedWidth(%q, %q, %v, %v) = %q, want %q", tt.curr, tt.field, tt.decimals, tt.sign, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr, field string
+			decimals    int
+			sign        SignConvention
+		}{
+			"currency 1": {"ZZZ", "123", 2, SignNone},
+			"decimals 1": {"USD", "123", -1, SignNone},
+			"decimals 2": {"USD", "123", 20, SignNone},
+			"sign 1":     {"USD", "123", 2, SignLeading},
+			"sign 2":     {"USD", "123", 2, SignTrailing},
+			"sign 3":     {"USD", "12X", 2, SignZoned},
+			"sign 4":     {"USD", "123", 2, SignConvention(-1)},
+			"empty 1":    {"USD", "", 2, SignNone},
+			"empty 2":    {"USD", "", 2, SignZoned},
+			"empty 3":    {"USD", "+", 2, SignLeading},
+			"digit 1":    {"USD", "12.3", 2, SignNone},
+			"digit 2":    {"USD", " 123", 2, SignNone},
+			"digit 3":    {"USD", "-123", 2, SignNone},
+			"overflow 1": {"USD", "123456789012345678", 0, SignNone},
+			"overflow 2": {"USD", "12345678901234567890", 2, SignNone},
+		}
+		for name, tt := range tests {
+			_, err := ParseFixedWidth(tt.curr, tt.field, tt.decimals, tt.sign)
+			if err == nil {
+				t.Errorf("ParseFixedWidth(%q, %q, %v, %v) did not fail: %v", tt.curr, tt.field, tt.decimals, tt.sign, name)
+			}
+		}
+	})
+}
@@ -0,0 +1,106 @@
+package money
+
+import (
+	"fmt"
+)
+
+// Revalue returns the position revalued at the closing rate and the unrealized
+// gain or loss relative to the booked rate, both in the quote currency of
+// the rates, which is the booking currency.
+// The booked and the revalued amounts are rounded to the scale of the booking
+// currency using [Amount.RoundToCurr] before the gain or loss is computed,
+// so the gain or loss is exactly the adjustment that brings the booked balance
+// to the revalued balance.
+// A positive gain or loss is a gain, a negative one is a loss.
+//
+// Revalue returns an error if:
+//   - the rates are denominated in different base or quote currencies;
+//   - the base currency of the rates does not match the currency of the position;
+//   - the integer part of any result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func Revalue(position Amount, bookedRate, closingRate ExchangeRate) (revalued, gainLoss Amount, err error) {
+	revalued, gainLoss, err = revalue(position, bookedRate, closingRate)
+	if err != nil {
+		return Amount{}, Amount{}, fmt.Errorf("revaluing [%v] from [%v] to [%v]: %w", position, bookedRate, closingRate, err)
+	}
+	return revalued, gainLoss, nil
+}
+
+func revalue(position Amount, bookedRate, closingRate ExchangeRate) (revalued, gainLoss Amount, err error) {
+	if !bookedRate.SameCurr(closingRate) {
+		return Amount{}, Amount{}, rateMismatchError(bookedRate, closingRate)
+	}
+	booked, err := bookedRate.conv(position)
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+	revalued, err = closingRate.conv(position)
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+	booked = booked.RoundToCurr()
+	revalued = revalued.RoundToCurr()
+	gainLoss, err = revalued.sub(booked)
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+	return revalued, gainLoss, nil
+}
+
+// RealizedGainLoss returns the realized gain or loss in the quote currency of
+// the rates, which is the booking currency, when the settled amount of
+// an original amount booked at the original rate is settled at
+// the settlement rate.
+// The settled amount may be a part of the original amount in case of
+// partial settlements.
+// The gain or loss equals settled * (settleRate - origRate); it is computed
+// without any intermediate rounding and then rounded to the scale of
+// the booking currency using [Amount.RoundToCurr].
+// A positive gain or loss is a gain, a negative one is a loss.
+// See also function [Revalue].
+//
+// RealizedGainLoss returns an error if:
+//   - the rates are denominated in different base or quote currencies;
+//   - the base currency of the rates does not match the currency of the amounts;
+//   - the settled amount has a different sign or a greater absolute value
+//     than the original amount;
+//   - the integer part of any result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func RealizedGainLoss(orig Amount, origRate ExchangeRate, settled Amount, settleRate ExchangeRate) (Amount, error) {
+	g, err := realizedGainLoss(orig, origRate, settled, settleRate)
+	if err != nil {
+		return Amount{}, fmt.Errorf("computing realized gain or loss of [%v] at [%v] settled as [%v] at [%v]: %w", orig, origRate, settled, settleRate, err)
+	}
+	return g, nil
+}
+
+func realizedGainLoss(orig Amount, origRate ExchangeRate, settled Amount, settleRate ExchangeRate) (Amount, error) {
+	if !origRate.SameCurr(settleRate) {
+		return Amount{}, rateMismatchError(origRate, settleRate)
+	}
+	if !orig.SameCurr(settled) {
+		return Amount{}, mismatchError(orig.Curr(), settled.Curr())
+	}
+	if settled.Sign()*orig.Sign() < 0 {
+		return Amount{}, fmt.Errorf("settled amount must have the same sign as original amount")
+	}
+	switch c, err := settled.CmpAbs(orig); {
+	case err != nil:
+		return Amount{}, err
+	case c > 0:
+		return Amount{}, fmt.Errorf("settled amount must not exceed original amount")
+	}
+	booked, err := origRate.conv(settled)
+	if err != nil {
+		return Amount{}, err
+	}
+	received, err := settleRate.conv(settled)
+	if err != nil {
+		return Amount{}, err
+	}
+	g, err := received.sub(booked)
+	if err != nil {
+		return Amount{}, err
+	}
+	return g.RoundToCurr(), nil
+}
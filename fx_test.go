@@ -0,0 +1,109 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestRevalue(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			b, q, pos, booked, closing string
+			wantRevalued, wantGainLoss string
+		}{
+			{"EUR", "USD", "1000.00", "1.1000", "1.1200", "1120.00", "20.00"},
+			{"EUR", "USD", "1000.00", "1.1200", "1.1000", "1100.00", "-20.00"},
+			{"EUR", "USD", "-1000.00", "1.1000", "1.1200", "-1120.00", "-20.00"},
+			{"EUR", "USD", "333.33", "1.0995", "1.1003", "366.76", "0.26"},
+			{"EUR", "USD", "100.00", "1.1000", "1.1000", "110.00", "0.00"},
+			{"USD", "JPY", "100.00", "150.255", "151.745", "15174", "148"},
+		}
+		for _, tt := range tests {
+			pos := MustParseAmount(tt.b, tt.pos)
+			booked := MustParseExchRate(tt.b, tt.q, tt.booked)
+			closing := MustParseExchRate(tt.b, tt.q, tt.closing)
+			gotRevalued, gotGainLoss, err := Revalue(pos, booked, closing)
+			if err != nil {
+				t.Errorf("Revalue(%q, %q, %q) failed: %v", pos, booked, closing, err)
+				continue
+			}
+			wantRevalued := MustParseAmount(tt.q, tt.wantRevalued)
+			wantGainLoss := MustParseAmount(tt.q, tt.wantGainLoss)
+			if gotRevalued != wantRevalued || gotGainLoss != wantGainLoss {
+				t.Errorf("Revalue(%q, %q, %q) = [%q %q], want [%q %q]", pos, booked, closing, gotRevalued, gotGainLoss, wantRevalued, wantGainLoss)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			pos             Amount
+			booked, closing ExchangeRate
+		}{
+			"currency 1": {MustParseAmount("EUR", "1"), MustParseExchRate("EUR", "USD", "1.1"), MustParseExchRate("EUR", "GBP", "0.9")},
+			"currency 2": {MustParseAmount("GBP", "1"), MustParseExchRate("EUR", "USD", "1.1"), MustParseExchRate("EUR", "USD", "1.2")},
+			"overflow 1": {MustParseAmount("USD", "10000000000000000"), MustParseExchRate("USD", "JPY", "1000"), MustParseExchRate("USD", "JPY", "1000")},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, _, err := Revalue(tt.pos, tt.booked, tt.closing)
+				if err == nil {
+					t.Errorf("Revalue(%q, %q, %q) did not fail", tt.pos, tt.booked, tt.closing)
+				}
+			})
+		}
+	})
+}
+
+func TestRealizedGainLoss(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			b, q, orig, origRate, settled, settleRate string
+			want                                      string
+		}{
+			{"EUR", "USD", "100.00", "1.1000", "100.00", "1.1200", "2.00"},
+			{"EUR", "USD", "100.00", "1.1200", "100.00", "1.1000", "-2.00"},
+			{"EUR", "USD", "100.00", "1.1000", "40.00", "1.1200", "0.80"},
+			{"EUR", "USD", "100.00", "1.1000", "0.00", "1.1200", "0.00"},
+			{"EUR", "USD", "-100.00", "1.1000", "-100.00", "1.1200", "-2.00"},
+			{"EUR", "USD", "333.33", "1.0995", "333.33", "1.1003", "0.27"},
+			{"USD", "JPY", "100.00", "150.255", "100.00", "151.745", "149"},
+		}
+		for _, tt := range tests {
+			orig := MustParseAmount(tt.b, tt.orig)
+			origRate := MustParseExchRate(tt.b, tt.q, tt.origRate)
+			settled := MustParseAmount(tt.b, tt.settled)
+			settleRate := MustParseExchRate(tt.b, tt.q, tt.settleRate)
+			got, err := RealizedGainLoss(orig, origRate, settled, settleRate)
+			if err != nil {
+				t.Errorf("RealizedGainLoss(%q, %q, %q, %q) failed: %v", orig, origRate, settled, settleRate, err)
+				continue
+			}
+			want := MustParseAmount(tt.q, tt.want)
+			if got != want {
+				t.Errorf("RealizedGainLoss(%q, %q, %q, %q) = %q, want %q", orig, origRate, settled, settleRate, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		eurusd := MustParseExchRate("EUR", "USD", "1.1")
+		tests := map[string]struct {
+			orig, settled        Amount
+			origRate, settleRate ExchangeRate
+		}{
+			"currency 1": {MustParseAmount("EUR", "1"), MustParseAmount("EUR", "1"), eurusd, MustParseExchRate("EUR", "GBP", "0.9")},
+			"currency 2": {MustParseAmount("EUR", "1"), MustParseAmount("GBP", "1"), eurusd, eurusd},
+			"currency 3": {MustParseAmount("GBP", "1"), MustParseAmount("GBP", "1"), eurusd, eurusd},
+			"settled 1":  {MustParseAmount("EUR", "1"), MustParseAmount("EUR", "-1"), eurusd, eurusd},
+			"settled 2":  {MustParseAmount("EUR", "1"), MustParseAmount("EUR", "1.01"), eurusd, eurusd},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := RealizedGainLoss(tt.orig, tt.origRate, tt.settled, tt.settleRate)
+				if err == nil {
+					t.Errorf("RealizedGainLoss(%q, %q, %q, %q) did not fail", tt.orig, tt.origRate, tt.settled, tt.settleRate)
+				}
+			})
+		}
+	})
+}
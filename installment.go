@@ -0,0 +1,115 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/govalues/decimal"
+)
+
+// InstallmentPolicy specifies which installments absorb the remainder
+// when a total cannot be divided into equal installments.
+// See also function [Installments].
+type InstallmentPolicy int8
+
+const (
+	// AdjustLast adds the whole remainder to the last installment.
+	AdjustLast InstallmentPolicy = iota
+	// AdjustFirst adds the whole remainder to the first installment.
+	AdjustFirst
+	// Spread distributes the remainder one minor unit at a time among
+	// the first installments.
+	Spread
+)
+
+// String implements the [fmt.Stringer] interface and returns
+// a string representation of the installment policy.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (p InstallmentPolicy) String() string {
+	switch p {
+	case AdjustLast:
+		return "adjust last"
+	case AdjustFirst:
+		return "adjust first"
+	case Spread:
+		return "spread"
+	default:
+		return fmt.Sprintf("InstallmentPolicy(%d)", int8(p))
+	}
+}
+
+// Installments returns the amounts due in each of n installments of the total.
+// The regular installments are equal to the total divided by n and truncated
+// to the scale of the currency, and the remainder is added according to
+// the policy.
+// The installments always sum up exactly to the total and are
+// at the scale of the currency.
+// Unlike [Amount.Split], which works at the scale of the amount, Installments
+// always produces amounts payable in minor units, and with [AdjustFirst] and
+// [AdjustLast] all installments except one are equal.
+//
+// Installments returns an error if:
+//   - the number of installments is not a positive integer;
+//   - the policy is not valid;
+//   - the total has more digits after the decimal point than the currency.
+func Installments(total Amount, n int, policy InstallmentPolicy) ([]Amount, error) {
+	res, err := installments(total, n, policy)
+	if err != nil {
+		return nil, fmt.Errorf("computing %v installments of %v: %w", n, total, err)
+	}
+	return res, nil
+}
+
+func installments(total Amount, n int, policy InstallmentPolicy) ([]Amount, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("number of installments must be positive")
+	}
+	if total.MinScale() > total.Curr().Scale() {
+		return nil, fmt.Errorf("total must be a multiple of the minor unit")
+	}
+	total = total.TrimToCurr()
+
+	var idx int
+	switch policy {
+	case Spread:
+		return total.split(n)
+	case AdjustFirst:
+		idx = 0
+	case AdjustLast:
+		idx = n - 1
+	default:
+		return nil, fmt.Errorf("invalid installment policy %v", policy)
+	}
+
+	// Regular installment
+	par, err := decimal.New(int64(n), 0)
+	if err != nil {
+		return nil, err
+	}
+	reg, err := total.quo(par)
+	if err != nil {
+		return nil, err
+	}
+	reg = reg.Trunc(reg.Curr().Scale())
+
+	// Adjusted installment
+	adj, err := reg.mul(par)
+	if err != nil {
+		return nil, err
+	}
+	adj, err = total.sub(adj)
+	if err != nil {
+		return nil, err
+	}
+	adj, err = adj.add(reg)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]Amount, n)
+	for i := range res {
+		res[i] = reg
+	}
+	res[idx] = adj
+	return res, nil
+}
@@ -0,0 +1,66 @@
+package money
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestInstallments(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, total string
+			n           int
+			policy      InstallmentPolicy
+			want        []string
+		}{
+			{"USD", "100", 3, AdjustLast, []string{"33.33", "33.33", "33.34"}},
+			{"USD", "100", 3, AdjustFirst, []string{"33.34", "33.33", "33.33"}},
+			{"USD", "100", 3, Spread, []string{"33.34", "33.33", "33.33"}},
+			{"USD", "100.02", 4, AdjustLast, []string{"25.00", "25.00", "25.00", "25.02"}},
+			{"USD", "100.02", 4, AdjustFirst, []string{"25.02", "25.00", "25.00", "25.00"}},
+			{"USD", "100.02", 4, Spread, []string{"25.01", "25.01", "25.00", "25.00"}},
+			{"USD", "100.000", 3, AdjustLast, []string{"33.33", "33.33", "33.34"}},
+			{"USD", "-100", 3, AdjustLast, []string{"-33.33", "-33.33", "-33.34"}},
+			{"USD", "0.01", 3, AdjustLast, []string{"0.00", "0.00", "0.01"}},
+			{"USD", "0.01", 3, AdjustFirst, []string{"0.01", "0.00", "0.00"}},
+			{"USD", "9.99", 1, AdjustLast, []string{"9.99"}},
+			{"JPY", "1000", 3, AdjustLast, []string{"333", "333", "334"}},
+			{"JPY", "1000", 3, Spread, []string{"334", "333", "333"}},
+		}
+		for _, tt := range tests {
+			total := MustParseAmount(tt.curr, tt.total)
+			got, err := Installments(total, tt.n, tt.policy)
+			if err != nil {
+				t.Errorf("Installments(%q, %v, %v) failed: %v", total, tt.n, tt.policy, err)
+				continue
+			}
+			want := MustParseAmountSlice(tt.curr, tt.want)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Installments(%q, %v, %v) = %v, want %v", total, tt.n, tt.policy, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr, total string
+			n           int
+			policy      InstallmentPolicy
+		}{
+			"number 1": {"USD", "100", 0, AdjustLast},
+			"number 2": {"USD", "100", -1, AdjustLast},
+			"policy 1": {"USD", "100", 3, InstallmentPolicy(-1)},
+			"scale 1":  {"USD", "100.001", 3, AdjustLast},
+			"scale 2":  {"JPY", "100.5", 3, Spread},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				total := MustParseAmount(tt.curr, tt.total)
+				_, err := Installments(total, tt.n, tt.policy)
+				if err == nil {
+					t.Errorf("Installments(%q, %v, %v) did not fail", total, tt.n, tt.policy)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,221 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/govalues/decimal"
+)
+
+// Interval represents a closed range [lo, hi] of amounts denominated in
+// the same currency.
+// It is useful for estimating totals with uncertain components, such as
+// pending currency conversions or estimated fees, where each component is
+// known only to lie within some bounds.
+// Interval arithmetic is exact: the bounds are never rounded, and
+// operations return an error if the exact result cannot be represented.
+// The zero value corresponds to the point interval ["XXX 0", "XXX 0"],
+// where [XXX] indicates an unknown currency.
+type Interval struct {
+	lo, hi Amount
+}
+
+// NewInterval returns an interval with the specified bounds.
+//
+// NewInterval returns an error if:
+//   - amounts are denominated in different currencies;
+//   - lo is greater than hi.
+func NewInterval(lo, hi Amount) (Interval, error) {
+	i, err := newInterval(lo, hi)
+	if err != nil {
+		return Interval{}, fmt.Errorf("constructing [%v, %v]: %w", lo, hi, err)
+	}
+	return i, nil
+}
+
+func newInterval(lo, hi Amount) (Interval, error) {
+	if !lo.SameCurr(hi) {
+		return Interval{}, mismatchError(lo.Curr(), hi.Curr())
+	}
+	if lo.Decimal().Cmp(hi.Decimal()) > 0 {
+		return Interval{}, fmt.Errorf("lower bound %v is greater than upper bound %v", lo, hi)
+	}
+	return Interval{lo: lo, hi: hi}, nil
+}
+
+// NewIntervalPoint returns an interval containing only amount a,
+// that is, an amount known without uncertainty.
+func NewIntervalPoint(a Amount) Interval {
+	return Interval{lo: a, hi: a}
+}
+
+// Lo returns the lower bound of the interval.
+func (i Interval) Lo() Amount {
+	return i.lo
+}
+
+// Hi returns the upper bound of the interval.
+func (i Interval) Hi() Amount {
+	return i.hi
+}
+
+// Curr returns the currency of the interval.
+func (i Interval) Curr() Currency {
+	return i.lo.Curr()
+}
+
+// IsPoint returns true if the bounds of the interval are numerically equal.
+func (i Interval) IsPoint() bool {
+	return i.lo.Decimal().Cmp(i.hi.Decimal()) == 0
+}
+
+// Width returns the difference hi - lo.
+//
+// Width returns an error if the integer part of the result has more than
+// ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func (i Interval) Width() (Amount, error) {
+	return i.hi.Sub(i.lo)
+}
+
+// Contains returns true if amount a lies within the interval,
+// bounds included.
+//
+// Contains returns an error if the amount and the interval are denominated
+// in different currencies.
+func (i Interval) Contains(a Amount) (bool, error) {
+	if i.Curr() != a.Curr() {
+		return false, fmt.Errorf("checking if %v contains %v: %w", i, a, mismatchError(i.Curr(), a.Curr()))
+	}
+	d := a.Decimal()
+	return i.lo.Decimal().Cmp(d) <= 0 && d.Cmp(i.hi.Decimal()) <= 0, nil
+}
+
+// Add returns the interval [i.lo + j.lo, i.hi + j.hi], which contains
+// all sums of amounts from intervals i and j.
+//
+// Add returns an error if:
+//   - intervals are denominated in different currencies;
+//   - either bound of the result cannot be represented exactly.
+func (i Interval) Add(j Interval) (Interval, error) {
+	k, err := i.add(j)
+	if err != nil {
+		return Interval{}, fmt.Errorf("computing [%v + %v]: %w", i, j, err)
+	}
+	return k, nil
+}
+
+func (i Interval) add(j Interval) (Interval, error) {
+	if i.Curr() != j.Curr() {
+		return Interval{}, mismatchError(i.Curr(), j.Curr())
+	}
+	lo, err := intervalBound(i.lo, j.lo, decimal.One, decimal.One)
+	if err != nil {
+		return Interval{}, err
+	}
+	hi, err := intervalBound(i.hi, j.hi, decimal.One, decimal.One)
+	if err != nil {
+		return Interval{}, err
+	}
+	return Interval{lo: lo, hi: hi}, nil
+}
+
+// Sub returns the interval [i.lo - j.hi, i.hi - j.lo], which contains
+// all differences of amounts from intervals i and j.
+//
+// Sub returns an error if:
+//   - intervals are denominated in different currencies;
+//   - either bound of the result cannot be represented exactly.
+func (i Interval) Sub(j Interval) (Interval, error) {
+	k, err := i.sub(j)
+	if err != nil {
+		return Interval{}, fmt.Errorf("computing [%v - %v]: %w", i, j, err)
+	}
+	return k, nil
+}
+
+func (i Interval) sub(j Interval) (Interval, error) {
+	if i.Curr() != j.Curr() {
+		return Interval{}, mismatchError(i.Curr(), j.Curr())
+	}
+	lo, err := intervalBound(i.lo, j.hi, decimal.One, decimal.NegOne)
+	if err != nil {
+		return Interval{}, err
+	}
+	hi, err := intervalBound(i.hi, j.lo, decimal.One, decimal.NegOne)
+	if err != nil {
+		return Interval{}, err
+	}
+	return Interval{lo: lo, hi: hi}, nil
+}
+
+// Mul returns the interval containing all products of amounts from
+// interval i and factor e.
+// If the factor is negative, the bounds are swapped.
+//
+// Mul returns an error if either bound of the result cannot be represented
+// exactly.
+func (i Interval) Mul(e decimal.Decimal) (Interval, error) {
+	k, err := i.mul(e)
+	if err != nil {
+		return Interval{}, fmt.Errorf("computing [%v * %v]: %w", i, e, err)
+	}
+	return k, nil
+}
+
+func (i Interval) mul(e decimal.Decimal) (Interval, error) {
+	z := newAmountUnsafe(i.Curr(), decimal.Zero)
+	lo, err := intervalBound(i.lo, z, e, decimal.Zero)
+	if err != nil {
+		return Interval{}, err
+	}
+	hi, err := intervalBound(i.hi, z, e, decimal.Zero)
+	if err != nil {
+		return Interval{}, err
+	}
+	if e.IsNeg() {
+		lo, hi = hi, lo
+	}
+	return Interval{lo: lo, hi: hi}, nil
+}
+
+// intervalBound returns the exact linear combination a * x + b * y.
+// It returns an error if the result cannot be represented exactly.
+func intervalBound(a, b Amount, x, y decimal.Decimal) (Amount, error) {
+	c, err := a.mul(x)
+	if err != nil {
+		return Amount{}, err
+	}
+	if !y.IsZero() {
+		d, err := b.mul(y)
+		if err != nil {
+			return Amount{}, err
+		}
+		c, err = c.add(d)
+		if err != nil {
+			return Amount{}, err
+		}
+	}
+	want := new(big.Rat).Mul(decimalRat(a.Decimal()), decimalRat(x))
+	want.Add(want, new(big.Rat).Mul(decimalRat(b.Decimal()), decimalRat(y)))
+	if decimalRat(c.Decimal()).Cmp(want) != 0 {
+		return Amount{}, fmt.Errorf("bound %v cannot be represented exactly", want.FloatString(decimal.MaxScale))
+	}
+	return c, nil
+}
+
+// decimalRat returns the exact rational value of decimal d.
+func decimalRat(d decimal.Decimal) *big.Rat {
+	x := new(big.Int).SetUint64(d.Coef())
+	if d.IsNeg() {
+		x.Neg(x)
+	}
+	return new(big.Rat).SetFrac(x, pow10Big(d.Scale()))
+}
+
+// String implements the [fmt.Stringer] interface and returns
+// a string representation of the interval, for example, "[USD 1.00, USD 2.50]".
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (i Interval) String() string {
+	return "[" + i.lo.String() + ", " + i.hi.String() + "]"
+}
@@ -0,0 +1,249 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/govalues/decimal"
+)
+
+func TestNewInterval(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		i, err := NewInterval(MustParseAmount("USD", "1.00"), MustParseAmount("USD", "2.50"))
+		if err != nil {
+			t.Fatalf("NewInterval(\"USD 1.00\", \"USD 2.50\") failed: %v", err)
+		}
+		if got, want := i.String(), "[USD 1.00, USD 2.50]"; got != want {
+			t.Errorf("NewInterval(...).String() = %q, want %q", got, want)
+		}
+		if i.IsPoint() {
+			t.Errorf("%v.IsPoint() = true, want false", i)
+		}
+		p := NewIntervalPoint(MustParseAmount("USD", "1.00"))
+		if !p.IsPoint() {
+			t.Errorf("%v.IsPoint() = false, want true", p)
+		}
+		w, err := i.Width()
+		if err != nil {
+			t.Fatalf("%v.Width() failed: %v", i, err)
+		}
+		if want := MustParseAmount("USD", "1.50"); w != want {
+			t.Errorf("%v.Width() = %q, want %q", i, w, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			lo, hi Amount
+		}{
+			"mismatch 1": {MustParseAmount("USD", "1"), MustParseAmount("EUR", "2")},
+			"order 1":    {MustParseAmount("USD", "2"), MustParseAmount("USD", "1")},
+		}
+		for name, tt := range tests {
+			_, err := NewInterval(tt.lo, tt.hi)
+			if err == nil {
+				t.Errorf("NewInterval(%q, %q) did not fail: %v", tt.lo, tt.hi, name)
+			}
+		}
+	})
+}
+
+func TestInterval_zero(t *testing.T) {
+	var z Interval
+	if got, want := z.String(), "[XXX 0, XXX 0]"; got != want {
+		t.Errorf("Interval{}.String() = %q, want %q", got, want)
+	}
+	if !z.IsPoint() {
+		t.Errorf("Interval{}.IsPoint() = false, want true")
+	}
+}
+
+func TestInterval_Contains(t *testing.T) {
+	i, err := NewInterval(MustParseAmount("USD", "1.00"), MustParseAmount("USD", "2.00"))
+	if err != nil {
+		t.Fatalf("NewInterval(\"USD 1.00\", \"USD 2.00\") failed: %v", err)
+	}
+	tests := []struct {
+		a    string
+		want bool
+	}{
+		{"0.99", false},
+		{"1", true},
+		{"1.5", true},
+		{"2.000", true},
+		{"2.01", false},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount("USD", tt.a)
+		got, err := i.Contains(a)
+		if err != nil {
+			t.Errorf("%v.Contains(%q) failed: %v", i, a, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%v.Contains(%q) = %v, want %v", i, a, got, tt.want)
+		}
+	}
+	if _, err := i.Contains(MustParseAmount("EUR", "1")); err == nil {
+		t.Errorf("%v.Contains(\"EUR 1\") did not fail", i)
+	}
+}
+
+func TestInterval_Add(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			i, j [2]string
+			want string
+		}{
+			{[2]string{"1.00", "2.00"}, [2]string{"0.10", "0.25"}, "[USD 1.10, USD 2.25]"},
+			{[2]string{"-1", "1"}, [2]string{"5", "5"}, "[USD 4.00, USD 6.00]"},
+			{[2]string{"0.001", "0.002"}, [2]string{"0", "0"}, "[USD 0.001, USD 0.002]"},
+		}
+		for _, tt := range tests {
+			i, err := NewInterval(MustParseAmount("USD", tt.i[0]), MustParseAmount("USD", tt.i[1]))
+			if err != nil {
+				t.Fatalf("NewInterval(%q, %q) failed: %v", tt.i[0], tt.i[1], err)
+			}
+			j, err := NewInterval(MustParseAmount("USD", tt.j[0]), MustParseAmount("USD", tt.j[1]))
+			if err != nil {
+				t.Fatalf("NewInterval(%q, %q) failed: %v", tt.j[0], tt.j[1], err)
+			}
+			got, err := i.Add(j)
+			if err != nil {
+				t.Errorf("%v.Add(%v) failed: %v", i, j, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("%v.Add(%v) = %v, want %v", i, j, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			i, j [2]Amount
+		}{
+			"mismatch 1": {[2]Amount{MustParseAmount("USD", "1"), MustParseAmount("USD", "2")}, [2]Amount{MustParseAmount("EUR", "1"), MustParseAmount("EUR", "2")}},
+			"overflow 1": {[2]Amount{MustParseAmount("USD", "1"), MustParseAmount("USD", "99999999999999999")}, [2]Amount{MustParseAmount("USD", "0"), MustParseAmount("USD", "1")}},
+			"inexact 1":  {[2]Amount{MustParseAmount("USD", "0"), MustParseAmount("USD", "10000000000000000")}, [2]Amount{MustParseAmount("USD", "0"), MustParseAmount("USD", "0.001")}},
+		}
+		for name, tt := range tests {
+			i, err := NewInterval(tt.i[0], tt.i[1])
+			if err != nil {
+				t.Fatalf("NewInterval(%q, %q) failed: %v", tt.i[0], tt.i[1], err)
+			}
+			j, err := NewInterval(tt.j[0], tt.j[1])
+			if err != nil {
+				t.Fatalf("NewInterval(%q, %q) failed: %v", tt.j[0], tt.j[1], err)
+			}
+			_, err = i.Add(j)
+			if err == nil {
+				t.Errorf("%v.Add(%v) did not fail: %v", i, j, name)
+			}
+		}
+	})
+}
+
+func TestInterval_Sub(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			i, j [2]string
+			want string
+		}{
+			{[2]string{"10.00", "12.00"}, [2]string{"1.00", "3.00"}, "[USD 7.00, USD 11.00]"},
+			{[2]string{"1", "1"}, [2]string{"1", "1"}, "[USD 0.00, USD 0.00]"},
+			{[2]string{"0", "0"}, [2]string{"-0.5", "0.5"}, "[USD -0.50, USD 0.50]"},
+		}
+		for _, tt := range tests {
+			i, err := NewInterval(MustParseAmount("USD", tt.i[0]), MustParseAmount("USD", tt.i[1]))
+			if err != nil {
+				t.Fatalf("NewInterval(%q, %q) failed: %v", tt.i[0], tt.i[1], err)
+			}
+			j, err := NewInterval(MustParseAmount("USD", tt.j[0]), MustParseAmount("USD", tt.j[1]))
+			if err != nil {
+				t.Fatalf("NewInterval(%q, %q) failed: %v", tt.j[0], tt.j[1], err)
+			}
+			got, err := i.Sub(j)
+			if err != nil {
+				t.Errorf("%v.Sub(%v) failed: %v", i, j, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("%v.Sub(%v) = %v, want %v", i, j, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			i, j [2]Amount
+		}{
+			"mismatch 1": {[2]Amount{MustParseAmount("USD", "1"), MustParseAmount("USD", "2")}, [2]Amount{MustParseAmount("EUR", "1"), MustParseAmount("EUR", "2")}},
+			"overflow 1": {[2]Amount{MustParseAmount("USD", "-99999999999999999"), MustParseAmount("USD", "0")}, [2]Amount{MustParseAmount("USD", "0"), MustParseAmount("USD", "1")}},
+		}
+		for name, tt := range tests {
+			i, err := NewInterval(tt.i[0], tt.i[1])
+			if err != nil {
+				t.Fatalf("NewInterval(%q, %q) failed: %v", tt.i[0], tt.i[1], err)
+			}
+			j, err := NewInterval(tt.j[0], tt.j[1])
+			if err != nil {
+				t.Fatalf("NewInterval(%q, %q) failed: %v", tt.j[0], tt.j[1], err)
+			}
+			_, err = i.Sub(j)
+			if err == nil {
+				t.Errorf("%v.Sub(%v) did not fail: %v", i, j, name)
+			}
+		}
+	})
+}
+
+func TestInterval_Mul(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			i    [2]string
+			e    string
+			want string
+		}{
+			{[2]string{"100.00", "110.00"}, "1.5", "[USD 150.000, USD 165.000]"},
+			{[2]string{"100.00", "110.00"}, "-2", "[USD -220.00, USD -200.00]"},
+			{[2]string{"-1", "1"}, "0", "[USD 0.00, USD 0.00]"},
+			{[2]string{"1.00", "1.00"}, "0.0125", "[USD 0.012500, USD 0.012500]"},
+		}
+		for _, tt := range tests {
+			i, err := NewInterval(MustParseAmount("USD", tt.i[0]), MustParseAmount("USD", tt.i[1]))
+			if err != nil {
+				t.Fatalf("NewInterval(%q, %q) failed: %v", tt.i[0], tt.i[1], err)
+			}
+			e := decimal.MustParse(tt.e)
+			got, err := i.Mul(e)
+			if err != nil {
+				t.Errorf("%v.Mul(%v) failed: %v", i, e, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("%v.Mul(%v) = %v, want %v", i, e, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			i [2]Amount
+			e string
+		}{
+			"overflow 1": {[2]Amount{MustParseAmount("USD", "0"), MustParseAmount("USD", "99999999999999999")}, "10"},
+			"inexact 1":  {[2]Amount{MustParseAmount("USD", "0"), MustParseAmount("USD", "1.2345678901")}, "0.123456789012345"},
+		}
+		for name, tt := range tests {
+			i, err := NewInterval(tt.i[0], tt.i[1])
+			if err != nil {
+				t.Fatalf("NewInterval(%q, %q) failed: %v", tt.i[0], tt.i[1], err)
+			}
+			e := decimal.MustParse(tt.e)
+			_, err = i.Mul(e)
+			if err == nil {
+				t.Errorf("%v.Mul(%v) did not fail: %v", i, e, name)
+			}
+		}
+	})
+}
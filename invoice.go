@@ -0,0 +1,222 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/govalues/decimal"
+)
+
+// InvoiceRounding specifies at which level the amounts of an invoice are rounded.
+// See also function [ComputeInvoice].
+type InvoiceRounding int8
+
+const (
+	// RoundPerLine rounds the net and tax amounts of each line, and the totals
+	// are the sums of the rounded lines.
+	RoundPerLine InvoiceRounding = iota
+	// RoundPerTotal rounds only the totals, and the rounding difference is
+	// distributed among the lines, so that the lines still sum up to the totals.
+	RoundPerTotal
+)
+
+// String implements the [fmt.Stringer] interface and returns
+// a string representation of the rounding strategy.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (r InvoiceRounding) String() string {
+	switch r {
+	case RoundPerLine:
+		return "per line"
+	case RoundPerTotal:
+		return "per total"
+	default:
+		return fmt.Sprintf("InvoiceRounding(%d)", int8(r))
+	}
+}
+
+// LineItem represents a single line of an invoice.
+type LineItem struct {
+	Qty       decimal.Decimal // quantity of units
+	UnitPrice Amount          // net price of one unit
+	TaxRate   decimal.Decimal // tax rate expressed as a fraction, for example, 0.20 for 20%
+}
+
+// InvoiceLine represents the computed amounts of a single [LineItem].
+type InvoiceLine struct {
+	Net   Amount // amount before tax
+	Tax   Amount // tax amount
+	Gross Amount // amount after tax, always equal to Net + Tax
+}
+
+// Invoice represents the breakdown of an invoice computed by [ComputeInvoice].
+// The net, tax, and gross amounts of the lines always sum up to
+// the corresponding totals, and the gross total always equals
+// the net total plus the tax total.
+type Invoice struct {
+	Lines []InvoiceLine // computed lines in the order of the line items
+	Net   Amount        // total amount before tax
+	Tax   Amount        // total tax amount
+	Gross Amount        // total amount after tax
+}
+
+// ComputeInvoice computes the net, tax, and gross amounts of the line items
+// and the totals of the invoice using the specified rounding strategy.
+// Amounts are rounded half to even to the scale of the currency.
+// With [RoundPerLine], the tax of each line is computed from its rounded net amount.
+// With [RoundPerTotal], the totals are computed from the exact amounts of the lines,
+// and the rounding difference is distributed among the first lines of the invoice
+// one minor unit at a time.
+//
+// ComputeInvoice returns an error if:
+//   - there are no line items;
+//   - the unit prices are denominated in different currencies;
+//   - the rounding strategy is not valid;
+//   - the integer part of any result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func ComputeInvoice(items []LineItem, r InvoiceRounding) (Invoice, error) {
+	inv, err := computeInvoice(items, r)
+	if err != nil {
+		return Invoice{}, fmt.Errorf("computing invoice %v: %w", r, err)
+	}
+	return inv, nil
+}
+
+func computeInvoice(items []LineItem, r InvoiceRounding) (Invoice, error) {
+	if len(items) == 0 {
+		return Invoice{}, fmt.Errorf("no line items")
+	}
+	curr := items[0].UnitPrice.Curr()
+	for _, item := range items {
+		if item.UnitPrice.Curr() != curr {
+			return Invoice{}, mismatchError(curr, item.UnitPrice.Curr())
+		}
+	}
+
+	var nets, taxes []Amount
+	var err error
+	switch r {
+	case RoundPerLine:
+		nets, taxes, err = invoiceLinesPerLine(items)
+	case RoundPerTotal:
+		nets, taxes, err = invoiceLinesPerTotal(items)
+	default:
+		return Invoice{}, fmt.Errorf("invalid rounding strategy %v", r)
+	}
+	if err != nil {
+		return Invoice{}, err
+	}
+
+	// Totals
+	zero := newAmountUnsafe(curr, decimal.Zero.Pad(curr.Scale()))
+	inv := Invoice{
+		Lines: make([]InvoiceLine, len(items)),
+		Net:   zero,
+		Tax:   zero,
+	}
+	for i := range items {
+		gross, err := nets[i].Add(taxes[i])
+		if err != nil {
+			return Invoice{}, err
+		}
+		inv.Lines[i] = InvoiceLine{Net: nets[i], Tax: taxes[i], Gross: gross}
+		inv.Net, err = inv.Net.Add(nets[i])
+		if err != nil {
+			return Invoice{}, err
+		}
+		inv.Tax, err = inv.Tax.Add(taxes[i])
+		if err != nil {
+			return Invoice{}, err
+		}
+	}
+	inv.Gross, err = inv.Net.Add(inv.Tax)
+	if err != nil {
+		return Invoice{}, err
+	}
+	return inv, nil
+}
+
+// invoiceLinesPerLine returns the net and tax amounts of the lines,
+// each rounded to the scale of the currency.
+func invoiceLinesPerLine(items []LineItem) (nets, taxes []Amount, err error) {
+	nets = make([]Amount, len(items))
+	taxes = make([]Amount, len(items))
+	for i, item := range items {
+		net, err := item.UnitPrice.Mul(item.Qty)
+		if err != nil {
+			return nil, nil, err
+		}
+		nets[i] = net.Round(net.Curr().Scale())
+		tax, err := nets[i].Mul(item.TaxRate)
+		if err != nil {
+			return nil, nil, err
+		}
+		taxes[i] = tax.Round(tax.Curr().Scale())
+	}
+	return nets, taxes, nil
+}
+
+// invoiceLinesPerTotal returns the net and tax amounts of the lines,
+// adjusted so that they sum up to the rounded sums of the exact amounts.
+func invoiceLinesPerTotal(items []LineItem) (nets, taxes []Amount, err error) {
+	nets = make([]Amount, len(items))
+	taxes = make([]Amount, len(items))
+	for i, item := range items {
+		nets[i], err = item.UnitPrice.Mul(item.Qty)
+		if err != nil {
+			return nil, nil, err
+		}
+		taxes[i], err = nets[i].Mul(item.TaxRate)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	nets, err = roundToTotal(nets)
+	if err != nil {
+		return nil, nil, err
+	}
+	taxes, err = roundToTotal(taxes)
+	if err != nil {
+		return nil, nil, err
+	}
+	return nets, taxes, nil
+}
+
+// roundToTotal rounds the amounts to the scale of the currency and distributes
+// the rounding difference among the first amounts, so that the result sums up
+// to the rounded sum of the original amounts.
+func roundToTotal(amounts []Amount) ([]Amount, error) {
+	var err error
+	c := amounts[0].Curr()
+	exact := newAmountUnsafe(c, decimal.Zero.Pad(c.Scale()))
+	rounded := exact
+	res := make([]Amount, len(amounts))
+	for i, a := range amounts {
+		exact, err = exact.Add(a)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = a.Round(c.Scale())
+		rounded, err = rounded.Add(res[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Difference distribution
+	diff, err := exact.Round(c.Scale()).Sub(rounded)
+	if err != nil {
+		return nil, err
+	}
+	ulp := diff.ULP().CopySign(diff)
+	for i := 0; i < len(res) && !diff.IsZero(); i++ {
+		diff, err = diff.Sub(ulp)
+		if err != nil {
+			return nil, err
+		}
+		res[i], err = res[i].Add(ulp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
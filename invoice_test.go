@@ -0,0 +1,104 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/govalues/decimal"
+)
+
+func TestComputeInvoice(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		item := func(qty, price, rate string) LineItem {
+			return LineItem{
+				Qty:       decimal.MustParse(qty),
+				UnitPrice: MustParseAmount("USD", price),
+				TaxRate:   decimal.MustParse(rate),
+			}
+		}
+		tests := []struct {
+			items                   []LineItem
+			r                       InvoiceRounding
+			wantNets, wantTaxes     []string
+			wantNet, wantTax, wantG string
+		}{
+			{
+				[]LineItem{item("1", "0.335", "0.2"), item("1", "0.335", "0.2"), item("1", "0.335", "0.2")},
+				RoundPerLine,
+				[]string{"0.34", "0.34", "0.34"},
+				[]string{"0.07", "0.07", "0.07"},
+				"1.02", "0.21", "1.23",
+			},
+			{
+				[]LineItem{item("1", "0.335", "0.2"), item("1", "0.335", "0.2"), item("1", "0.335", "0.2")},
+				RoundPerTotal,
+				[]string{"0.33", "0.33", "0.34"},
+				[]string{"0.06", "0.07", "0.07"},
+				"1.00", "0.20", "1.20",
+			},
+			{
+				[]LineItem{item("3", "9.99", "0.0725"), item("0.5", "4.00", "0")},
+				RoundPerLine,
+				[]string{"29.97", "2.00"},
+				[]string{"2.17", "0.00"},
+				"31.97", "2.17", "34.14",
+			},
+			{
+				[]LineItem{item("3", "9.99", "0.0725"), item("0.5", "4.00", "0")},
+				RoundPerTotal,
+				[]string{"29.97", "2.00"},
+				[]string{"2.17", "0.00"},
+				"31.97", "2.17", "34.14",
+			},
+		}
+		for _, tt := range tests {
+			got, err := ComputeInvoice(tt.items, tt.r)
+			if err != nil {
+				t.Errorf("ComputeInvoice(%v, %v) failed: %v", tt.items, tt.r, err)
+				continue
+			}
+			wantNet := MustParseAmount("USD", tt.wantNet)
+			wantTax := MustParseAmount("USD", tt.wantTax)
+			wantGross := MustParseAmount("USD", tt.wantG)
+			if got.Net != wantNet || got.Tax != wantTax || got.Gross != wantGross {
+				t.Errorf("ComputeInvoice(%v, %v) = [%q %q %q], want [%q %q %q]", tt.items, tt.r, got.Net, got.Tax, got.Gross, wantNet, wantTax, wantGross)
+			}
+			if len(got.Lines) != len(tt.items) {
+				t.Errorf("ComputeInvoice(%v, %v) returned %v lines, want %v", tt.items, tt.r, len(got.Lines), len(tt.items))
+				continue
+			}
+			for i, line := range got.Lines {
+				wantNet := MustParseAmount("USD", tt.wantNets[i])
+				wantTax := MustParseAmount("USD", tt.wantTaxes[i])
+				wantGross, err := wantNet.Add(wantTax)
+				if err != nil {
+					t.Errorf("%q.Add(%q) failed: %v", wantNet, wantTax, err)
+					continue
+				}
+				if line.Net != wantNet || line.Tax != wantTax || line.Gross != wantGross {
+					t.Errorf("ComputeInvoice(%v, %v) line %v = [%q %q %q], want [%q %q %q]", tt.items, tt.r, i+1, line.Net, line.Tax, line.Gross, wantNet, wantTax, wantGross)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		one := decimal.MustParse("1")
+		tests := map[string]struct {
+			items []LineItem
+			r     InvoiceRounding
+		}{
+			"empty":    {nil, RoundPerLine},
+			"currency": {[]LineItem{{Qty: one, UnitPrice: MustParseAmount("USD", "1")}, {Qty: one, UnitPrice: MustParseAmount("EUR", "1")}}, RoundPerLine},
+			"strategy": {[]LineItem{{Qty: one, UnitPrice: MustParseAmount("USD", "1")}}, InvoiceRounding(-1)},
+			"overflow": {[]LineItem{{Qty: decimal.MustParse("10000000000"), UnitPrice: MustParseAmount("USD", "10000000000")}}, RoundPerTotal},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := ComputeInvoice(tt.items, tt.r)
+				if err == nil {
+					t.Errorf("ComputeInvoice(%v, %v) did not fail", tt.items, tt.r)
+				}
+			})
+		}
+	})
+}
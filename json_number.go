@@ -0,0 +1,89 @@
+package money
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// JSONNumber is an opt-in JSON representation of an amount with the value
+// encoded as a JSON number instead of a string, for example:
+//
+//	{"currency":"USD","amount":5.67}
+//
+// It is intended for downstream consumers, such as BI tools, that require
+// numeric values.
+// Most JSON decoders, including JavaScript ones, parse numbers as binary
+// floating-point numbers, so only amounts that survive a round trip through
+// float64 are encoded.
+// Use conversions JSONNumber(a) and Amount(n) to switch between the types.
+type JSONNumber Amount
+
+// jsonNumber is the wire layout of [JSONNumber] used for encoding.
+type jsonNumber struct {
+	Currency Currency    `json:"currency"`
+	Amount   json.Number `json:"amount"`
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The scale of the amount is adjusted according to the options registered
+// using [SetCodecOptions]; by default, the value is encoded without any
+// rounding and with trailing zeros preserved.
+//
+// MarshalJSON returns an error if the amount cannot be converted to float64
+// and back to the same decimal value, see [Amount.Float64Lossy].
+//
+// [json.Marshaler]: https://pkg.go.dev/encoding/json#Marshaler
+func (n JSONNumber) MarshalJSON() ([]byte, error) {
+	a := Amount(n).encodable()
+	if _, lossy := a.Float64Lossy(); lossy {
+		return nil, fmt.Errorf("marshaling %v: amount is not exactly representable as float64", a)
+	}
+	return json.Marshal(jsonNumber{
+		Currency: a.Curr(),
+		Amount:   json.Number(a.Decimal().String()),
+	})
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// The amount may be encoded either as a JSON number or as a JSON string,
+// for example, both {"currency":"USD","amount":5.67} and
+// {"currency":"USD","amount":"5.67"} are accepted, because real-world APIs
+// are inconsistent.
+// In both forms the value is parsed from its textual representation and
+// never converted to float64, so the following precision rules apply:
+//   - trailing zeros are preserved, for example, 5.670 remains USD 5.670;
+//   - exponent notation, such as 5.67e2, is accepted;
+//   - digits beyond [MaxCoefDigits] significant digits are rounded half to
+//     even, as in [ParseAmount].
+//
+// Both the currency and the amount are required.
+// See also constructor [ParseAmount].
+//
+// [json.Unmarshaler]: https://pkg.go.dev/encoding/json#Unmarshaler
+func (n *JSONNumber) UnmarshalJSON(data []byte) error {
+	var v struct {
+		Currency *Currency       `json:"currency"`
+		Amount   json.RawMessage `json:"amount"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("unmarshaling %s: %w", data, err)
+	}
+	if v.Currency == nil {
+		return fmt.Errorf("unmarshaling %s: missing currency", data)
+	}
+	if len(v.Amount) == 0 || string(v.Amount) == "null" {
+		return fmt.Errorf("unmarshaling %s: missing amount", data)
+	}
+	s := string(v.Amount)
+	if v.Amount[0] == '"' {
+		if err := json.Unmarshal(v.Amount, &s); err != nil {
+			return fmt.Errorf("unmarshaling %s: %w", data, err)
+		}
+	}
+	a, err := ParseAmount(v.Currency.Code(), s)
+	if err != nil {
+		return fmt.Errorf("unmarshaling %s: %w", data, err)
+	}
+	*n = JSONNumber(a)
+	return nil
+}
@@ -0,0 +1,123 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJSONNumber_Interfaces(t *testing.T) {
+	var i any = JSONNumber{}
+	_, ok := i.(json.Marshaler)
+	if !ok {
+		t.Errorf("%T does not implement json.Marshaler", i)
+	}
+
+	i = &JSONNumber{}
+	_, ok = i.(json.Unmarshaler)
+	if !ok {
+		t.Errorf("%T does not implement json.Unmarshaler", i)
+	}
+}
+
+func TestJSONNumber_MarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, amount, want string
+		}{
+			{"USD", "5.67", `{"currency":"USD","amount":5.67}`},
+			{"USD", "5.670", `{"currency":"USD","amount":5.670}`},
+			{"USD", "-0.01", `{"currency":"USD","amount":-0.01}`},
+			{"JPY", "100", `{"currency":"JPY","amount":100}`},
+			{"OMR", "0", `{"currency":"OMR","amount":0.000}`},
+			{"USD", "9007199254740992", `{"currency":"USD","amount":9007199254740992.00}`},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.amount)
+			got, err := json.Marshal(JSONNumber(a))
+			if err != nil {
+				t.Errorf("json.Marshal(%v) failed: %v", a, err)
+				continue
+			}
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal(%v) = %s, want %s", a, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			curr, amount string
+		}{
+			{"USD", "9007199254740993"},
+			{"USD", "12345678901234567.89"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.amount)
+			_, err := json.Marshal(JSONNumber(a))
+			if err == nil {
+				t.Errorf("json.Marshal(%v) did not fail", a)
+			}
+		}
+	})
+}
+
+func TestJSONNumber_UnmarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			data, want string
+		}{
+			{`{"currency":"USD","amount":5.67}`, "USD 5.67"},
+			{`{"currency":"usd","amount":5}`, "USD 5.00"},
+			{`{"amount":1.5,"currency":"JPY"}`, "JPY 1.5"},
+			{`{"currency":"USD","amount":12345678901234567.89}`, "USD 12345678901234567.89"},
+			{`{"currency":"USD","amount":5.670}`, "USD 5.670"},
+			{`{"currency":"USD","amount":5.67e2}`, "USD 567.00"},
+			{`{"currency":"USD","amount":1.23456789012345678901}`, "USD 1.234567890123456789"},
+			{`{"currency":"USD","amount":-5.67}`, "USD -5.67"},
+
+			// Strings
+			{`{"currency":"USD","amount":"5.67"}`, "USD 5.67"},
+			{`{"currency":"USD","amount":"5.670"}`, "USD 5.670"},
+			{`{"currency":"USD","amount":"-5.67e2"}`, "USD -567.00"},
+			{`{"currency":"USD","amount":"12345678901234567.89"}`, "USD 12345678901234567.89"},
+			{`{"currency":"USD","amount":"1.23456789012345678901"}`, "USD 1.234567890123456789"},
+		}
+		for _, tt := range tests {
+			var got JSONNumber
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if err != nil {
+				t.Errorf("json.Unmarshal(%s) failed: %v", tt.data, err)
+				continue
+			}
+			if Amount(got).String() != tt.want {
+				t.Errorf("json.Unmarshal(%s) = %q, want %q", tt.data, Amount(got), tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			`{"currency":"UUU","amount":1}`,
+			`{"currency":"USD"}`,
+			`{"amount":"5.67"}`,
+			`{"currency":"","amount":"5.67"}`,
+			`{"currency":null,"amount":"5.67"}`,
+			`{"currency":"USD","amount":1e400}`,
+			`"USD 1.00"`,
+			`{"currency":"USD","amount":null}`,
+			`{"currency":"USD","amount":""}`,
+			`{"currency":"USD","amount":"abc"}`,
+			`{"currency":"USD","amount":"USD 1.00"}`,
+			`{"currency":"USD","amount":true}`,
+			`{"currency":"USD","amount":[1]}`,
+			`{"currency":"USD","amount":"123456789012345678.9"}`,
+		}
+		for _, data := range tests {
+			var got JSONNumber
+			err := json.Unmarshal([]byte(data), &got)
+			if err == nil {
+				t.Errorf("json.Unmarshal(%s) did not fail", data)
+			}
+		}
+	})
+}
@@ -0,0 +1,44 @@
+package money
+
+import (
+	"strings"
+
+	"github.com/govalues/decimal"
+)
+
+const (
+	// MaxCoefDigits is the maximum number of significant digits of an amount
+	// or an exchange rate.
+	MaxCoefDigits = decimal.MaxPrec
+	// MaxScale is the maximum number of digits after the decimal point of
+	// an amount or an exchange rate.
+	MaxScale = decimal.MaxScale
+)
+
+// SmallestNonzero returns the smallest positive amount that can be
+// represented in the currency, which is 0.0000000000000000001.
+// It is intended for validation layers and property tests that need to
+// reference the limits of the package without hard-coding them.
+// See also function [MaxAmount].
+func SmallestNonzero(c Currency) Amount {
+	return newAmountUnsafe(c, decimal.MustNew(1, MaxScale))
+}
+
+// MaxAmount returns the largest amount that can be represented in
+// the currency, which has [MaxCoefDigits] nines with the scale of
+// the currency.
+// For example, for US Dollars the result is 99999999999999999.99.
+// The smallest amount is the negation of the result.
+// See also function [SmallestNonzero].
+func MaxAmount(c Currency) Amount {
+	s := c.Scale()
+	whole, frac := strings.Repeat("9", MaxCoefDigits-s), strings.Repeat("9", s)
+	if whole == "" {
+		whole = "0"
+	}
+	if frac != "" {
+		whole += "." + frac
+	}
+	d := decimal.MustParse(whole)
+	return newAmountUnsafe(c, d)
+}
@@ -0,0 +1,50 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestSmallestNonzero(t *testing.T) {
+	tests := []struct {
+		c    Currency
+		want string
+	}{
+		{USD, "0.0000000000000000001"},
+		{JPY, "0.0000000000000000001"},
+		{KWD, "0.0000000000000000001"},
+	}
+	for _, tt := range tests {
+		got := SmallestNonzero(tt.c)
+		want := MustParseAmount(tt.c.Code(), tt.want)
+		if got != want {
+			t.Errorf("SmallestNonzero(%v) = %q, want %q", tt.c, got, want)
+		}
+		if got.ULP() != got {
+			t.Errorf("SmallestNonzero(%v).ULP() = %q, want %q", tt.c, got.ULP(), got)
+		}
+	}
+}
+
+func TestMaxAmount(t *testing.T) {
+	tests := []struct {
+		c    Currency
+		want string
+	}{
+		{USD, "99999999999999999.99"},
+		{JPY, "9999999999999999999"},
+		{KWD, "9999999999999999.999"},
+	}
+	for _, tt := range tests {
+		got := MaxAmount(tt.c)
+		want := MustParseAmount(tt.c.Code(), tt.want)
+		if got != want {
+			t.Errorf("MaxAmount(%v) = %q, want %q", tt.c, got, want)
+		}
+		if got.Decimal().Prec() != MaxCoefDigits {
+			t.Errorf("MaxAmount(%v).Prec() = %v, want %v", tt.c, got.Decimal().Prec(), MaxCoefDigits)
+		}
+		if _, err := got.Add(got.ULP()); err == nil {
+			t.Errorf("MaxAmount(%v) + ULP did not fail", tt.c)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package money
+
+// Observer is the interface implemented by metrics that record observations,
+// such as histograms and summaries.
+// It is satisfied by [prometheus.Observer] without importing the package.
+//
+// [prometheus.Observer]: https://pkg.go.dev/github.com/prometheus/client_golang/prometheus#Observer
+type Observer interface {
+	Observe(float64)
+}
+
+// Counter is the interface implemented by metrics that count events.
+// It is satisfied by [prometheus.Counter] without importing the package.
+//
+// [prometheus.Counter]: https://pkg.go.dev/github.com/prometheus/client_golang/prometheus#Counter
+type Counter interface {
+	Inc()
+}
+
+// ObserveAmount records the amount in major units using the observer, and
+// increments the counter if the conversion to float lost precision.
+// The counter may be nil if the precision loss does not need to be tracked.
+// Amounts in different currencies should be recorded by different observers,
+// for example, by using the currency code as a label.
+// See also method [Amount.Float64Lossy].
+func ObserveAmount(o Observer, lossy Counter, a Amount) {
+	f, l := a.Float64Lossy()
+	o.Observe(f)
+	if l && lossy != nil {
+		lossy.Inc()
+	}
+}
@@ -0,0 +1,46 @@
+package money
+
+import (
+	"testing"
+)
+
+type testObserver []float64
+
+func (o *testObserver) Observe(f float64) {
+	*o = append(*o, f)
+}
+
+type testCounter int
+
+func (c *testCounter) Inc() {
+	*c++
+}
+
+func TestObserveAmount(t *testing.T) {
+	tests := []struct {
+		curr, a   string
+		want      float64
+		wantLossy int
+	}{
+		{"USD", "0", 0, 0},
+		{"USD", "1.23", 1.23, 0},
+		{"USD", "-1.23", -1.23, 0},
+		{"JPY", "1000000", 1000000, 0},
+		{"USD", "12345678901234567.89", 12345678901234567.89, 1},
+		{"USD", "0.1234567890123456789", 0.1234567890123456789, 1},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.curr, tt.a)
+		var o testObserver
+		var c testCounter
+		ObserveAmount(&o, &c, a)
+		if len(o) != 1 || o[0] != tt.want {
+			t.Errorf("ObserveAmount(%q) observed %v, want [%v]", a, o, tt.want)
+		}
+		if int(c) != tt.wantLossy {
+			t.Errorf("ObserveAmount(%q) counted %v lossy conversions, want %v", a, c, tt.wantLossy)
+		}
+		// Nil counter
+		ObserveAmount(&o, nil, a)
+	}
+}
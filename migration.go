@@ -0,0 +1,66 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/govalues/decimal"
+)
+
+// MigrateMinorUnits converts amounts stored in minor units of the currency
+// at the old scale to minor units at the current scale of the currency,
+// as returned by [Currency.Scale].
+// It is intended for long-lived databases that store amounts as integers
+// and need to be migrated after the scale of a currency has changed,
+// for example, as a result of an update of the ISO 4217 standard.
+// If the current scale is less than the old scale, then the values are
+// rounded using the given rounding mode, and the indices of the values
+// that changed as a result of rounding are reported as lossy.
+// Use [Unnecessary] rounding mode to require a lossless conversion.
+// See also method [Currency.ScaleAt].
+//
+// MigrateMinorUnits returns an error if:
+//   - the old scale is negative or greater than [decimal.MaxScale];
+//   - the rounding mode is not valid;
+//   - the rounding mode is [Unnecessary] and rounding is necessary;
+//   - any result cannot be represented as an int64.
+func MigrateMinorUnits(curr Currency, oldScale int, units []int64, mode RoundingMode) (migrated []int64, lossy []int, err error) {
+	migrated, lossy, err = migrateMinorUnits(curr, oldScale, units, mode)
+	if err != nil {
+		return nil, nil, fmt.Errorf("migrating %v minor units from scale %v to %v: %w", curr, oldScale, curr.Scale(), err)
+	}
+	return migrated, lossy, nil
+}
+
+func migrateMinorUnits(curr Currency, oldScale int, units []int64, mode RoundingMode) (migrated []int64, lossy []int, err error) {
+	if oldScale < 0 || oldScale > decimal.MaxScale {
+		return nil, nil, fmt.Errorf("scale %v out of range", oldScale)
+	}
+	if !mode.valid() {
+		return nil, nil, fmt.Errorf("invalid rounding mode %v", mode)
+	}
+	scale := curr.Scale()
+	migrated = make([]int64, len(units))
+	for i, u := range units {
+		d, err := decimal.New(u, oldScale)
+		if err != nil {
+			return nil, nil, fmt.Errorf("value %v at index %v: %w", u, i, err)
+		}
+		if d.MinScale() > scale {
+			if mode == Unnecessary {
+				return nil, nil, fmt.Errorf("value %v at index %v: rounding is necessary", u, i)
+			}
+			lossy = append(lossy, i)
+			d = roundDecimal(d, scale, mode)
+		}
+		d = d.Rescale(scale)
+		if d.Scale() != scale {
+			return nil, nil, fmt.Errorf("value %v at index %v: %w", u, i, errAmountOverflow)
+		}
+		m, ok := minorUnits(d)
+		if !ok {
+			return nil, nil, fmt.Errorf("value %v at index %v: %w", u, i, errAmountOverflow)
+		}
+		migrated[i] = m
+	}
+	return migrated, lossy, nil
+}
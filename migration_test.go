@@ -0,0 +1,61 @@
+package money
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestMigrateMinorUnits(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr      Currency
+			oldScale  int
+			units     []int64
+			mode      RoundingMode
+			want      []int64
+			wantLossy []int
+		}{
+			{USD, 2, []int64{100, -5, 0}, Unnecessary, []int64{100, -5, 0}, nil},
+			{USD, 0, []int64{1, -12}, Unnecessary, []int64{100, -1200}, nil},
+			{KWD, 2, []int64{150}, Unnecessary, []int64{1500}, nil},
+			{JPY, 2, []int64{1200, -300}, Unnecessary, []int64{12, -3}, nil},
+			{JPY, 2, []int64{1250, 1350, 1201, -1250}, HalfEven, []int64{12, 14, 12, -12}, []int{0, 1, 2, 3}},
+			{JPY, 2, []int64{1200, 1250, 1201}, Down, []int64{12, 12, 12}, []int{1, 2}},
+			{JPY, 2, []int64{1200, 1201}, Up, []int64{12, 13}, []int{1}},
+			{JPY, 2, nil, HalfEven, []int64{}, nil},
+		}
+		for _, tt := range tests {
+			got, gotLossy, err := MigrateMinorUnits(tt.curr, tt.oldScale, tt.units, tt.mode)
+			if err != nil {
+				t.Errorf("MigrateMinorUnits(%v, %v, %v, %v) failed: %v", tt.curr, tt.oldScale, tt.units, tt.mode, err)
+				continue
+			}
+			if !slices.Equal(got, tt.want) || !slices.Equal(gotLossy, tt.wantLossy) {
+				t.Errorf("MigrateMinorUnits(%v, %v, %v, %v) = %v, %v, want %v, %v", tt.curr, tt.oldScale, tt.units, tt.mode, got, gotLossy, tt.want, tt.wantLossy)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr     Currency
+			oldScale int
+			units    []int64
+			mode     RoundingMode
+		}{
+			"scale 1":    {USD, -1, []int64{1}, HalfEven},
+			"scale 2":    {USD, 20, []int64{1}, HalfEven},
+			"mode 1":     {JPY, 2, []int64{1}, RoundingMode(-1)},
+			"lossy 1":    {JPY, 2, []int64{1200, 1201}, Unnecessary},
+			"overflow 1": {USD, 0, []int64{math.MaxInt64}, HalfEven},
+			"overflow 2": {USD, 0, []int64{math.MaxInt64 / 10}, HalfEven},
+		}
+		for name, tt := range tests {
+			_, _, err := MigrateMinorUnits(tt.curr, tt.oldScale, tt.units, tt.mode)
+			if err == nil {
+				t.Errorf("MigrateMinorUnits(%v, %v, %v, %v) did not fail: %v", tt.curr, tt.oldScale, tt.units, tt.mode, name)
+			}
+		}
+	})
+}
@@ -0,0 +1,242 @@
+/*
+Package moneyarrow maps amounts to and from columns in the physical layout of
+the [Apache Arrow] Decimal128 type.
+
+Arrow decimal columns do not carry a currency, so amounts are stored as one
+column per currency.
+Every column has the same length as the slice of amounts it was built from,
+and the validity bitmap of a column marks the rows denominated in its
+currency, so a row is valid in exactly one column.
+
+The package does not depend on any Arrow implementation.
+The buffers of a [Column] follow the Arrow columnar format: the values buffer
+holds 16-byte little-endian two's complement integers, and the validity
+buffer is a least-significant-bit ordered bitmap.
+They can be wrapped into Arrow arrays of type decimal128(38, scale) without
+copying, for example, using memory.NewBufferBytes and array.NewData from
+the Arrow Go module.
+
+[Apache Arrow]: https://arrow.apache.org/docs/format/Columnar.html
+*/
+package moneyarrow
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"sort"
+
+	"github.com/govalues/decimal"
+	"github.com/govalues/money"
+)
+
+// Precision is the precision of the Arrow Decimal128 type used by columns.
+const Precision = 38
+
+// valueSize is the size of a Decimal128 value in bytes.
+const valueSize = 16
+
+// pow10 holds powers of 10 that fit into uint64.
+var pow10 = func() [decimal.MaxScale + 1]uint64 {
+	var p [decimal.MaxScale + 1]uint64
+	p[0] = 1
+	for i := 1; i < len(p); i++ {
+		p[i] = p[i-1] * 10
+	}
+	return p
+}()
+
+// Column represents amounts denominated in a single currency in the physical
+// layout of an Arrow column of type decimal128([Precision], Scale).
+type Column struct {
+	Curr      money.Currency
+	Scale     int    // scale of the Decimal128 type
+	Len       int    // number of rows
+	NullCount int    // number of rows not denominated in the currency
+	Values    []byte // Len values, 16 bytes each
+	Validity  []byte // bitmap with a bit set for every valid row
+}
+
+// NewColumns converts amounts to columns, one per currency, ordered by
+// currency code.
+// The scale of each column is the largest scale of the amounts denominated in
+// its currency, but not less than the scale of the currency, so the values are
+// stored without rounding.
+// See also function [ReadColumns].
+func NewColumns(amounts []money.Amount) []Column {
+	// Scales
+	scales := make(map[money.Currency]int)
+	for _, a := range amounts {
+		c := a.Curr()
+		if s, ok := scales[c]; !ok || a.Scale() > s {
+			scales[c] = max(a.Scale(), c.Scale())
+		}
+	}
+	currs := make([]money.Currency, 0, len(scales))
+	for c := range scales {
+		currs = append(currs, c)
+	}
+	sort.Slice(currs, func(i, j int) bool {
+		return currs[i].Code() < currs[j].Code()
+	})
+
+	// Columns
+	cols := make([]Column, len(currs))
+	index := make(map[money.Currency]int, len(currs))
+	for i, c := range currs {
+		cols[i] = Column{
+			Curr:      c,
+			Scale:     scales[c],
+			Len:       len(amounts),
+			NullCount: len(amounts),
+			Values:    make([]byte, len(amounts)*valueSize),
+			Validity:  make([]byte, (len(amounts)+7)/8),
+		}
+		index[c] = i
+	}
+
+	// Values
+	for row, a := range amounts {
+		col := &cols[index[a.Curr()]]
+		coef, scale, neg, _ := a.Components()
+		hi, lo := bits.Mul64(coef, pow10[col.Scale-scale])
+		if neg {
+			hi, lo = negate(hi, lo)
+		}
+		putUint128(col.Values[row*valueSize:], hi, lo)
+		col.Validity[row/8] |= 1 << (row % 8)
+		col.NullCount--
+	}
+	return cols
+}
+
+// IsValid returns true if the row is denominated in the currency of the column.
+func (c Column) IsValid(row int) bool {
+	return c.Validity[row/8]&(1<<(row%8)) != 0
+}
+
+// Amount returns the amount stored in the row.
+// Trailing zeros are removed up to the scale of the currency.
+// If the row is not valid, the result is the zero amount and false.
+//
+// Amount returns an error if:
+//   - the row is out of range;
+//   - the value, without trailing zeros, does not fit into an int64 coefficient.
+func (c Column) Amount(row int) (money.Amount, bool, error) {
+	a, ok, err := c.amount(row)
+	if err != nil {
+		return money.Amount{}, false, fmt.Errorf("reading row %v of %v column: %w", row, c.Curr, err)
+	}
+	return a, ok, nil
+}
+
+func (c Column) amount(row int) (money.Amount, bool, error) {
+	if row < 0 || row >= c.Len || len(c.Values) < c.Len*valueSize || len(c.Validity) < (c.Len+7)/8 {
+		return money.Amount{}, false, fmt.Errorf("row out of range")
+	}
+	if !c.IsValid(row) {
+		return money.Amount{}, false, nil
+	}
+	hi, lo := getUint128(c.Values[row*valueSize:])
+	neg := hi>>63 != 0
+	if neg {
+		hi, lo = negate(hi, lo)
+	}
+	scale := c.Scale
+	for (hi != 0 || lo > math.MaxInt64) && scale > 0 {
+		q, r := bits.Div64(hi%10, lo, 10)
+		if r != 0 {
+			break
+		}
+		hi, lo = hi/10, q
+		scale--
+	}
+	if hi != 0 || lo > math.MaxInt64 {
+		return money.Amount{}, false, fmt.Errorf("value overflow")
+	}
+	v := int64(lo)
+	if neg {
+		v = -v
+	}
+	d, err := decimal.New(v, scale)
+	if err != nil {
+		return money.Amount{}, false, err
+	}
+	a, err := money.NewAmountFromDecimal(c.Curr, d)
+	if err != nil {
+		return money.Amount{}, false, err
+	}
+	return a.TrimToCurr(), true, nil
+}
+
+// ReadColumns converts columns produced by [NewColumns] back to amounts.
+// Trailing zeros are removed up to the scale of the currency.
+//
+// ReadColumns returns an error if:
+//   - the columns have different lengths;
+//   - a row is valid in none or in more than one column;
+//   - any value cannot be converted to an amount.
+func ReadColumns(cols []Column) ([]money.Amount, error) {
+	amounts, err := readColumns(cols)
+	if err != nil {
+		return nil, fmt.Errorf("reading columns: %w", err)
+	}
+	return amounts, nil
+}
+
+func readColumns(cols []Column) ([]money.Amount, error) {
+	if len(cols) == 0 {
+		return nil, nil
+	}
+	n := cols[0].Len
+	for _, c := range cols {
+		if c.Len != n {
+			return nil, fmt.Errorf("%v and %v columns have different lengths", cols[0].Curr, c.Curr)
+		}
+	}
+	amounts := make([]money.Amount, n)
+	for row := range amounts {
+		found := false
+		for _, c := range cols {
+			a, ok, err := c.Amount(row)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			if found {
+				return nil, fmt.Errorf("row %v is valid in more than one column", row)
+			}
+			amounts[row], found = a, true
+		}
+		if !found {
+			return nil, fmt.Errorf("row %v is not valid in any column", row)
+		}
+	}
+	return amounts, nil
+}
+
+// negate returns the two's complement of a 128-bit integer.
+func negate(hi, lo uint64) (uint64, uint64) {
+	lo, borrow := bits.Sub64(0, lo, 0)
+	hi, _ = bits.Sub64(0, hi, borrow)
+	return hi, lo
+}
+
+// putUint128 writes a 128-bit integer in little-endian order.
+func putUint128(b []byte, hi, lo uint64) {
+	for i := 0; i < 8; i++ {
+		b[i] = byte(lo >> (8 * i))
+		b[8+i] = byte(hi >> (8 * i))
+	}
+}
+
+// getUint128 reads a 128-bit integer in little-endian order.
+func getUint128(b []byte) (hi, lo uint64) {
+	for i := 0; i < 8; i++ {
+		lo |= uint64(b[i]) << (8 * i)
+		hi |= uint64(b[8+i]) << (8 * i)
+	}
+	return hi, lo
+}
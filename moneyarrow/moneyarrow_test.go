@@ -0,0 +1,131 @@
+package moneyarrow
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/govalues/money"
+)
+
+func TestNewColumns(t *testing.T) {
+	amounts := []money.Amount{
+		money.MustParseAmount("USD", "5.67"),
+		money.MustParseAmount("EUR", "-1"),
+		money.MustParseAmount("USD", "-0.001"),
+		money.MustParseAmount("JPY", "100"),
+	}
+	cols := NewColumns(amounts)
+	if len(cols) != 3 {
+		t.Fatalf("NewColumns(%v) returned %v columns, want 3", amounts, len(cols))
+	}
+	tests := []struct {
+		curr      money.Currency
+		scale     int
+		nullCount int
+		validity  []byte
+		values    []byte
+	}{
+		{
+			curr: money.EUR, scale: 2, nullCount: 3, validity: []byte{0b0010},
+			values: bytes.Join([][]byte{
+				make([]byte, 16),
+				{0x9c, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+				make([]byte, 32),
+			}, nil),
+		},
+		{
+			curr: money.JPY, scale: 0, nullCount: 3, validity: []byte{0b1000},
+			values: bytes.Join([][]byte{
+				make([]byte, 48),
+				{0x64, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			}, nil),
+		},
+		{
+			curr: money.USD, scale: 3, nullCount: 2, validity: []byte{0b0101},
+			values: bytes.Join([][]byte{
+				{0x26, 0x16, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+				make([]byte, 16),
+				{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+				make([]byte, 16),
+			}, nil),
+		},
+	}
+	for i, tt := range tests {
+		got := cols[i]
+		if got.Curr != tt.curr {
+			t.Errorf("NewColumns(%v)[%v].Curr = %v, want %v", amounts, i, got.Curr, tt.curr)
+		}
+		if got.Scale != tt.scale {
+			t.Errorf("NewColumns(%v)[%v].Scale = %v, want %v", amounts, i, got.Scale, tt.scale)
+		}
+		if got.Len != len(amounts) {
+			t.Errorf("NewColumns(%v)[%v].Len = %v, want %v", amounts, i, got.Len, len(amounts))
+		}
+		if got.NullCount != tt.nullCount {
+			t.Errorf("NewColumns(%v)[%v].NullCount = %v, want %v", amounts, i, got.NullCount, tt.nullCount)
+		}
+		if !bytes.Equal(got.Validity, tt.validity) {
+			t.Errorf("NewColumns(%v)[%v].Validity = %08b, want %08b", amounts, i, got.Validity, tt.validity)
+		}
+		if !bytes.Equal(got.Values, tt.values) {
+			t.Errorf("NewColumns(%v)[%v].Values = %x, want %x", amounts, i, got.Values, tt.values)
+		}
+	}
+}
+
+func TestReadColumns(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := [][]money.Amount{
+			nil,
+			{money.MustParseAmount("USD", "0")},
+			{
+				money.MustParseAmount("USD", "5.67"),
+				money.MustParseAmount("EUR", "-1"),
+				money.MustParseAmount("USD", "-0.001"),
+				money.MustParseAmount("JPY", "100"),
+				money.MustParseAmount("OMR", "0.0000000000000000001"),
+				money.MustParseAmount("JPY", "-9223372036854775807"),
+				money.MustParseAmount("JPY", "0.0000000000000000001"),
+			},
+		}
+		for _, amounts := range tests {
+			got, err := ReadColumns(NewColumns(amounts))
+			if err != nil {
+				t.Errorf("ReadColumns(NewColumns(%v)) failed: %v", amounts, err)
+				continue
+			}
+			if len(got) != len(amounts) {
+				t.Errorf("ReadColumns(NewColumns(%v)) = %v, want %v", amounts, got, amounts)
+				continue
+			}
+			for i, a := range amounts {
+				if got[i] != a.TrimToCurr() {
+					t.Errorf("ReadColumns(NewColumns(%v))[%v] = %q, want %q", amounts, i, got[i], a)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		usd := NewColumns([]money.Amount{money.MustParseAmount("USD", "1")})
+		eur := NewColumns([]money.Amount{money.MustParseAmount("EUR", "1")})
+		two := NewColumns([]money.Amount{money.MustParseAmount("EUR", "1"), money.MustParseAmount("EUR", "2")})
+		empty := Column{Curr: money.USD, Len: 1, Values: make([]byte, 16), Validity: make([]byte, 1)}
+		short := Column{Curr: money.USD, Len: 2, Values: make([]byte, 16), Validity: make([]byte, 1)}
+		big := Column{Curr: money.USD, Len: 1, Values: make([]byte, 16), Validity: []byte{1}}
+		big.Values[8] = 1 // 2^64
+		tests := map[string][]Column{
+			"length 1":   {usd[0], two[0]},
+			"validity 1": {usd[0], eur[0]},
+			"validity 2": {empty},
+			"range 1":    {short},
+			"overflow 1": {big},
+		}
+		for name, cols := range tests {
+			_, err := ReadColumns(cols)
+			if err == nil {
+				t.Errorf("ReadColumns(%v) did not fail", name)
+			}
+		}
+	})
+}
@@ -0,0 +1,243 @@
+/*
+Package moneygen provides the currency data tooling of package money,
+so that organizations can validate their own currency data and compile it
+into their programs at build time without forking the repository.
+
+The currency data is a CSV file with the header "Name,Code,Num,Scale",
+for example:
+
+	Name,Code,Num,Scale
+	Internal Token,ZZT,899,6
+	Loyalty Points,ZZP,,0
+
+The numeric code is optional.
+Function [Generate] produces a Go source file for a package of the caller,
+which registers the currencies using [money.LoadCurrencyData] when
+the package is initialized, for example, from a go:generate directive:
+
+	//go:generate go run ./internal/gencurr currencies.csv currency_data.go
+
+The same tooling is used by package money to generate its built-in table
+from scripts/currency/currency_data.csv.
+*/
+package moneygen
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/govalues/decimal"
+	"github.com/govalues/money"
+)
+
+// MaxCurrencies is the maximum number of currencies, including the built-in
+// ones, which is limited by the underlying type of [money.Currency].
+const MaxCurrencies = int(^money.Currency(0)) + 1
+
+// MaxScale is the maximum scale of a currency.
+const MaxScale = decimal.MaxScale
+
+// Currency represents a row of the currency data.
+type Currency struct {
+	// Name is the English name of the currency, for example, "US Dollar".
+	Name string
+	// Code is the alphabetic code of the currency, for example, "USD".
+	Code string
+	// Num is the numeric code of the currency, for example, "840",
+	// or an empty string if the currency has no numeric code.
+	Num string
+	// Scale is the number of digits after the decimal point in the minor
+	// unit of the currency, for example, 2.
+	Scale int
+}
+
+// ReadCSV reads and validates the currency data.
+// The currencies are returned in the order of the rows.
+// See also function [Validate].
+//
+// ReadCSV returns an error if:
+//   - the data is not a valid CSV file;
+//   - the header is not "Name,Code,Num,Scale";
+//   - any row does not have exactly four fields;
+//   - the scale of any currency is not an integer;
+//   - the currencies are not valid, see [Validate].
+func ReadCSV(r io.Reader) ([]Currency, error) {
+	currs, err := readCSV(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading currency data: %w", err)
+	}
+	return currs, nil
+}
+
+func readCSV(r io.Reader) ([]Currency, error) {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	if strings.Join(header, ",") != "Name,Code,Num,Scale" {
+		return nil, fmt.Errorf("header %q must be \"Name,Code,Num,Scale\"", strings.Join(header, ","))
+	}
+	recs, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	currs := make([]Currency, 0, len(recs))
+	for i, rec := range recs {
+		scale, err := strconv.Atoi(rec[3])
+		if err != nil {
+			return nil, fmt.Errorf("row %v: invalid scale %q", i+2, rec[3])
+		}
+		currs = append(currs, Currency{
+			Name:  rec[0],
+			Code:  rec[1],
+			Num:   rec[2],
+			Scale: scale,
+		})
+	}
+	if err := validate(currs); err != nil {
+		return nil, err
+	}
+	return currs, nil
+}
+
+// Validate checks that the currencies can be registered in package money.
+// It does not check for conflicts with the built-in currencies, which
+// are reported by [money.LoadCurrencyData].
+//
+// Validate returns an error if:
+//   - the number of currencies is greater than [MaxCurrencies];
+//   - any name is empty;
+//   - any code does not consist of 3 uppercase letters;
+//   - any numeric code is neither empty nor consists of 3 digits;
+//   - any scale is negative or greater than [MaxScale];
+//   - any code or numeric code is duplicated.
+func Validate(currs []Currency) error {
+	if err := validate(currs); err != nil {
+		return fmt.Errorf("validating currency data: %w", err)
+	}
+	return nil
+}
+
+func validate(currs []Currency) error {
+	if len(currs) > MaxCurrencies {
+		return fmt.Errorf("number of currencies %v is greater than %v", len(currs), MaxCurrencies)
+	}
+	codes := make(map[string]bool, len(currs))
+	nums := make(map[string]bool, len(currs))
+	for _, c := range currs {
+		if c.Name == "" {
+			return fmt.Errorf("currency %q has no name", c.Code)
+		}
+		if !isCode(c.Code, 'A', 'Z') {
+			return fmt.Errorf("code %q must consist of 3 uppercase letters", c.Code)
+		}
+		if c.Num != "" && !isCode(c.Num, '0', '9') {
+			return fmt.Errorf("numeric code %q of %v must consist of 3 digits", c.Num, c.Code)
+		}
+		if c.Scale < 0 || c.Scale > MaxScale {
+			return fmt.Errorf("scale %v of %v is out of range [0, %v]", c.Scale, c.Code, MaxScale)
+		}
+		if codes[c.Code] {
+			return fmt.Errorf("code %v is duplicated", c.Code)
+		}
+		if c.Num != "" && nums[c.Num] {
+			return fmt.Errorf("numeric code %v of %v is duplicated", c.Num, c.Code)
+		}
+		codes[c.Code] = true
+		nums[c.Num] = true
+	}
+	return nil
+}
+
+// isCode returns true if s consists of exactly 3 characters from
+// the range [lo, hi].
+func isCode(s string, lo, hi byte) bool {
+	if len(s) != 3 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < lo || s[i] > hi {
+			return false
+		}
+	}
+	return true
+}
+
+// WriteCSV writes the currencies in the format read by [ReadCSV] and
+// [money.LoadCurrencyData].
+func WriteCSV(w io.Writer, currs []Currency) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"Name", "Code", "Num", "Scale"}); err != nil {
+		return fmt.Errorf("writing currency data: %w", err)
+	}
+	for _, c := range currs {
+		if err := cw.Write([]string{c.Name, c.Code, c.Num, strconv.Itoa(c.Scale)}); err != nil {
+			return fmt.Errorf("writing currency data: %w", err)
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("writing currency data: %w", err)
+	}
+	return nil
+}
+
+// Generate validates the currencies and returns the formatted Go source
+// of a file in package pkg, which registers the currencies using
+// [money.LoadCurrencyData] when the package is initialized.
+// The program panics at initialization if the currencies conflict with
+// the built-in ones, for example, if a numeric code is already assigned to
+// another currency.
+//
+// Generate returns an error if:
+//   - the package name is not a valid Go identifier;
+//   - the currencies are not valid, see [Validate].
+func Generate(currs []Currency, pkg string) ([]byte, error) {
+	src, err := generate(currs, pkg)
+	if err != nil {
+		return nil, fmt.Errorf("generating currency data: %w", err)
+	}
+	return src, nil
+}
+
+func generate(currs []Currency, pkg string) ([]byte, error) {
+	if !token.IsIdentifier(pkg) {
+		return nil, fmt.Errorf("invalid package name %q", pkg)
+	}
+	if err := validate(currs); err != nil {
+		return nil, err
+	}
+	var data bytes.Buffer
+	if err := WriteCSV(&data, currs); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by moneygen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %v\n\n", pkg)
+	buf.WriteString("import (\n\"strings\"\n\n\"github.com/govalues/money\"\n)\n\n")
+	buf.WriteString("// currencyData is registered by init using money.LoadCurrencyData.\n")
+	buf.WriteString("const currencyData = \"\" +\n")
+	lines := strings.SplitAfter(data.String(), "\n")
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+		buf.WriteString(strconv.Quote(line))
+		if i < len(lines)-2 {
+			buf.WriteString(" +")
+		}
+		buf.WriteString("\n")
+	}
+	buf.WriteString("\nfunc init() {\n")
+	buf.WriteString("if err := money.LoadCurrencyData(strings.NewReader(currencyData)); err != nil {\n")
+	buf.WriteString("panic(err)\n}\n}\n")
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,165 @@
+package moneygen
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/govalues/money"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		currs := []Currency{
+			{"Internal Token", "ZZT", "899", 6},
+			{"Loyalty Points", "ZZP", "", 0},
+			{"Quoted \"Name\", Inc.", "ZZQ", "", 3},
+		}
+		got, err := Generate(currs, "billing")
+		if err != nil {
+			t.Fatalf("Generate() failed: %v", err)
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), "currency_data.go", got, 0)
+		if err != nil {
+			t.Fatalf("parser.ParseFile() failed: %v\n%s", err, got)
+		}
+		if f.Name.Name != "billing" {
+			t.Errorf("Generate() package = %q, want %q", f.Name.Name, "billing")
+		}
+
+		// The embedded data must be accepted by money.LoadCurrencyData.
+		var data bytes.Buffer
+		if err := WriteCSV(&data, currs); err != nil {
+			t.Fatalf("WriteCSV() failed: %v", err)
+		}
+		for _, line := range strings.SplitAfter(data.String(), "\n") {
+			if line != "" && !bytes.Contains(got, []byte(strconv.Quote(line))) {
+				t.Errorf("Generate() output does not contain %q", line)
+			}
+		}
+		if err := money.LoadCurrencyData(&data); err != nil {
+			t.Fatalf("money.LoadCurrencyData() failed: %v", err)
+		}
+		for _, c := range currs {
+			curr, err := money.ParseCurr(c.Code)
+			if err != nil {
+				t.Errorf("money.ParseCurr(%q) failed: %v", c.Code, err)
+				continue
+			}
+			if curr.Scale() != c.Scale {
+				t.Errorf("%v.Scale() = %v, want %v", curr, curr.Scale(), c.Scale)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		currs := []Currency{{"Internal Token", "ZZT", "899", 6}}
+		tests := map[string]struct {
+			currs []Currency
+			pkg   string
+		}{
+			"package 1":  {currs, ""},
+			"package 2":  {currs, "my-money"},
+			"package 3":  {currs, "1money"},
+			"currency 1": {[]Currency{{"Internal Token", "zzt", "899", 6}}, "billing"},
+		}
+		for name, tt := range tests {
+			_, err := Generate(tt.currs, tt.pkg)
+			if err == nil {
+				t.Errorf("Generate(%v, %q) did not fail: %v", tt.currs, tt.pkg, name)
+			}
+		}
+	})
+}
+
+func TestReadCSV(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		data := "Name,Code,Num,Scale\n" +
+			"US Dollar,USD,840,2\n" +
+			"Internal Token,ZZT,899,6\n" +
+			"Loyalty Points,ZZP,,0\n"
+		got, err := ReadCSV(strings.NewReader(data))
+		if err != nil {
+			t.Fatalf("ReadCSV() failed: %v", err)
+		}
+		want := []Currency{
+			{"US Dollar", "USD", "840", 2},
+			{"Internal Token", "ZZT", "899", 6},
+			{"Loyalty Points", "ZZP", "", 0},
+		}
+		if len(got) != len(want) {
+			t.Fatalf("ReadCSV() = %v, want %v", got, want)
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Errorf("ReadCSV()[%v] = %v, want %v", i, got[i], want[i])
+			}
+		}
+	})
+
+	t.Run("builtin", func(t *testing.T) {
+		in, err := os.Open(filepath.Join("..", "scripts", "currency", "currency_data.csv"))
+		if err != nil {
+			t.Fatalf("os.Open() failed: %v", err)
+		}
+		defer func() { _ = in.Close() }()
+		currs, err := ReadCSV(in)
+		if err != nil {
+			t.Fatalf("ReadCSV() failed: %v", err)
+		}
+		for _, c := range currs {
+			curr, err := money.ParseCurr(c.Code)
+			if err != nil {
+				t.Errorf("money.ParseCurr(%q) failed: %v", c.Code, err)
+				continue
+			}
+			if curr.Scale() != c.Scale {
+				t.Errorf("%v.Scale() = %v, want %v", curr, curr.Scale(), c.Scale)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		const base = "US Dollar,USD,840,2\n"
+		tests := map[string]string{
+			"empty 1":     "",
+			"header 1":    "Code,Name,Num,Scale\n" + base,
+			"fields 1":    "Name,Code,Num,Scale\n" + base + "Euro,EUR,978\n",
+			"scale 1":     "Name,Code,Num,Scale\n" + base + "Euro,EUR,978,two\n",
+			"scale 2":     "Name,Code,Num,Scale\n" + base + "Euro,EUR,978,-1\n",
+			"scale 3":     "Name,Code,Num,Scale\n" + base + "Euro,EUR,978,20\n",
+			"code 1":      "Name,Code,Num,Scale\n" + base + "Euro,eur,978,2\n",
+			"code 2":      "Name,Code,Num,Scale\n" + base + "Euro,EURO,978,2\n",
+			"num 1":       "Name,Code,Num,Scale\n" + base + "Euro,EUR,97,2\n",
+			"num 2":       "Name,Code,Num,Scale\n" + base + "Euro,EUR,97A,2\n",
+			"name 1":      "Name,Code,Num,Scale\n" + base + ",EUR,978,2\n",
+			"duplicate 1": "Name,Code,Num,Scale\n" + base + "US Dollar,USD,841,2\n",
+			"duplicate 2": "Name,Code,Num,Scale\n" + base + "Euro,EUR,840,2\n",
+		}
+		for name, data := range tests {
+			_, err := ReadCSV(strings.NewReader(data))
+			if err == nil {
+				t.Errorf("ReadCSV(%q) did not fail: %v", data, name)
+			}
+		}
+	})
+}
+
+func TestValidate(t *testing.T) {
+	currs := make([]Currency, 0, MaxCurrencies+1)
+	for i := 0; len(currs) <= MaxCurrencies; i++ {
+		code := string([]byte{'A' + byte(i/26%26), 'A' + byte(i%26), 'Q'})
+		currs = append(currs, Currency{"Currency " + code, code, "", 2})
+	}
+	if err := Validate(currs[:MaxCurrencies]); err != nil {
+		t.Errorf("Validate() failed: %v", err)
+	}
+	if err := Validate(currs); err == nil {
+		t.Errorf("Validate() did not fail for %v currencies", len(currs))
+	}
+}
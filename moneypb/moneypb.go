@@ -0,0 +1,224 @@
+/*
+Package moneypb maps amounts to and from protocol buffer messages.
+
+It provides two messages:
+
+  - [Money] mirrors [google.type.Money], which is widely used in public APIs
+    but supports at most 9 digits after the decimal point and does not
+    preserve trailing zeros.
+  - [Amount] holds the currency code and the decimal string, which preserves
+    the scale of the amount exactly.
+
+Both messages implement the Marshal, MarshalTo, Unmarshal, and Size methods
+used by gogo/protobuf custom types and vtproto, and produce the standard
+protocol buffer wire format, so they can be embedded into generated code
+without depending on any protobuf runtime.
+
+[google.type.Money]: https://github.com/googleapis/googleapis/blob/master/google/type/money.proto
+*/
+package moneypb
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/govalues/money"
+)
+
+var errTruncated = errors.New("data is truncated")
+
+// Money mirrors the google.type.Money message:
+//
+//	message Money {
+//	  string currency_code = 1;
+//	  int64 units = 2;
+//	  int32 nanos = 3;
+//	}
+type Money struct {
+	CurrencyCode string
+	Units        int64
+	Nanos        int32
+}
+
+// NewMoney converts an amount to a google.type.Money message.
+// See also method [Money.Amount].
+//
+// NewMoney returns an error if the amount has more than 9 digits after
+// the decimal point, ignoring trailing zeros.
+func NewMoney(a money.Amount) (*Money, error) {
+	if a.MinScale() > 9 {
+		return nil, fmt.Errorf("converting %v: too many digits after the decimal point", a)
+	}
+	units, nanos, ok := a.Int64(9)
+	if !ok {
+		return nil, fmt.Errorf("converting %v: amount overflow", a)
+	}
+	return &Money{
+		CurrencyCode: a.Curr().Code(),
+		Units:        units,
+		Nanos:        int32(nanos),
+	}, nil
+}
+
+// Amount converts the message to an amount.
+// Trailing zeros are removed up to the scale of the currency.
+// See also constructor [money.NewAmountFromInt64].
+//
+// Amount returns an error if:
+//   - the currency code is not valid;
+//   - the units and the nanos have different signs;
+//   - the nanos are not within the range (-1e9, 1e9).
+func (m *Money) Amount() (money.Amount, error) {
+	return money.NewAmountFromInt64(m.CurrencyCode, m.Units, int64(m.Nanos), 9)
+}
+
+// Size returns the size of the message in the wire format.
+func (m *Money) Size() int {
+	n := 0
+	if m.CurrencyCode != "" {
+		n += 1 + sizeVarint(uint64(len(m.CurrencyCode))) + len(m.CurrencyCode)
+	}
+	if m.Units != 0 {
+		n += 1 + sizeVarint(uint64(m.Units))
+	}
+	if m.Nanos != 0 {
+		n += 1 + sizeVarint(uint64(int64(m.Nanos)))
+	}
+	return n
+}
+
+// Marshal encodes the message in the wire format.
+func (m *Money) Marshal() ([]byte, error) {
+	data := make([]byte, m.Size())
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+// MarshalTo encodes the message in the wire format into data,
+// which must be at least [Money.Size] bytes long, and returns
+// the number of bytes written.
+func (m *Money) MarshalTo(data []byte) (int, error) {
+	if len(data) < m.Size() {
+		return 0, fmt.Errorf("marshaling money: buffer is too small")
+	}
+	buf := data[:0]
+	if m.CurrencyCode != "" {
+		buf = appendString(buf, 1, m.CurrencyCode)
+	}
+	if m.Units != 0 {
+		buf = appendVarintField(buf, 2, uint64(m.Units))
+	}
+	if m.Nanos != 0 {
+		buf = appendVarintField(buf, 3, uint64(int64(m.Nanos)))
+	}
+	return len(buf), nil
+}
+
+// Unmarshal decodes the message from the wire format.
+// Unknown fields are skipped.
+func (m *Money) Unmarshal(data []byte) error {
+	*m = Money{}
+	err := parseFields(data, func(num, typ int, v uint64, b []byte) {
+		switch {
+		case num == 1 && typ == wireBytes:
+			m.CurrencyCode = string(b)
+		case num == 2 && typ == wireVarint:
+			m.Units = int64(v)
+		case num == 3 && typ == wireVarint:
+			m.Nanos = int32(v)
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("unmarshaling money: %w", err)
+	}
+	return nil
+}
+
+// Amount represents an amount as a pair of strings, which preserves
+// the scale of the amount exactly:
+//
+//	message Amount {
+//	  string currency = 1;
+//	  string value = 2;
+//	}
+type Amount struct {
+	Currency string
+	Value    string
+}
+
+// NewAmount converts an amount to an Amount message.
+// See also method [Amount.Amount].
+func NewAmount(a money.Amount) *Amount {
+	return &Amount{
+		Currency: a.Curr().Code(),
+		Value:    a.Decimal().String(),
+	}
+}
+
+// Amount converts the message to an amount with the same scale.
+// See also constructor [money.ParseAmount].
+//
+// Amount returns an error if the currency code or the value is not valid.
+func (m *Amount) Amount() (money.Amount, error) {
+	return money.ParseAmount(m.Currency, m.Value)
+}
+
+// Size returns the size of the message in the wire format.
+func (m *Amount) Size() int {
+	n := 0
+	if m.Currency != "" {
+		n += 1 + sizeVarint(uint64(len(m.Currency))) + len(m.Currency)
+	}
+	if m.Value != "" {
+		n += 1 + sizeVarint(uint64(len(m.Value))) + len(m.Value)
+	}
+	return n
+}
+
+// Marshal encodes the message in the wire format.
+func (m *Amount) Marshal() ([]byte, error) {
+	data := make([]byte, m.Size())
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+// MarshalTo encodes the message in the wire format into data,
+// which must be at least [Amount.Size] bytes long, and returns
+// the number of bytes written.
+func (m *Amount) MarshalTo(data []byte) (int, error) {
+	if len(data) < m.Size() {
+		return 0, fmt.Errorf("marshaling amount: buffer is too small")
+	}
+	buf := data[:0]
+	if m.Currency != "" {
+		buf = appendString(buf, 1, m.Currency)
+	}
+	if m.Value != "" {
+		buf = appendString(buf, 2, m.Value)
+	}
+	return len(buf), nil
+}
+
+// Unmarshal decodes the message from the wire format.
+// Unknown fields are skipped.
+func (m *Amount) Unmarshal(data []byte) error {
+	*m = Amount{}
+	err := parseFields(data, func(num, typ int, _ uint64, b []byte) {
+		switch {
+		case num == 1 && typ == wireBytes:
+			m.Currency = string(b)
+		case num == 2 && typ == wireBytes:
+			m.Value = string(b)
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("unmarshaling amount: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package moneypb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/govalues/money"
+)
+
+func TestNewMoney(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, amount string
+			want         Money
+		}{
+			{"USD", "0", Money{"USD", 0, 0}},
+			{"USD", "5.67", Money{"USD", 5, 670000000}},
+			{"USD", "-5.67", Money{"USD", -5, -670000000}},
+			{"USD", "-0.01", Money{"USD", 0, -10000000}},
+			{"JPY", "100", Money{"JPY", 100, 0}},
+			{"USD", "0.000000001", Money{"USD", 0, 1}},
+			{"USD", "1.0000000000", Money{"USD", 1, 0}},
+		}
+		for _, tt := range tests {
+			a := money.MustParseAmount(tt.curr, tt.amount)
+			got, err := NewMoney(a)
+			if err != nil {
+				t.Errorf("NewMoney(%v) failed: %v", a, err)
+				continue
+			}
+			if *got != tt.want {
+				t.Errorf("NewMoney(%v) = %v, want %v", a, *got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		a := money.MustParseAmount("USD", "0.0000000001")
+		_, err := NewMoney(a)
+		if err == nil {
+			t.Errorf("NewMoney(%v) did not fail", a)
+		}
+	})
+}
+
+func TestMoney_Amount(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m    Money
+			want string
+		}{
+			{Money{"USD", 0, 0}, "USD 0.00"},
+			{Money{"USD", 5, 670000000}, "USD 5.67"},
+			{Money{"USD", -5, -670000000}, "USD -5.67"},
+			{Money{"USD", 0, 1}, "USD 0.000000001"},
+			{Money{"JPY", 100, 0}, "JPY 100"},
+		}
+		for _, tt := range tests {
+			got, err := tt.m.Amount()
+			if err != nil {
+				t.Errorf("%v.Amount() failed: %v", tt.m, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("%v.Amount() = %q, want %q", tt.m, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []Money{
+			{"UUU", 1, 0},
+			{"USD", 1, -1},
+			{"USD", -1, 1},
+			{"USD", 0, 1000000000},
+		}
+		for _, m := range tests {
+			_, err := m.Amount()
+			if err == nil {
+				t.Errorf("%v.Amount() did not fail", m)
+			}
+		}
+	})
+}
+
+func TestMoney_Marshal(t *testing.T) {
+	tests := []struct {
+		m    Money
+		want string
+	}{
+		{Money{}, ""},
+		{Money{"USD", 5, 670000000}, "\x0a\x03USD\x10\x05\x18\x80\xc7\xbd\xbf\x02"},
+		{Money{"USD", -1, 0}, "\x0a\x03USD\x10\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01"},
+		{Money{"USD", 0, -1}, "\x0a\x03USD\x18\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01"},
+	}
+	for _, tt := range tests {
+		got, err := tt.m.Marshal()
+		if err != nil {
+			t.Errorf("%v.Marshal() failed: %v", tt.m, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("%v.Marshal() = %q, want %q", tt.m, got, tt.want)
+		}
+		if len(got) != tt.m.Size() {
+			t.Errorf("%v.Size() = %v, want %v", tt.m, tt.m.Size(), len(got))
+		}
+		var m Money
+		err = m.Unmarshal(got)
+		if err != nil {
+			t.Errorf("Unmarshal(%q) failed: %v", got, err)
+			continue
+		}
+		if m != tt.m {
+			t.Errorf("Unmarshal(%q) = %v, want %v", got, m, tt.m)
+		}
+	}
+}
+
+func TestMoney_Unmarshal(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			data string
+			want Money
+		}{
+			// Unknown fields
+			{"\x0a\x03USD\x20\x01\x29\x00\x00\x00\x00\x00\x00\x00\x00\x35\x00\x00\x00\x00\x3a\x01x\x10\x05", Money{"USD", 5, 0}},
+			// Reordered fields
+			{"\x10\x05\x0a\x03USD", Money{"USD", 5, 0}},
+		}
+		for _, tt := range tests {
+			var got Money
+			err := got.Unmarshal([]byte(tt.data))
+			if err != nil {
+				t.Errorf("Unmarshal(%q) failed: %v", tt.data, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("Unmarshal(%q) = %v, want %v", tt.data, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			"\x0a",
+			"\x0a\x03US",
+			"\x10",
+			"\x10\xff",
+			"\x29\x00",
+			"\x35\x00",
+			"\x0b",
+			"\x00\x01",
+			"\x10\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01",
+		}
+		for _, data := range tests {
+			var got Money
+			err := got.Unmarshal([]byte(data))
+			if err == nil {
+				t.Errorf("Unmarshal(%q) did not fail", data)
+			}
+		}
+	})
+}
+
+func TestAmount(t *testing.T) {
+	tests := []struct {
+		curr, amount string
+		want         string
+	}{
+		{"USD", "5.67", "\x0a\x03USD\x12\x045.67"},
+		{"USD", "5.670", "\x0a\x03USD\x12\x055.670"},
+		{"JPY", "-1", "\x0a\x03JPY\x12\x02-1"},
+	}
+	for _, tt := range tests {
+		a := money.MustParseAmount(tt.curr, tt.amount)
+		m := NewAmount(a)
+		got, err := m.Marshal()
+		if err != nil {
+			t.Errorf("%v.Marshal() failed: %v", m, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("%v.Marshal() = %q, want %q", m, got, tt.want)
+		}
+		if len(got) != m.Size() {
+			t.Errorf("%v.Size() = %v, want %v", m, m.Size(), len(got))
+		}
+		var n Amount
+		err = n.Unmarshal(got)
+		if err != nil {
+			t.Errorf("Unmarshal(%q) failed: %v", got, err)
+			continue
+		}
+		b, err := n.Amount()
+		if err != nil {
+			t.Errorf("%v.Amount() failed: %v", n, err)
+			continue
+		}
+		if b != a {
+			t.Errorf("%v.Amount() = %q, want %q", n, b, a)
+		}
+	}
+
+	// MarshalTo
+	m := NewAmount(money.MustParseAmount("USD", "5.67"))
+	buf := make([]byte, m.Size()+2)
+	n, err := m.MarshalTo(buf)
+	if err != nil {
+		t.Fatalf("%v.MarshalTo() failed: %v", m, err)
+	}
+	if want, _ := m.Marshal(); !bytes.Equal(buf[:n], want) {
+		t.Errorf("%v.MarshalTo() = %q, want %q", m, buf[:n], want)
+	}
+	if _, err := m.MarshalTo(buf[:m.Size()-1]); err == nil {
+		t.Errorf("%v.MarshalTo() did not fail with a short buffer", m)
+	}
+
+	// Invalid value
+	if _, err := (&Amount{"USD", "abc"}).Amount(); err == nil {
+		t.Errorf("Amount.Amount() did not fail")
+	}
+}
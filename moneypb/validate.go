@@ -0,0 +1,89 @@
+package moneypb
+
+import "github.com/govalues/money"
+
+// FieldViolation describes why a field of a message is invalid.
+// It mirrors the google.rpc.BadRequest.FieldViolation message, so that
+// violations can be returned to clients as gRPC status details.
+type FieldViolation struct {
+	Field       string // name of the field, for example, "currency_code"
+	Description string // human-readable reason
+}
+
+// Validate checks the message against the semantic constraints of
+// [AIP-143] and [ISO 4217] before it is accepted by an API, and returns
+// the field-level violations found.
+// It reports the following violations:
+//   - the currency code does not consist of three uppercase letters;
+//   - the currency code is not known, see [money.ParseCurr];
+//   - the nanos are not within the range [-999999999, 999999999];
+//   - the units and the nanos have different signs;
+//   - the units and the nanos do not fit into an amount with the scale of
+//     the currency, see [money.NewAmountFromInt64].
+//
+// If the message is valid, Validate returns nil, and [Money.Amount] does not
+// fail.
+//
+// [AIP-143]: https://google.aip.dev/143
+// [ISO 4217]: https://en.wikipedia.org/wiki/ISO_4217
+func (m *Money) Validate() []FieldViolation {
+	var vs []FieldViolation
+
+	// Currency code
+	switch {
+	case !isAlphaCode(m.CurrencyCode):
+		vs = append(vs, FieldViolation{
+			Field:       "currency_code",
+			Description: "must consist of three uppercase letters",
+		})
+	default:
+		if _, err := money.ParseCurr(m.CurrencyCode); err != nil {
+			vs = append(vs, FieldViolation{
+				Field:       "currency_code",
+				Description: "unknown currency " + m.CurrencyCode,
+			})
+		}
+	}
+
+	// Nanos
+	if m.Nanos <= -1e9 || m.Nanos >= 1e9 {
+		vs = append(vs, FieldViolation{
+			Field:       "nanos",
+			Description: "must be within the range [-999999999, 999999999]",
+		})
+	}
+
+	// Sign
+	if (m.Units > 0 && m.Nanos < 0) || (m.Units < 0 && m.Nanos > 0) {
+		vs = append(vs, FieldViolation{
+			Field:       "nanos",
+			Description: "must have the same sign as units",
+		})
+	}
+
+	// Precision
+	if vs == nil {
+		if _, err := m.Amount(); err != nil {
+			vs = append(vs, FieldViolation{
+				Field:       "units",
+				Description: "too large for the scale of " + m.CurrencyCode,
+			})
+		}
+	}
+
+	return vs
+}
+
+// isAlphaCode returns true if the string consists of three uppercase
+// ASCII letters.
+func isAlphaCode(s string) bool {
+	if len(s) != 3 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'A' || s[i] > 'Z' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,47 @@
+package moneypb
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMoney_Validate(t *testing.T) {
+	tests := []struct {
+		m    Money
+		want []FieldViolation
+	}{
+		{Money{"USD", 5, 670000000}, nil},
+		{Money{"USD", -5, -670000000}, nil},
+		{Money{"USD", 0, -10000000}, nil},
+		{Money{"JPY", 100, 0}, nil},
+		{Money{"USD", 0, 999999999}, nil},
+		{Money{"usd", 1, 0}, []FieldViolation{{"currency_code", "must consist of three uppercase letters"}}},
+		{Money{"840", 1, 0}, []FieldViolation{{"currency_code", "must consist of three uppercase letters"}}},
+		{Money{"", 1, 0}, []FieldViolation{{"currency_code", "must consist of three uppercase letters"}}},
+		{Money{"ZZZ", 1, 0}, []FieldViolation{{"currency_code", "unknown currency ZZZ"}}},
+		{Money{"USD", 0, 1000000000}, []FieldViolation{{"nanos", "must be within the range [-999999999, 999999999]"}}},
+		{Money{"USD", 0, -1000000000}, []FieldViolation{{"nanos", "must be within the range [-999999999, 999999999]"}}},
+		{Money{"USD", 1, -1}, []FieldViolation{{"nanos", "must have the same sign as units"}}},
+		{Money{"USD", -1, 1}, []FieldViolation{{"nanos", "must have the same sign as units"}}},
+		{Money{"USD", 99999999999999999, 990000000}, nil},
+		{Money{"USD", 1e18, 5}, []FieldViolation{{"units", "too large for the scale of USD"}}},
+		{Money{"USD", 1e17, 0}, []FieldViolation{{"units", "too large for the scale of USD"}}},
+		{Money{"OMR", -1e17, 0}, []FieldViolation{{"units", "too large for the scale of OMR"}}},
+		{Money{"Usd", -1, 1000000000}, []FieldViolation{
+			{"currency_code", "must consist of three uppercase letters"},
+			{"nanos", "must be within the range [-999999999, 999999999]"},
+			{"nanos", "must have the same sign as units"},
+		}},
+	}
+	for _, tt := range tests {
+		got := tt.m.Validate()
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%+v.Validate() = %v, want %v", tt.m, got, tt.want)
+		}
+		if got == nil {
+			if _, err := tt.m.Amount(); err != nil {
+				t.Errorf("%+v.Amount() failed after successful validation: %v", tt.m, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package moneypb
+
+import (
+	"fmt"
+)
+
+// Wire types of the protocol buffer encoding.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// sizeVarint returns the number of bytes needed to encode v as a varint.
+func sizeVarint(v uint64) int {
+	n := 1
+	for v >= 0x80 {
+		v >>= 7
+		n++
+	}
+	return n
+}
+
+// appendVarint appends v encoded as a varint.
+func appendVarint(buf []byte, v uint64) []byte {
+	for v >= 0x80 {
+		buf = append(buf, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(buf, byte(v))
+}
+
+// appendVarintField appends a varint field with the specified number.
+func appendVarintField(buf []byte, num int, v uint64) []byte {
+	buf = appendVarint(buf, uint64(num)<<3|wireVarint)
+	return appendVarint(buf, v)
+}
+
+// appendString appends a length-delimited field with the specified number.
+func appendString(buf []byte, num int, s string) []byte {
+	buf = appendVarint(buf, uint64(num)<<3|wireBytes)
+	buf = appendVarint(buf, uint64(len(s)))
+	return append(buf, s...)
+}
+
+// consumeVarint decodes a varint and returns its value and length.
+func consumeVarint(data []byte) (uint64, int, error) {
+	var v uint64
+	for i := 0; i < len(data) && i < 10; i++ {
+		b := data[i]
+		v |= uint64(b&0x7f) << (7 * i)
+		if b < 0x80 {
+			return v, i + 1, nil
+		}
+	}
+	if len(data) < 10 {
+		return 0, 0, errTruncated
+	}
+	return 0, 0, fmt.Errorf("varint overflow")
+}
+
+// parseFields calls f for each field of the message.
+// For varint fields, v holds the value; for length-delimited fields,
+// b holds the payload.
+// Fixed-size fields are skipped.
+func parseFields(data []byte, f func(num, typ int, v uint64, b []byte)) error {
+	for len(data) > 0 {
+		tag, n, err := consumeVarint(data)
+		if err != nil {
+			return err
+		}
+		data = data[n:]
+		num, typ := int(tag>>3), int(tag&7)
+		if num <= 0 {
+			return fmt.Errorf("invalid field number %v", num)
+		}
+		var v uint64
+		var b []byte
+		switch typ {
+		case wireVarint:
+			v, n, err = consumeVarint(data)
+			if err != nil {
+				return err
+			}
+		case wireFixed64:
+			n = 8
+		case wireFixed32:
+			n = 4
+		case wireBytes:
+			v, n, err = consumeVarint(data)
+			if err != nil {
+				return err
+			}
+			if v > uint64(len(data)-n) {
+				return errTruncated
+			}
+			b = data[n : n+int(v)]
+			n += int(v)
+		default:
+			return fmt.Errorf("unsupported wire type %v", typ)
+		}
+		if n > len(data) {
+			return errTruncated
+		}
+		data = data[n:]
+		f(num, typ, v, b)
+	}
+	return nil
+}
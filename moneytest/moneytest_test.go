@@ -0,0 +1,93 @@
+package moneytest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/govalues/decimal"
+	"github.com/govalues/money"
+)
+
+func TestRandomAmount(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr     money.Currency
+			min, max string
+			wantMin  string
+			wantMax  string
+			wantN    int
+		}{
+			{money.USD, "0", "0.03", "0.00", "0.03", 4},
+			{money.USD, "0.001", "0.029", "0.01", "0.02", 2},
+			{money.USD, "-0.01", "0.01", "-0.01", "0.01", 3},
+			{money.USD, "5.67", "5.67", "5.67", "5.67", 1},
+			{money.JPY, "-2", "2", "-2", "2", 5},
+			{money.OMR, "0.0005", "0.0025", "0.001", "0.002", 2},
+		}
+		for _, tt := range tests {
+			rng := rand.New(rand.NewSource(1))
+			min, max := decimal.MustParse(tt.min), decimal.MustParse(tt.max)
+			wantMin := money.MustParseAmount(tt.curr.Code(), tt.wantMin)
+			wantMax := money.MustParseAmount(tt.curr.Code(), tt.wantMax)
+			seen := make(map[money.Amount]int)
+			for i := 0; i < 1000; i++ {
+				got, err := RandomAmount(rng, tt.curr, min, max)
+				if err != nil {
+					t.Fatalf("RandomAmount(%v, %v, %v) failed: %v", tt.curr, min, max, err)
+				}
+				if !got.SameScaleAsCurr() {
+					t.Errorf("RandomAmount(%v, %v, %v) = %q, want scale %v", tt.curr, min, max, got, tt.curr.Scale())
+				}
+				if c, _ := got.Cmp(wantMin); c < 0 {
+					t.Errorf("RandomAmount(%v, %v, %v) = %q, want >= %q", tt.curr, min, max, got, wantMin)
+				}
+				if c, _ := got.Cmp(wantMax); c > 0 {
+					t.Errorf("RandomAmount(%v, %v, %v) = %q, want <= %q", tt.curr, min, max, got, wantMax)
+				}
+				seen[got]++
+			}
+			if len(seen) != tt.wantN {
+				t.Errorf("RandomAmount(%v, %v, %v) produced %v distinct amounts, want %v", tt.curr, min, max, len(seen), tt.wantN)
+			}
+		}
+	})
+
+	t.Run("deterministic", func(t *testing.T) {
+		min, max := decimal.MustParse("0"), decimal.MustParse("1000000")
+		rng1 := rand.New(rand.NewSource(42))
+		rng2 := rand.New(rand.NewSource(42))
+		for i := 0; i < 100; i++ {
+			a, err := RandomAmount(rng1, money.EUR, min, max)
+			if err != nil {
+				t.Fatalf("RandomAmount() failed: %v", err)
+			}
+			b, err := RandomAmount(rng2, money.EUR, min, max)
+			if err != nil {
+				t.Fatalf("RandomAmount() failed: %v", err)
+			}
+			if a != b {
+				t.Errorf("RandomAmount() = %q and %q for the same seed", a, b)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr     money.Currency
+			min, max string
+		}{
+			"range 1":    {money.USD, "1", "0"},
+			"range 2":    {money.USD, "0.001", "0.009"},
+			"range 3":    {money.JPY, "0.1", "0.9"},
+			"overflow 1": {money.USD, "0", "99999999999999999"},
+		}
+		for name, tt := range tests {
+			rng := rand.New(rand.NewSource(1))
+			min, max := decimal.MustParse(tt.min), decimal.MustParse(tt.max)
+			_, err := RandomAmount(rng, tt.curr, min, max)
+			if err == nil {
+				t.Errorf("RandomAmount(%v, %v, %v) did not fail: %v", tt.curr, min, max, name)
+			}
+		}
+	})
+}
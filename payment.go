@@ -0,0 +1,133 @@
+package money
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ApplicationMode specifies whether a payment can settle an open item
+// partially.
+// See also function [ApplyPayment].
+type ApplicationMode int8
+
+const (
+	// ApplyPartial settles open items in order until the payment is used up,
+	// so the last settled item may be settled partially.
+	ApplyPartial ApplicationMode = iota
+	// ApplyFullOnly settles only open items that the remaining payment covers
+	// in full and skips the others.
+	ApplyFullOnly
+)
+
+// String implements the [fmt.Stringer] interface and returns
+// a string representation of the application mode.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (m ApplicationMode) String() string {
+	switch m {
+	case ApplyPartial:
+		return "partial"
+	case ApplyFullOnly:
+		return "full only"
+	default:
+		return fmt.Sprintf("ApplicationMode(%d)", int8(m))
+	}
+}
+
+// OpenItem represents an outstanding receivable, such as an unpaid invoice,
+// that a payment can be applied to.
+// See also function [ApplyPayment].
+type OpenItem struct {
+	// Due is the date the item is due.
+	Due time.Time
+	// Priority orders items before their due dates, lower values first.
+	Priority int
+	// Outstanding is the unpaid amount of the item.
+	Outstanding Amount
+}
+
+// ApplyPayment applies the payment to the open items and returns the amounts
+// applied to each item, in the same order as the items, and the remaining
+// credit.
+// The items are settled in order of priority, then due date, then their
+// position in the slice, and the mode specifies whether the last item can
+// be settled partially.
+// All amounts are at the scale of the currency, and the applied amounts
+// and the credit always sum up exactly to the payment.
+//
+// ApplyPayment returns an error if:
+//   - the mode is not valid;
+//   - the payment or any outstanding amount is negative;
+//   - the payment and the outstanding amounts are denominated in
+//     different currencies;
+//   - the payment or any outstanding amount has more digits after the decimal
+//     point than the currency.
+func ApplyPayment(payment Amount, items []OpenItem, mode ApplicationMode) (applied []Amount, credit Amount, err error) {
+	applied, credit, err = applyPayment(payment, items, mode)
+	if err != nil {
+		return nil, Amount{}, fmt.Errorf("applying payment %v in %v mode: %w", payment, mode, err)
+	}
+	return applied, credit, nil
+}
+
+func applyPayment(payment Amount, items []OpenItem, mode ApplicationMode) (applied []Amount, credit Amount, err error) {
+	if mode != ApplyPartial && mode != ApplyFullOnly {
+		return nil, Amount{}, fmt.Errorf("invalid application mode %v", mode)
+	}
+	if err := checkPaymentAmount(payment, payment); err != nil {
+		return nil, Amount{}, err
+	}
+	for _, it := range items {
+		if err := checkPaymentAmount(payment, it.Outstanding); err != nil {
+			return nil, Amount{}, fmt.Errorf("outstanding amount %v: %w", it.Outstanding, err)
+		}
+	}
+
+	// Ordering
+	order := make([]int, len(items))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := items[order[i]], items[order[j]]
+		if a.Priority != b.Priority {
+			return a.Priority < b.Priority
+		}
+		return a.Due.Before(b.Due)
+	})
+
+	// Application
+	credit = payment.TrimToCurr()
+	zero := credit.Zero()
+	applied = make([]Amount, len(items))
+	for _, i := range order {
+		out := items[i].Outstanding.TrimToCurr()
+		applied[i] = zero
+		switch c := credit.Decimal().Cmp(out.Decimal()); {
+		case c >= 0:
+			applied[i] = out
+		case mode == ApplyPartial:
+			applied[i] = credit
+		}
+		credit, err = credit.sub(applied[i])
+		if err != nil {
+			return nil, Amount{}, err
+		}
+	}
+	return applied, credit, nil
+}
+
+// checkPaymentAmount returns an error if the amount cannot be used
+// in the application of the payment.
+func checkPaymentAmount(payment, a Amount) error {
+	switch {
+	case !a.SameCurr(payment):
+		return mismatchError(payment.Curr(), a.Curr())
+	case a.IsNeg():
+		return fmt.Errorf("amount must not be negative")
+	case a.MinScale() > a.Curr().Scale():
+		return fmt.Errorf("amount must be a multiple of the minor unit")
+	}
+	return nil
+}
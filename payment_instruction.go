@@ -0,0 +1,109 @@
+package money
+
+import (
+	"fmt"
+	"time"
+)
+
+// ChargeBearer specifies which party bears the charges of a payment,
+// using the codes of the ISO 20022 standard.
+// See also type [Payment].
+type ChargeBearer int8
+
+const (
+	// ChargesShared means that the debtor and the creditor bear their own
+	// charges (ISO 20022 code "SHAR", SWIFT code "SHA").
+	ChargesShared ChargeBearer = iota
+	// ChargesDebtor means that the debtor bears all charges
+	// (ISO 20022 code "DEBT", SWIFT code "OUR").
+	ChargesDebtor
+	// ChargesCreditor means that the creditor bears all charges
+	// (ISO 20022 code "CRED", SWIFT code "BEN").
+	ChargesCreditor
+	// ChargesServiceLevel means that the charges follow the rules of
+	// the service level, such as SEPA (ISO 20022 code "SLEV").
+	ChargesServiceLevel
+)
+
+// String implements the [fmt.Stringer] interface and returns the ISO 20022
+// code of the charge bearer.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (b ChargeBearer) String() string {
+	switch b {
+	case ChargesShared:
+		return "SHAR"
+	case ChargesDebtor:
+		return "DEBT"
+	case ChargesCreditor:
+		return "CRED"
+	case ChargesServiceLevel:
+		return "SLEV"
+	default:
+		return fmt.Sprintf("ChargeBearer(%d)", int8(b))
+	}
+}
+
+// ParseChargeBearer converts an ISO 20022 code, such as "SHAR", or a SWIFT
+// code, such as "SHA", to a charge bearer.
+//
+// ParseChargeBearer returns an error if the code is not valid.
+func ParseChargeBearer(code string) (ChargeBearer, error) {
+	switch code {
+	case "SHAR", "SHA":
+		return ChargesShared, nil
+	case "DEBT", "OUR":
+		return ChargesDebtor, nil
+	case "CRED", "BEN":
+		return ChargesCreditor, nil
+	case "SLEV":
+		return ChargesServiceLevel, nil
+	default:
+		return 0, fmt.Errorf("parsing charge bearer: unknown code %q", code)
+	}
+}
+
+// Payment represents the monetary part of a payment instruction, such as
+// a credit transfer, providing a typed alternative to passing loose fields
+// to payment initiation APIs.
+// See also method [Payment.Validate].
+type Payment struct {
+	Amount       Amount       // instructed amount
+	ChargeBearer ChargeBearer // party bearing the charges
+	ValueDate    time.Time    // date on which the funds become available
+}
+
+// Validate returns an error if the payment cannot be submitted to a payment
+// initiation API, that is, if:
+//   - the amount is not positive;
+//   - the currency is [XXX] or is not in the allowed set, if the set is not empty;
+//   - the amount has more digits after the decimal point than the currency,
+//     ignoring trailing zeros;
+//   - the charge bearer is not valid;
+//   - the value date is not set.
+func (p Payment) Validate(allowed CurrencySet) error {
+	err := p.validate(allowed)
+	if err != nil {
+		return fmt.Errorf("validating payment of %v: %w", p.Amount, err)
+	}
+	return nil
+}
+
+func (p Payment) validate(allowed CurrencySet) error {
+	a, c := p.Amount, p.Amount.Curr()
+	switch {
+	case c == XXX:
+		return fmt.Errorf("currency must be set")
+	case allowed.Len() > 0 && !allowed.Contains(c):
+		return allowed.check(c)
+	case !a.IsPos():
+		return fmt.Errorf("amount must be positive")
+	case a.MinScale() > c.Scale():
+		return fmt.Errorf("amount must be a multiple of the minor unit")
+	case p.ChargeBearer < ChargesShared || p.ChargeBearer > ChargesServiceLevel:
+		return fmt.Errorf("invalid charge bearer %v", p.ChargeBearer)
+	case p.ValueDate.IsZero():
+		return fmt.Errorf("value date must be set")
+	}
+	return nil
+}
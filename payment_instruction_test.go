@@ -0,0 +1,111 @@
+package money
+
+import (
+	"testing"
+	"time"
+)
+
+func TestChargeBearer_String(t *testing.T) {
+	tests := []struct {
+		b    ChargeBearer
+		want string
+	}{
+		{ChargesShared, "SHAR"},
+		{ChargesDebtor, "DEBT"},
+		{ChargesCreditor, "CRED"},
+		{ChargesServiceLevel, "SLEV"},
+		{ChargeBearer(-1), "ChargeBearer(-1)"},
+	}
+	for _, tt := range tests {
+		if got := tt.b.String(); got != tt.want {
+			t.Errorf("%v.String() = %q, want %q", int8(tt.b), got, tt.want)
+		}
+	}
+}
+
+func TestParseChargeBearer(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			code string
+			want ChargeBearer
+		}{
+			{"SHAR", ChargesShared},
+			{"SHA", ChargesShared},
+			{"DEBT", ChargesDebtor},
+			{"OUR", ChargesDebtor},
+			{"CRED", ChargesCreditor},
+			{"BEN", ChargesCreditor},
+			{"SLEV", ChargesServiceLevel},
+		}
+		for _, tt := range tests {
+			got, err := ParseChargeBearer(tt.code)
+			if err != nil {
+				t.Errorf("ParseChargeBearer(%q) failed: %v", tt.code, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("ParseChargeBearer(%q) = %v, want %v", tt.code, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{"", "shar", "SHARE", "XXX"}
+		for _, code := range tests {
+			_, err := ParseChargeBearer(code)
+			if err == nil {
+				t.Errorf("ParseChargeBearer(%q) did not fail", code)
+			}
+		}
+	})
+}
+
+func TestPayment_Validate(t *testing.T) {
+	date := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, amount string
+			bearer       ChargeBearer
+			allowed      CurrencySet
+		}{
+			{"USD", "100.00", ChargesShared, CurrencySet{}},
+			{"USD", "100.000", ChargesDebtor, CurrencySet{}},
+			{"EUR", "0.01", ChargesServiceLevel, NewCurrencySet(EUR)},
+			{"JPY", "1000", ChargesCreditor, NewCurrencySet(JPY, USD)},
+		}
+		for _, tt := range tests {
+			p := Payment{
+				Amount:       MustParseAmount(tt.curr, tt.amount),
+				ChargeBearer: tt.bearer,
+				ValueDate:    date,
+			}
+			err := p.Validate(tt.allowed)
+			if err != nil {
+				t.Errorf("%+v.Validate(%v) failed: %v", p, tt.allowed, err)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			p       Payment
+			allowed CurrencySet
+		}{
+			"no currency":      {Payment{MustParseAmount("XXX", "1"), ChargesShared, date}, CurrencySet{}},
+			"not allowed":      {Payment{MustParseAmount("USD", "1"), ChargesShared, date}, NewCurrencySet(EUR)},
+			"zero amount":      {Payment{MustParseAmount("USD", "0"), ChargesShared, date}, CurrencySet{}},
+			"negative amount":  {Payment{MustParseAmount("USD", "-1"), ChargesShared, date}, CurrencySet{}},
+			"sub-minor amount": {Payment{MustParseAmount("USD", "1.001"), ChargesShared, date}, CurrencySet{}},
+			"fractional yen":   {Payment{MustParseAmount("JPY", "1.5"), ChargesShared, date}, CurrencySet{}},
+			"invalid bearer":   {Payment{MustParseAmount("USD", "1"), ChargeBearer(4), date}, CurrencySet{}},
+			"no value date":    {Payment{MustParseAmount("USD", "1"), ChargesShared, time.Time{}}, CurrencySet{}},
+		}
+		for name, tt := range tests {
+			err := tt.p.Validate(tt.allowed)
+			if err == nil {
+				t.Errorf("%v: %+v.Validate(%v) did not fail", name, tt.p, tt.allowed)
+			}
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package money
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestApplicationMode_String(t *testing.T) {
+	tests := []struct {
+		m    ApplicationMode
+		want string
+	}{
+		{ApplyPartial, "partial"},
+		{ApplyFullOnly, "full only"},
+		{ApplicationMode(-1), "ApplicationMode(-1)"},
+	}
+	for _, tt := range tests {
+		got := tt.m.String()
+		if got != tt.want {
+			t.Errorf("ApplicationMode(%d).String() = %q, want %q", int8(tt.m), got, tt.want)
+		}
+	}
+}
+
+func TestApplyPayment(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2024, time.March, d, 0, 0, 0, 0, time.UTC)
+	}
+	item := func(due, priority int, outstanding string) OpenItem {
+		return OpenItem{Due: day(due), Priority: priority, Outstanding: MustParseAmount("USD", outstanding)}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			payment     string
+			items       []OpenItem
+			mode        ApplicationMode
+			wantApplied []string
+			wantCredit  string
+		}{
+			{"0", nil, ApplyPartial, []string{}, "0"},
+			{"100", nil, ApplyPartial, []string{}, "100"},
+			{"100", []OpenItem{item(1, 0, "30"), item(2, 0, "50")}, ApplyPartial, []string{"30", "50"}, "20"},
+			{"100", []OpenItem{item(1, 0, "30"), item(2, 0, "80")}, ApplyPartial, []string{"30", "70"}, "0"},
+			{"100", []OpenItem{item(2, 0, "80"), item(1, 0, "30")}, ApplyPartial, []string{"70", "30"}, "0"},
+			{"100", []OpenItem{item(1, 1, "30"), item(2, 0, "80")}, ApplyPartial, []string{"20", "80"}, "0"},
+			{"100", []OpenItem{item(1, 0, "80"), item(1, 0, "30")}, ApplyPartial, []string{"80", "20"}, "0"},
+			{"100", []OpenItem{item(1, 0, "30"), item(2, 0, "80"), item(3, 0, "60")}, ApplyFullOnly, []string{"30", "0", "60"}, "10"},
+			{"100", []OpenItem{item(1, 0, "130")}, ApplyFullOnly, []string{"0"}, "100"},
+			{"100.00", []OpenItem{item(1, 0, "0"), item(2, 0, "99.99")}, ApplyPartial, []string{"0", "99.99"}, "0.01"},
+		}
+		for _, tt := range tests {
+			payment := MustParseAmount("USD", tt.payment)
+			gotApplied, gotCredit, err := ApplyPayment(payment, tt.items, tt.mode)
+			if err != nil {
+				t.Errorf("ApplyPayment(%q, %v, %v) failed: %v", payment, tt.items, tt.mode, err)
+				continue
+			}
+			wantApplied := MustParseAmountSlice("USD", tt.wantApplied)
+			wantCredit := MustParseAmount("USD", tt.wantCredit)
+			if !reflect.DeepEqual(gotApplied, wantApplied) || gotCredit != wantCredit {
+				t.Errorf("ApplyPayment(%q, %v, %v) = %v, %q, want %v, %q", payment, tt.items, tt.mode, gotApplied, gotCredit, wantApplied, wantCredit)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			payment Amount
+			items   []OpenItem
+			mode    ApplicationMode
+		}{
+			"mode 1":     {MustParseAmount("USD", "100"), nil, ApplicationMode(2)},
+			"payment 1":  {MustParseAmount("USD", "-100"), nil, ApplyPartial},
+			"payment 2":  {MustParseAmount("USD", "100.001"), nil, ApplyPartial},
+			"item 1":     {MustParseAmount("USD", "100"), []OpenItem{item(1, 0, "-1")}, ApplyPartial},
+			"item 2":     {MustParseAmount("USD", "100"), []OpenItem{item(1, 0, "0.001")}, ApplyPartial},
+			"currency 1": {MustParseAmount("EUR", "100"), []OpenItem{item(1, 0, "1")}, ApplyPartial},
+		}
+		for name, tt := range tests {
+			_, _, err := ApplyPayment(tt.payment, tt.items, tt.mode)
+			if err == nil {
+				t.Errorf("ApplyPayment(%q, %v, %v) did not fail: %v", tt.payment, tt.items, tt.mode, name)
+			}
+		}
+	})
+}
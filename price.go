@@ -0,0 +1,89 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/govalues/decimal"
+)
+
+// Price represents a monetary amount per unit of some quantity,
+// such as a price per liter of fuel or per share.
+// The amount of a price may have more digits after the decimal point than
+// its currency, while quantities are restricted to the unit scale of the price.
+// Its zero value corresponds to "XXX 0" per unit with a unit scale of 0.
+// Price is designed to be safe for concurrent use by multiple goroutines.
+type Price struct {
+	amount    Amount // price of one unit
+	unitScale int    // maximum number of digits after the decimal point in quantities
+}
+
+// NewPrice returns a price with the specified amount per unit and unit scale.
+// The unit scale is the maximum number of digits after the decimal point
+// allowed in quantities, for example, 3 when quantities are measured in liters
+// with milliliter precision.
+//
+// NewPrice returns an error if the unit scale is negative or greater than [decimal.MaxScale].
+func NewPrice(amount Amount, unitScale int) (Price, error) {
+	if unitScale < decimal.MinScale || unitScale > decimal.MaxScale {
+		return Price{}, fmt.Errorf("creating price %v: unit scale %v out of range", amount, unitScale)
+	}
+	return Price{amount: amount, unitScale: unitScale}, nil
+}
+
+// Amount returns the price of one unit.
+func (p Price) Amount() Amount {
+	return p.amount
+}
+
+// Curr returns the currency of the price.
+func (p Price) Curr() Currency {
+	return p.Amount().Curr()
+}
+
+// UnitScale returns the maximum number of digits after the decimal point
+// allowed in quantities.
+func (p Price) UnitScale() int {
+	return p.unitScale
+}
+
+// Total returns the total amount for the given quantity rounded to the scale
+// of the currency using [Amount.RoundToCurr], and the residual,
+// which is the difference between the exact and the rounded totals,
+// such that exact = total + residual.
+//
+// Total returns an error if:
+//   - the quantity has more digits after the decimal point than the unit scale;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func (p Price) Total(qty decimal.Decimal) (total, residual Amount, err error) {
+	total, residual, err = p.total(qty)
+	if err != nil {
+		return Amount{}, Amount{}, fmt.Errorf("computing [%v * %v]: %w", p, qty, err)
+	}
+	return total, residual, nil
+}
+
+func (p Price) total(qty decimal.Decimal) (total, residual Amount, err error) {
+	if qty.MinScale() > p.UnitScale() {
+		return Amount{}, Amount{}, fmt.Errorf("quantity has more than %v digits after the decimal point", p.UnitScale())
+	}
+	exact, err := p.Amount().mul(qty)
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+	total = exact.RoundToCurr()
+	residual, err = exact.sub(total)
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+	return total, residual, nil
+}
+
+// String implements the [fmt.Stringer] interface and returns a string
+// representation of the price.
+// See also method [Amount.String].
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (p Price) String() string {
+	return p.Amount().String() + " per unit"
+}
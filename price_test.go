@@ -0,0 +1,99 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/govalues/decimal"
+)
+
+func TestNewPrice(t *testing.T) {
+	t.Run("error", func(t *testing.T) {
+		a := MustParseAmount("USD", "1.2349")
+		tests := map[string]int{
+			"scale range 1": -1,
+			"scale range 2": 20,
+		}
+		for name, scale := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := NewPrice(a, scale)
+				if err == nil {
+					t.Errorf("NewPrice(%q, %v) did not fail", a, scale)
+				}
+			})
+		}
+	})
+}
+
+func TestPrice_Total(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, p                 string
+			unitScale               int
+			qty, wantTotal, wantRes string
+		}{
+			{"USD", "1.2349", 3, "0", "0.00", "0.0000"},
+			{"USD", "1.2349", 3, "1", "1.23", "0.0049"},
+			{"USD", "1.2349", 3, "40.5", "50.01", "0.00345"},
+			{"USD", "1.2349", 3, "40.125", "49.55", "0.0003625"},
+			{"USD", "1.2349", 3, "-1", "-1.23", "-0.0049"},
+			{"USD", "9.99", 0, "3", "29.97", "0.00"},
+			{"JPY", "0.5", 0, "3", "2", "-0.5"},
+			{"JPY", "0.5", 0, "5", "2", "0.5"},
+		}
+		for _, tt := range tests {
+			p, err := NewPrice(MustParseAmount(tt.curr, tt.p), tt.unitScale)
+			if err != nil {
+				t.Errorf("NewPrice(%q, %v) failed: %v", tt.p, tt.unitScale, err)
+				continue
+			}
+			qty := decimal.MustParse(tt.qty)
+			gotTotal, gotRes, err := p.Total(qty)
+			if err != nil {
+				t.Errorf("%v.Total(%v) failed: %v", p, qty, err)
+				continue
+			}
+			wantTotal := MustParseAmount(tt.curr, tt.wantTotal)
+			wantRes := MustParseAmount(tt.curr, tt.wantRes)
+			if gotTotal != wantTotal || gotRes != wantRes {
+				t.Errorf("%v.Total(%v) = [%q %q], want [%q %q]", p, qty, gotTotal, gotRes, wantTotal, wantRes)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr, p   string
+			unitScale int
+			qty       string
+		}{
+			"scale 1":    {"USD", "1.2349", 0, "1.5"},
+			"scale 2":    {"USD", "1.2349", 3, "1.0005"},
+			"overflow 1": {"USD", "10000000000", 0, "10000000"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				p, err := NewPrice(MustParseAmount(tt.curr, tt.p), tt.unitScale)
+				if err != nil {
+					t.Fatalf("NewPrice(%q, %v) failed: %v", tt.p, tt.unitScale, err)
+				}
+				qty := decimal.MustParse(tt.qty)
+				_, _, err = p.Total(qty)
+				if err == nil {
+					t.Errorf("%v.Total(%v) did not fail", p, qty)
+				}
+			})
+		}
+	})
+}
+
+func TestPrice_String(t *testing.T) {
+	p, err := NewPrice(MustParseAmount("USD", "1.2349"), 3)
+	if err != nil {
+		t.Fatalf("NewPrice failed: %v", err)
+	}
+	got := p.String()
+	want := "USD 1.2349 per unit"
+	if got != want {
+		t.Errorf("%v.String() = %q, want %q", p, got, want)
+	}
+}
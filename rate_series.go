@@ -0,0 +1,171 @@
+package money
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/govalues/decimal"
+)
+
+// RatePoint represents an exchange rate observed at a particular time.
+type RatePoint struct {
+	Time time.Time
+	Rate ExchangeRate
+}
+
+// RateSeries represents the history of exchange rates for a currency pair,
+// ordered by time.
+// It provides the minimal time-series functionality needed for revaluation
+// and reporting.
+// The zero value is an empty series for the pair "XXX/XXX".
+// RateSeries is not safe for concurrent modification, but it is safe for
+// concurrent reads by multiple goroutines.
+type RateSeries struct {
+	base   Currency
+	quote  Currency
+	points []RatePoint // sorted by time, without duplicate times
+}
+
+// NewRateSeries returns an empty series for the specified currency pair.
+func NewRateSeries(base, quote Currency) *RateSeries {
+	return &RateSeries{base: base, quote: quote}
+}
+
+// Base returns the base currency of the series.
+func (s *RateSeries) Base() Currency {
+	return s.base
+}
+
+// Quote returns the quote currency of the series.
+func (s *RateSeries) Quote() Currency {
+	return s.quote
+}
+
+// Len returns the number of points in the series.
+func (s *RateSeries) Len() int {
+	return len(s.points)
+}
+
+// Add inserts the rate observed at time t into the series.
+// If the series already contains a rate observed at the same time,
+// the rate is replaced.
+//
+// Add returns an error if the rate is denominated in different base or
+// quote currencies than the series.
+func (s *RateSeries) Add(t time.Time, r ExchangeRate) error {
+	if r.Base() != s.Base() {
+		return fmt.Errorf("adding %v to %v/%v series: %w", r, s.Base(), s.Quote(), mismatchError(s.Base(), r.Base()))
+	}
+	if r.Quote() != s.Quote() {
+		return fmt.Errorf("adding %v to %v/%v series: %w", r, s.Base(), s.Quote(), mismatchError(s.Quote(), r.Quote()))
+	}
+	i := s.search(t)
+	if i < len(s.points) && s.points[i].Time.Equal(t) {
+		s.points[i].Rate = r
+		return nil
+	}
+	s.points = append(s.points, RatePoint{})
+	copy(s.points[i+1:], s.points[i:])
+	s.points[i] = RatePoint{Time: t, Rate: r}
+	return nil
+}
+
+// search returns the index of the first point observed at or after time t.
+func (s *RateSeries) search(t time.Time) int {
+	return sort.Search(len(s.points), func(i int) bool {
+		return !s.points[i].Time.Before(t)
+	})
+}
+
+// AsOf returns the latest rate observed at or before time t.
+//
+// AsOf returns an error if there are no rates observed at or before time t.
+func (s *RateSeries) AsOf(t time.Time) (ExchangeRate, error) {
+	i := s.search(t)
+	if i < len(s.points) && s.points[i].Time.Equal(t) {
+		return s.points[i].Rate, nil
+	}
+	if i == 0 {
+		return ExchangeRate{}, fmt.Errorf("looking up %v/%v rate as of %v: no rates observed", s.Base(), s.Quote(), t)
+	}
+	return s.points[i-1].Rate, nil
+}
+
+// Between returns the points observed from time t1 to time t2 inclusive,
+// ordered by time.
+// The returned slice must not be modified.
+func (s *RateSeries) Between(t1, t2 time.Time) []RatePoint {
+	i := s.search(t1)
+	j := sort.Search(len(s.points), func(k int) bool {
+		return s.points[k].Time.After(t2)
+	})
+	if i >= j {
+		return nil
+	}
+	return s.points[i:j:j]
+}
+
+// Min returns the smallest rate observed from time t1 to time t2 inclusive.
+// See also methods [RateSeries.Max] and [RateSeries.Average].
+//
+// Min returns an error if there are no rates observed in the window.
+func (s *RateSeries) Min(t1, t2 time.Time) (ExchangeRate, error) {
+	points := s.Between(t1, t2)
+	if len(points) == 0 {
+		return ExchangeRate{}, s.errEmptyWindow(t1, t2)
+	}
+	r := points[0].Rate
+	for _, p := range points[1:] {
+		if p.Rate.Decimal().Cmp(r.Decimal()) < 0 {
+			r = p.Rate
+		}
+	}
+	return r, nil
+}
+
+// Max returns the largest rate observed from time t1 to time t2 inclusive.
+// See also methods [RateSeries.Min] and [RateSeries.Average].
+//
+// Max returns an error if there are no rates observed in the window.
+func (s *RateSeries) Max(t1, t2 time.Time) (ExchangeRate, error) {
+	points := s.Between(t1, t2)
+	if len(points) == 0 {
+		return ExchangeRate{}, s.errEmptyWindow(t1, t2)
+	}
+	r := points[0].Rate
+	for _, p := range points[1:] {
+		if p.Rate.Decimal().Cmp(r.Decimal()) > 0 {
+			r = p.Rate
+		}
+	}
+	return r, nil
+}
+
+// Average returns the (possibly rounded) arithmetic mean of the rates observed
+// from time t1 to time t2 inclusive.
+// Each observation has the same weight regardless of the time between them.
+// See also function [BlendExchRates].
+//
+// Average returns an error if there are no rates observed in the window.
+func (s *RateSeries) Average(t1, t2 time.Time) (ExchangeRate, error) {
+	points := s.Between(t1, t2)
+	if len(points) == 0 {
+		return ExchangeRate{}, s.errEmptyWindow(t1, t2)
+	}
+	rates := make([]ExchangeRate, len(points))
+	weights := make([]decimal.Decimal, len(points))
+	for i, p := range points {
+		rates[i] = p.Rate
+		weights[i] = decimal.One
+	}
+	r, err := blendExchRates(rates, weights)
+	if err != nil {
+		return ExchangeRate{}, fmt.Errorf("averaging %v/%v rates from %v to %v: %w", s.Base(), s.Quote(), t1, t2, err)
+	}
+	return r, nil
+}
+
+func (s *RateSeries) errEmptyWindow(t1, t2 time.Time) error {
+	return fmt.Errorf("no %v/%v rates observed from %v to %v", s.Base(), s.Quote(), t1, t2)
+}
@@ -0,0 +1,187 @@
+package money
+
+import (
+	"testing"
+	"time"
+)
+
+func testDay(day int) time.Time {
+	return time.Date(2024, time.January, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestRateSeries_Add(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		s := NewRateSeries(EUR, USD)
+		for day, r := range map[int]string{5: "1.1050", 1: "1.1000", 3: "1.0900", 7: "1.1200"} {
+			if err := s.Add(testDay(day), MustParseExchRate("EUR", "USD", r)); err != nil {
+				t.Fatalf("Add(%v, %v) failed: %v", testDay(day), r, err)
+			}
+		}
+		if s.Len() != 4 {
+			t.Errorf("Len() = %v, want 4", s.Len())
+		}
+		// Replacing
+		r := MustParseExchRate("EUR", "USD", "1.0950")
+		if err := s.Add(testDay(3), r); err != nil {
+			t.Fatalf("Add(%v, %v) failed: %v", testDay(3), r, err)
+		}
+		if s.Len() != 4 {
+			t.Errorf("Len() = %v, want 4", s.Len())
+		}
+		got, err := s.AsOf(testDay(3))
+		if err != nil {
+			t.Fatalf("AsOf(%v) failed: %v", testDay(3), err)
+		}
+		if got != r {
+			t.Errorf("AsOf(%v) = %q, want %q", testDay(3), got, r)
+		}
+		// Ordering
+		points := s.Between(testDay(0), testDay(31))
+		for i := 1; i < len(points); i++ {
+			if !points[i-1].Time.Before(points[i].Time) {
+				t.Errorf("Between() = %v, not ordered by time", points)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		s := NewRateSeries(EUR, USD)
+		r := MustParseExchRate("USD", "EUR", "0.9")
+		err := s.Add(testDay(1), r)
+		if err == nil {
+			t.Errorf("Add(%v, %v) did not fail", testDay(1), r)
+		}
+	})
+}
+
+func TestRateSeries_AsOf(t *testing.T) {
+	s := NewRateSeries(EUR, USD)
+	for day, r := range map[int]string{5: "1.1050", 1: "1.1000", 3: "1.0900", 7: "1.1200"} {
+		if err := s.Add(testDay(day), MustParseExchRate("EUR", "USD", r)); err != nil {
+			t.Fatalf("Add(%v, %v) failed: %v", testDay(day), r, err)
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			t    time.Time
+			want string
+		}{
+			{testDay(1), "1.1000"},
+			{testDay(2), "1.1000"},
+			{testDay(3), "1.0900"},
+			{testDay(3).Add(-time.Nanosecond), "1.1000"},
+			{testDay(6), "1.1050"},
+			{testDay(31), "1.1200"},
+		}
+		for _, tt := range tests {
+			got, err := s.AsOf(tt.t)
+			if err != nil {
+				t.Errorf("AsOf(%v) failed: %v", tt.t, err)
+				continue
+			}
+			want := MustParseExchRate("EUR", "USD", tt.want)
+			if got != want {
+				t.Errorf("AsOf(%v) = %q, want %q", tt.t, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tm := testDay(1).Add(-time.Nanosecond)
+		_, err := s.AsOf(tm)
+		if err == nil {
+			t.Errorf("AsOf(%v) did not fail", tm)
+		}
+	})
+}
+
+func TestRateSeries_Between(t *testing.T) {
+	s := NewRateSeries(EUR, USD)
+	for day, r := range map[int]string{5: "1.1050", 1: "1.1000", 3: "1.0900", 7: "1.1200"} {
+		if err := s.Add(testDay(day), MustParseExchRate("EUR", "USD", r)); err != nil {
+			t.Fatalf("Add(%v, %v) failed: %v", testDay(day), r, err)
+		}
+	}
+	tests := []struct {
+		t1, t2 time.Time
+		want   []string
+	}{
+		{testDay(1), testDay(7), []string{"1.1000", "1.0900", "1.1050", "1.1200"}},
+		{testDay(2), testDay(5), []string{"1.0900", "1.1050"}},
+		{testDay(3), testDay(3), []string{"1.0900"}},
+		{testDay(8), testDay(9), nil},
+		{testDay(7), testDay(1), nil},
+	}
+	for _, tt := range tests {
+		got := s.Between(tt.t1, tt.t2)
+		if len(got) != len(tt.want) {
+			t.Errorf("Between(%v, %v) = %v, want %v", tt.t1, tt.t2, got, tt.want)
+			continue
+		}
+		for i, p := range got {
+			want := MustParseExchRate("EUR", "USD", tt.want[i])
+			if p.Rate != want {
+				t.Errorf("Between(%v, %v) = %v, want %v", tt.t1, tt.t2, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestRateSeries_Aggregates(t *testing.T) {
+	s := NewRateSeries(EUR, USD)
+	for day, r := range map[int]string{5: "1.1050", 1: "1.1000", 3: "1.0900", 7: "1.1200"} {
+		if err := s.Add(testDay(day), MustParseExchRate("EUR", "USD", r)); err != nil {
+			t.Fatalf("Add(%v, %v) failed: %v", testDay(day), r, err)
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			t1, t2           time.Time
+			wantMin, wantMax string
+			wantAvg          string
+		}{
+			{testDay(1), testDay(7), "1.0900", "1.1200", "1.10375"},
+			{testDay(1), testDay(3), "1.0900", "1.1000", "1.0950"},
+			{testDay(5), testDay(5), "1.1050", "1.1050", "1.1050"},
+		}
+		for _, tt := range tests {
+			gotMin, err := s.Min(tt.t1, tt.t2)
+			if err != nil {
+				t.Errorf("Min(%v, %v) failed: %v", tt.t1, tt.t2, err)
+				continue
+			}
+			gotMax, err := s.Max(tt.t1, tt.t2)
+			if err != nil {
+				t.Errorf("Max(%v, %v) failed: %v", tt.t1, tt.t2, err)
+				continue
+			}
+			gotAvg, err := s.Average(tt.t1, tt.t2)
+			if err != nil {
+				t.Errorf("Average(%v, %v) failed: %v", tt.t1, tt.t2, err)
+				continue
+			}
+			wantMin := MustParseExchRate("EUR", "USD", tt.wantMin)
+			wantMax := MustParseExchRate("EUR", "USD", tt.wantMax)
+			wantAvg := MustParseExchRate("EUR", "USD", tt.wantAvg)
+			if gotMin != wantMin || gotMax != wantMax || gotAvg != wantAvg {
+				t.Errorf("Min/Max/Average(%v, %v) = [%q %q %q], want [%q %q %q]", tt.t1, tt.t2, gotMin, gotMax, gotAvg, wantMin, wantMax, wantAvg)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t1, t2 := testDay(8), testDay(9)
+		if _, err := s.Min(t1, t2); err == nil {
+			t.Errorf("Min(%v, %v) did not fail", t1, t2)
+		}
+		if _, err := s.Max(t1, t2); err == nil {
+			t.Errorf("Max(%v, %v) did not fail", t1, t2)
+		}
+		if _, err := s.Average(t1, t2); err == nil {
+			t.Errorf("Average(%v, %v) did not fail", t1, t2)
+		}
+	})
+}
@@ -0,0 +1,38 @@
+//go:build go1.23
+
+package money
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Reduce applies the operation to the amounts of the sequence in order,
+// accumulating the result, and returns the final accumulated amount.
+// The first amount of the sequence is used as the initial accumulator.
+// It allows streaming pipelines to aggregate amounts without materializing
+// slices.
+// See also functions [SumReducer], [MinReducer], and [MaxReducer].
+//
+// Reduce returns an error if the sequence is empty or the operation fails.
+// The iteration stops at the first error.
+func Reduce(seq iter.Seq[Amount], op func(acc, x Amount) (Amount, error)) (Amount, error) {
+	var acc Amount
+	var err error
+	i := 0
+	for x := range seq {
+		if i == 0 {
+			acc = x
+		} else {
+			acc, err = op(acc, x)
+			if err != nil {
+				return Amount{}, fmt.Errorf("reducing amount %v: %w", i, err)
+			}
+		}
+		i++
+	}
+	if i == 0 {
+		return Amount{}, fmt.Errorf("reducing: no amounts")
+	}
+	return acc, nil
+}
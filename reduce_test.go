@@ -0,0 +1,52 @@
+//go:build go1.23
+
+package money
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestReduce(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr    string
+			amounts []string
+			op      func(acc, x Amount) (Amount, error)
+			want    string
+		}{
+			{"USD", []string{"1"}, SumReducer, "1"},
+			{"USD", []string{"1", "2.5", "-0.125"}, SumReducer, "3.375"},
+			{"USD", []string{"1", "2.5", "-0.125"}, MinReducer, "-0.125"},
+			{"USD", []string{"1", "2.5", "-0.125"}, MaxReducer, "2.5"},
+		}
+		for _, tt := range tests {
+			amounts := MustParseAmountSlice(tt.curr, tt.amounts)
+			got, err := Reduce(slices.Values(amounts), tt.op)
+			if err != nil {
+				t.Errorf("Reduce(%v) failed: %v", amounts, err)
+				continue
+			}
+			want := MustParseAmount(tt.curr, tt.want)
+			if got != want {
+				t.Errorf("Reduce(%v) = %q, want %q", amounts, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string][]Amount{
+			"empty 1":    nil,
+			"currency 1": {MustParseAmount("USD", "1"), MustParseAmount("EUR", "1")},
+			"overflow 1": {MustParseAmount("USD", "99999999999999999"), MustParseAmount("USD", "1")},
+		}
+		for name, amounts := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := Reduce(slices.Values(amounts), SumReducer)
+				if err == nil {
+					t.Errorf("Reduce(%v) did not fail", amounts)
+				}
+			})
+		}
+	})
+}
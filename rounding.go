@@ -0,0 +1,244 @@
+package money
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/govalues/decimal"
+)
+
+// RoundingMode specifies how a value is rounded when digits are discarded.
+// The zero value is [HalfEven].
+type RoundingMode int8
+
+const (
+	// HalfEven rounds to the nearest neighbor, and if both neighbors are
+	// equidistant, to the even neighbor (banker's rounding).
+	HalfEven RoundingMode = iota
+	// HalfUp rounds to the nearest neighbor, and if both neighbors are
+	// equidistant, away from zero (commercial rounding).
+	HalfUp
+	// Up rounds away from zero.
+	Up
+	// Down rounds toward zero (truncation).
+	Down
+	// Ceiling rounds toward positive infinity.
+	Ceiling
+	// Floor rounds toward negative infinity.
+	Floor
+	// Unnecessary asserts that no rounding is necessary.
+	// Operations that accept a rounding mode return an error if rounding
+	// would discard any non-zero digits.
+	// This mode cannot be used in a [RoundingPolicy].
+	Unnecessary
+)
+
+// String implements the [fmt.Stringer] interface and returns
+// a string representation of the rounding mode.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (m RoundingMode) String() string {
+	switch m {
+	case HalfEven:
+		return "half even"
+	case HalfUp:
+		return "half up"
+	case Up:
+		return "up"
+	case Down:
+		return "down"
+	case Ceiling:
+		return "ceiling"
+	case Floor:
+		return "floor"
+	case Unnecessary:
+		return "unnecessary"
+	default:
+		return fmt.Sprintf("RoundingMode(%d)", int8(m))
+	}
+}
+
+// valid returns true if the rounding mode is one of the predefined constants.
+func (m RoundingMode) valid() bool {
+	return m >= HalfEven && m <= Unnecessary
+}
+
+// rounds returns true if the rounding mode actually rounds values,
+// that is, if it is valid and not [Unnecessary].
+func (m RoundingMode) rounds() bool {
+	return m.valid() && m != Unnecessary
+}
+
+// roundDecimal returns a decimal rounded to the specified number of digits
+// after the decimal point using the given rounding mode.
+func roundDecimal(d decimal.Decimal, scale int, mode RoundingMode) decimal.Decimal {
+	switch mode {
+	case Down:
+		return d.Trunc(scale)
+	case Ceiling:
+		return d.Ceil(scale)
+	case Floor:
+		return d.Floor(scale)
+	case Up:
+		if d.IsNeg() {
+			return d.Floor(scale)
+		}
+		return d.Ceil(scale)
+	case HalfUp:
+		if scale < 0 || scale >= d.Scale() {
+			return d.Round(scale)
+		}
+		// Adding half of the unit in the last place and truncating
+		// the result is equivalent to rounding half away from zero.
+		half, err := decimal.New(5, scale+1)
+		if err != nil {
+			return d.Round(scale)
+		}
+		e, err := d.Add(half.CopySign(d))
+		if err != nil {
+			return d.Round(scale)
+		}
+		return e.Trunc(scale)
+	default:
+		return d.Round(scale)
+	}
+}
+
+// roundDecimalToIncrement returns a decimal rounded to a multiple of
+// the given positive increment using the given rounding mode.
+func roundDecimalToIncrement(d, inc decimal.Decimal, mode RoundingMode) (decimal.Decimal, error) {
+	q, r, err := d.QuoRem(inc)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	if !r.IsZero() {
+		away := false
+		switch mode {
+		case Up:
+			away = true
+		case Ceiling:
+			away = d.IsPos()
+		case Floor:
+			away = d.IsNeg()
+		case HalfUp, HalfEven:
+			// Comparing the doubled remainder with the increment
+			// to find the nearest neighbor.
+			r, err = r.Add(r)
+			if err != nil {
+				return decimal.Decimal{}, err
+			}
+			switch c := r.CmpAbs(inc); {
+			case c > 0:
+				away = true
+			case c == 0:
+				away = mode == HalfUp || q.Trunc(0).Coef()%2 != 0
+			}
+		}
+		if away {
+			one := decimal.One.CopySign(d)
+			q, err = q.Add(one)
+			if err != nil {
+				return decimal.Decimal{}, err
+			}
+		}
+	}
+	q, err = q.Trunc(0).MulExact(inc, inc.Scale())
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	if q.IsZero() {
+		q = q.Abs()
+	}
+	return q, nil
+}
+
+// RoundingPolicy specifies how amounts denominated in a particular currency
+// are rounded by methods [Amount.RoundToCurr], [Amount.CeilToCurr],
+// [Amount.FloorToCurr], and [Amount.TruncToCurr].
+// The zero value corresponds to the default policy: rounding half to even
+// to the minor unit of the currency.
+// See also function [SetRoundingPolicy].
+type RoundingPolicy struct {
+	// Mode is the rounding mode used by [Amount.RoundToCurr].
+	// Methods [Amount.CeilToCurr], [Amount.FloorToCurr], and [Amount.TruncToCurr]
+	// always round in their own direction.
+	Mode RoundingMode
+	// Increment is the smallest step the rounded amounts are multiples of,
+	// for example, 0.05 for Swiss Franc cash payments.
+	// It must be a multiple of the minor unit of the currency.
+	// The zero value means the minor unit of the currency.
+	// If a multiple of the increment would have more than [decimal.MaxPrec]
+	// digits, the amount is returned unchanged rather than rounded to
+	// the minor unit.
+	Increment Amount
+}
+
+// roundingPolicies holds the registered policies indexed by currency.
+// A nil entry means the default policy.
+var roundingPolicies [maxCurrencies]atomic.Pointer[RoundingPolicy]
+
+// SetRoundingPolicy registers a rounding policy for the currency, replacing
+// any previously registered policy.
+// The policy is consulted by [Amount.RoundToCurr], [Amount.CeilToCurr],
+// [Amount.FloorToCurr], and [Amount.TruncToCurr] for all amounts denominated
+// in this currency.
+// SetRoundingPolicy is safe for concurrent use by multiple goroutines, but
+// it is intended to be called during program initialization.
+// See also function [ResetRoundingPolicy] and method [Currency.RoundingPolicy].
+//
+// SetRoundingPolicy returns an error if:
+//   - the rounding mode is not valid;
+//   - the increment is negative or denominated in a different currency;
+//   - the increment is not a multiple of the minor unit of the currency.
+func SetRoundingPolicy(c Currency, p RoundingPolicy) error {
+	if !p.Mode.rounds() {
+		return fmt.Errorf("setting rounding policy for %v: invalid rounding mode %v", c, p.Mode)
+	}
+	if p.Increment.IsZero() {
+		p.Increment = Amount{}
+	} else {
+		if p.Increment.Curr() != c {
+			return fmt.Errorf("setting rounding policy for %v: increment %v: %w", c, p.Increment, mismatchError(c, p.Increment.Curr()))
+		}
+		if p.Increment.IsNeg() {
+			return fmt.Errorf("setting rounding policy for %v: increment %v must be positive", c, p.Increment)
+		}
+		if p.Increment.MinScale() > c.Scale() {
+			return fmt.Errorf("setting rounding policy for %v: increment %v must be a multiple of the minor unit", c, p.Increment)
+		}
+	}
+	roundingPolicies[c].Store(&p)
+	return nil
+}
+
+// ResetRoundingPolicy restores the default rounding policy for the currency.
+// See also function [SetRoundingPolicy].
+func ResetRoundingPolicy(c Currency) {
+	roundingPolicies[c].Store(nil)
+}
+
+// RoundingPolicy returns the rounding policy registered for the currency.
+// If no policy has been registered, the zero value is returned.
+// See also function [SetRoundingPolicy].
+func (c Currency) RoundingPolicy() RoundingPolicy {
+	if p := roundingPolicies[c].Load(); p != nil {
+		return *p
+	}
+	return RoundingPolicy{}
+}
+
+// roundToCurr rounds the amount to the scale of its currency, honoring
+// the increment of the registered policy.
+// If rounding to the increment overflows, the amount is returned unchanged.
+func (a Amount) roundToCurr(mode RoundingMode, p *RoundingPolicy) Amount {
+	c, d := a.Curr(), a.Decimal()
+	if p != nil && !p.Increment.IsZero() {
+		e, err := roundDecimalToIncrement(d, p.Increment.Decimal(), mode)
+		if err != nil {
+			return a
+		}
+		return newAmountUnsafe(c, e.Pad(c.Scale()))
+	}
+	d = roundDecimal(d, c.Scale(), mode).Pad(c.Scale())
+	return newAmountUnsafe(c, d)
+}
@@ -0,0 +1,93 @@
+package money
+
+import (
+	"fmt"
+)
+
+// RoundingAccumulator collects the residues left by repeated rounding of
+// amounts to the scale of their currency, so that a batch process can book
+// the accumulated rounding difference once instead of losing it.
+// For every rounded amount, the residue is the difference between the original
+// amount and the rounded one, and the accumulated residue is kept without
+// any rounding.
+// The sum of the rounded amounts, the flushed amounts, and the remaining
+// residue is always exactly equal to the sum of the original amounts.
+// The zero value is an empty accumulator ready to use, which adopts the
+// currency of the first rounded amount.
+// RoundingAccumulator is not safe for concurrent use by multiple goroutines.
+type RoundingAccumulator struct {
+	residue Amount
+	active  bool // true if the currency of the residue has been set
+}
+
+// Round returns the amount rounded to the scale of its currency using
+// [Amount.RoundToCurr] and adds the residue to the accumulator.
+// See also method [RoundingAccumulator.Flush].
+//
+// Round returns an error if:
+//   - the amount is denominated in a different currency than the amounts
+//     rounded before;
+//   - the integer part of the residue has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func (acc *RoundingAccumulator) Round(a Amount) (Amount, error) {
+	b, err := acc.round(a)
+	if err != nil {
+		return Amount{}, fmt.Errorf("rounding [%v] with residue [%v]: %w", a, acc.residue, err)
+	}
+	return b, nil
+}
+
+func (acc *RoundingAccumulator) round(a Amount) (Amount, error) {
+	if !acc.active {
+		acc.residue = a.Zero().TrimToCurr()
+		acc.active = true
+	}
+	if !a.SameCurr(acc.residue) {
+		return Amount{}, mismatchError(acc.residue.Curr(), a.Curr())
+	}
+	b := a.RoundToCurr()
+	d, err := a.sub(b)
+	if err != nil {
+		return Amount{}, err
+	}
+	residue, err := acc.residue.add(d)
+	if err != nil {
+		return Amount{}, err
+	}
+	acc.residue = residue
+	return b, nil
+}
+
+// Residue returns the accumulated residue, which is not rounded and may have
+// more digits after the decimal point than the scale of its currency.
+// If no amounts have been rounded, the result is "XXX 0".
+func (acc *RoundingAccumulator) Residue() Amount {
+	return acc.residue
+}
+
+// Flush returns the accumulated residue rounded to the scale of its currency
+// using [Amount.RoundToCurr], which is the rounding difference to be booked,
+// and removes it from the accumulator.
+// The part of the residue smaller than the rounding increment of the currency
+// stays in the accumulator and is carried over to the next flush.
+// If no amounts have been rounded, the result is "XXX 0".
+//
+// Flush returns an error if the integer part of the remaining residue has
+// more than ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func (acc *RoundingAccumulator) Flush() (Amount, error) {
+	f, err := acc.flush()
+	if err != nil {
+		return Amount{}, fmt.Errorf("flushing residue [%v]: %w", acc.residue, err)
+	}
+	return f, nil
+}
+
+func (acc *RoundingAccumulator) flush() (Amount, error) {
+	f := acc.residue.RoundToCurr()
+	residue, err := acc.residue.sub(f)
+	if err != nil {
+		return Amount{}, err
+	}
+	acc.residue = residue
+	return f, nil
+}
@@ -0,0 +1,100 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestRoundingAccumulator_Round(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr         string
+			amounts      []string
+			wantRounded  []string
+			wantResidue  string
+			wantFlush    string
+			wantLeftover string
+		}{
+			{
+				curr:         "USD",
+				amounts:      []string{"1.005", "2.333", "3.3333"},
+				wantRounded:  []string{"1.00", "2.33", "3.33"},
+				wantResidue:  "0.0113",
+				wantFlush:    "0.01",
+				wantLeftover: "0.0013",
+			},
+			{
+				curr:         "USD",
+				amounts:      []string{"0.335", "0.335", "0.335"},
+				wantRounded:  []string{"0.34", "0.34", "0.34"},
+				wantResidue:  "-0.015",
+				wantFlush:    "-0.02",
+				wantLeftover: "0.005",
+			},
+			{
+				curr:         "JPY",
+				amounts:      []string{"100.4", "-200.4", "300"},
+				wantRounded:  []string{"100", "-200", "300"},
+				wantResidue:  "0.0",
+				wantFlush:    "0",
+				wantLeftover: "0.0",
+			},
+		}
+		for _, tt := range tests {
+			var acc RoundingAccumulator
+			for i, a := range MustParseAmountSlice(tt.curr, tt.amounts) {
+				got, err := acc.Round(a)
+				if err != nil {
+					t.Errorf("Round(%q) failed: %v", a, err)
+					continue
+				}
+				want := MustParseAmount(tt.curr, tt.wantRounded[i])
+				if got != want {
+					t.Errorf("Round(%q) = %q, want %q", a, got, want)
+				}
+			}
+			got := acc.Residue()
+			want := MustParseAmount(tt.curr, tt.wantResidue)
+			if got.Decimal().Cmp(want.Decimal()) != 0 || !got.SameCurr(want) {
+				t.Errorf("Residue() = %q, want %q", got, want)
+			}
+			got, err := acc.Flush()
+			if err != nil {
+				t.Errorf("Flush() failed: %v", err)
+				continue
+			}
+			want = MustParseAmount(tt.curr, tt.wantFlush)
+			if got != want {
+				t.Errorf("Flush() = %q, want %q", got, want)
+			}
+			got = acc.Residue()
+			want = MustParseAmount(tt.curr, tt.wantLeftover)
+			if got.Decimal().Cmp(want.Decimal()) != 0 || !got.SameCurr(want) {
+				t.Errorf("Residue() after Flush() = %q, want %q", got, want)
+			}
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		var acc RoundingAccumulator
+		got, err := acc.Flush()
+		if err != nil {
+			t.Fatalf("Flush() failed: %v", err)
+		}
+		if got != (Amount{}) {
+			t.Errorf("Flush() = %q, want %q", got, Amount{})
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		var acc RoundingAccumulator
+		_, err := acc.Round(MustParseAmount("USD", "1.005"))
+		if err != nil {
+			t.Fatalf("Round() failed: %v", err)
+		}
+		a := MustParseAmount("EUR", "1.005")
+		_, err = acc.Round(a)
+		if err == nil {
+			t.Errorf("Round(%q) did not fail", a)
+		}
+	})
+}
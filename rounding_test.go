@@ -0,0 +1,149 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestRoundingMode_String(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		want string
+	}{
+		{HalfEven, "half even"},
+		{HalfUp, "half up"},
+		{Up, "up"},
+		{Down, "down"},
+		{Ceiling, "ceiling"},
+		{Floor, "floor"},
+		{Unnecessary, "unnecessary"},
+		{RoundingMode(100), "RoundingMode(100)"},
+	}
+	for _, tt := range tests {
+		got := tt.mode.String()
+		if got != tt.want {
+			t.Errorf("RoundingMode(%d).String() = %q, want %q", int8(tt.mode), got, tt.want)
+		}
+	}
+}
+
+func TestSetRoundingPolicy(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		defer ResetRoundingPolicy(CHF)
+		p := RoundingPolicy{Mode: HalfUp, Increment: MustParseAmount("CHF", "0.05")}
+		err := SetRoundingPolicy(CHF, p)
+		if err != nil {
+			t.Fatalf("SetRoundingPolicy(%v, %v) failed: %v", CHF, p, err)
+		}
+		got := CHF.RoundingPolicy()
+		if got != p {
+			t.Errorf("CHF.RoundingPolicy() = %v, want %v", got, p)
+		}
+		ResetRoundingPolicy(CHF)
+		got = CHF.RoundingPolicy()
+		if got != (RoundingPolicy{}) {
+			t.Errorf("CHF.RoundingPolicy() = %v, want %v", got, RoundingPolicy{})
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			mode       RoundingMode
+			curr, incr string
+		}{
+			"mode 1":      {RoundingMode(-1), "CHF", "0.05"},
+			"mode 2":      {RoundingMode(100), "CHF", "0.05"},
+			"mode 3":      {Unnecessary, "CHF", "0.05"},
+			"currency 1":  {HalfEven, "EUR", "0.05"},
+			"increment 1": {HalfEven, "CHF", "-0.05"},
+			"increment 2": {HalfEven, "CHF", "0.005"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				defer ResetRoundingPolicy(CHF)
+				p := RoundingPolicy{Mode: tt.mode, Increment: MustParseAmount(tt.curr, tt.incr)}
+				err := SetRoundingPolicy(CHF, p)
+				if err == nil {
+					t.Errorf("SetRoundingPolicy(%v, %v) did not fail", CHF, p)
+				}
+			})
+		}
+	})
+}
+
+func TestAmount_ToCurr_RoundingPolicy(t *testing.T) {
+	tests := []struct {
+		curr      string
+		mode      RoundingMode
+		incr      string
+		a         string
+		wantRound string
+		wantCeil  string
+		wantFloor string
+		wantTrunc string
+	}{
+		// Default policy
+		{"USD", HalfEven, "0", "1.005", "1.00", "1.01", "1.00", "1.00"},
+		{"USD", HalfEven, "0", "-1.005", "-1.00", "-1.00", "-1.01", "-1.00"},
+		{"USD", HalfEven, "0", "1.015", "1.02", "1.02", "1.01", "1.01"},
+
+		// Rounding mode
+		{"USD", HalfUp, "0", "1.005", "1.01", "1.01", "1.00", "1.00"},
+		{"USD", HalfUp, "0", "-1.005", "-1.01", "-1.00", "-1.01", "-1.00"},
+		{"USD", HalfUp, "0", "1.004", "1.00", "1.01", "1.00", "1.00"},
+		{"USD", Up, "0", "1.001", "1.01", "1.01", "1.00", "1.00"},
+		{"USD", Up, "0", "-1.001", "-1.01", "-1.00", "-1.01", "-1.00"},
+		{"JPY", Down, "0", "1.9", "1", "2", "1", "1"},
+		{"JPY", Down, "0", "-1.9", "-1", "-1", "-2", "-1"},
+		{"JPY", Ceiling, "0", "-1.9", "-1", "-1", "-2", "-1"},
+		{"JPY", Floor, "0", "1.9", "1", "2", "1", "1"},
+
+		// Rounding increment
+		{"CHF", HalfEven, "0.05", "1.00", "1.00", "1.00", "1.00", "1.00"},
+		{"CHF", HalfEven, "0.05", "1.02", "1.00", "1.05", "1.00", "1.00"},
+		{"CHF", HalfEven, "0.05", "1.025", "1.00", "1.05", "1.00", "1.00"},
+		{"CHF", HalfEven, "0.05", "1.03", "1.05", "1.05", "1.00", "1.00"},
+		{"CHF", HalfEven, "0.05", "1.075", "1.10", "1.10", "1.05", "1.05"},
+		{"CHF", HalfEven, "0.05", "-1.03", "-1.05", "-1.00", "-1.05", "-1.00"},
+		{"CHF", HalfEven, "0.05", "0.01", "0.00", "0.05", "0.00", "0.00"},
+		{"CHF", HalfEven, "0.05", "-0.01", "0.00", "0.00", "-0.05", "0.00"},
+		{"CHF", HalfUp, "0.05", "1.025", "1.05", "1.05", "1.00", "1.00"},
+		{"CHF", HalfUp, "0.05", "-1.025", "-1.05", "-1.00", "-1.05", "-1.00"},
+		{"JPY", HalfEven, "10", "15", "20", "20", "10", "10"},
+		{"JPY", HalfEven, "10", "25", "20", "30", "20", "20"},
+
+		// Overflow
+		{"CHF", HalfEven, "0.05", "99999999999999999.99", "99999999999999999.99", "99999999999999999.99", "99999999999999999.95", "99999999999999999.95"},
+		{"CHF", HalfEven, "0.05", "-99999999999999999.99", "-99999999999999999.99", "-99999999999999999.95", "-99999999999999999.99", "-99999999999999999.95"},
+	}
+	for _, tt := range tests {
+		c := MustParseCurr(tt.curr)
+		p := RoundingPolicy{Mode: tt.mode, Increment: MustParseAmount(tt.curr, tt.incr)}
+		err := SetRoundingPolicy(c, p)
+		if err != nil {
+			t.Errorf("SetRoundingPolicy(%v, %v) failed: %v", c, p, err)
+			continue
+		}
+		a := MustParseAmount(tt.curr, tt.a)
+		got := a.RoundToCurr()
+		want := MustParseAmount(tt.curr, tt.wantRound)
+		if got != want {
+			t.Errorf("%q.RoundToCurr() with policy %v = %q, want %q", a, p, got, want)
+		}
+		got = a.CeilToCurr()
+		want = MustParseAmount(tt.curr, tt.wantCeil)
+		if got != want {
+			t.Errorf("%q.CeilToCurr() with policy %v = %q, want %q", a, p, got, want)
+		}
+		got = a.FloorToCurr()
+		want = MustParseAmount(tt.curr, tt.wantFloor)
+		if got != want {
+			t.Errorf("%q.FloorToCurr() with policy %v = %q, want %q", a, p, got, want)
+		}
+		got = a.TruncToCurr()
+		want = MustParseAmount(tt.curr, tt.wantTrunc)
+		if got != want {
+			t.Errorf("%q.TruncToCurr() with policy %v = %q, want %q", a, p, got, want)
+		}
+		ResetRoundingPolicy(c)
+	}
+}
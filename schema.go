@@ -0,0 +1,52 @@
+package money
+
+// Patterns of the textual forms of currencies and amounts.
+const (
+	currencyPattern = `^[A-Z]{3}$`
+	amountPattern   = `^[A-Z]{3} -?[0-9]+(\.[0-9]+)?$`
+)
+
+// JSONSchema returns JSON Schema fragments describing the textual forms of
+// currencies and amounts, keyed by type name:
+//
+//	| Key        | Form                                        | Example                          |
+//	| ---------- | ------------------------------------------- | -------------------------------- |
+//	| Currency   | alphabetic code, see [Currency.MarshalText] | "USD"                            |
+//	| Amount     | text produced by [Amount.String]            | "USD 5.67"                       |
+//	| JSONNumber | object produced by [JSONNumber.MarshalJSON] | {"currency":"USD","amount":5.67} |
+//
+// The fragments are compatible with OpenAPI 3.1 and JSON Schema 2020-12 and
+// can be registered as components by API frameworks, so monetary values
+// are advertised with their exact format.
+// The returned map is a new copy on each call and may be modified.
+func JSONSchema() map[string]any {
+	return map[string]any{
+		"Currency": map[string]any{
+			"type":        "string",
+			"pattern":     currencyPattern,
+			"description": "ISO 4217 alphabetic currency code.",
+			"examples":    []any{"USD", "JPY"},
+		},
+		"Amount": map[string]any{
+			"type":        "string",
+			"pattern":     amountPattern,
+			"description": "ISO 4217 alphabetic currency code followed by a space and a decimal value. Trailing zeros are significant.",
+			"examples":    []any{"USD 5.67", "JPY -100"},
+		},
+		"JSONNumber": map[string]any{
+			"type":     "object",
+			"required": []any{"currency", "amount"},
+			"properties": map[string]any{
+				"currency": map[string]any{
+					"type":    "string",
+					"pattern": currencyPattern,
+				},
+				"amount": map[string]any{
+					"type": "number",
+				},
+			},
+			"additionalProperties": false,
+			"description":          "Amount with the value encoded as a JSON number. Only values that survive a round trip through float64 are encoded.",
+		},
+	}
+}
@@ -0,0 +1,70 @@
+package money
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+)
+
+func TestJSONSchema(t *testing.T) {
+	schema := JSONSchema()
+	for _, key := range []string{"Currency", "Amount", "JSONNumber"} {
+		if _, ok := schema[key]; !ok {
+			t.Errorf("JSONSchema() does not contain %q", key)
+		}
+	}
+	if _, err := json.Marshal(schema); err != nil {
+		t.Errorf("json.Marshal(JSONSchema()) failed: %v", err)
+	}
+
+	// Copy
+	delete(schema, "Amount")
+	if _, ok := JSONSchema()["Amount"]; !ok {
+		t.Errorf("JSONSchema() returned a shared map")
+	}
+}
+
+func TestJSONSchema_Patterns(t *testing.T) {
+	t.Run("currency", func(t *testing.T) {
+		re := regexp.MustCompile(currencyPattern)
+		for _, c := range []Currency{XXX, USD, JPY, ZWL} {
+			text, err := c.MarshalText()
+			if err != nil {
+				t.Errorf("%v.MarshalText() failed: %v", c, err)
+				continue
+			}
+			if !re.Match(text) {
+				t.Errorf("%q does not match %q", text, currencyPattern)
+			}
+		}
+		for _, s := range []string{"usd", "840", "US", "USD "} {
+			if re.MatchString(s) {
+				t.Errorf("%q matches %q", s, currencyPattern)
+			}
+		}
+	})
+
+	t.Run("amount", func(t *testing.T) {
+		re := regexp.MustCompile(amountPattern)
+		tests := []struct {
+			curr, amount string
+		}{
+			{"USD", "0"},
+			{"USD", "5.670"},
+			{"USD", "-99999999999999999.99"},
+			{"JPY", "-5"},
+			{"USD", "0.0000000000000000001"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.curr, tt.amount)
+			if !re.MatchString(a.String()) {
+				t.Errorf("%q does not match %q", a, amountPattern)
+			}
+		}
+		for _, s := range []string{"USD", "USD5.67", "usd 5.67", "USD 5.", "USD .5", "USD +5"} {
+			if re.MatchString(s) {
+				t.Errorf("%q matches %q", s, amountPattern)
+			}
+		}
+	})
+}
@@ -1,9 +1,7 @@
 package main
 
 import (
-	"bufio"
 	"bytes"
-	"encoding/csv"
 	"fmt"
 	"go/format"
 	"os"
@@ -11,90 +9,73 @@ import (
 	"sort"
 	"strings"
 	"text/template"
-)
 
-type currency struct {
-	Name  string
-	Code  string
-	Num   string
-	Scale string
-}
+	"github.com/govalues/money/moneygen"
+)
 
 func main() {
 	// Open the input file and read its contents
-	data, err := readCsvFile(filepath.Join("scripts", "currency", "currency_data.csv"))
+	in, err := os.Open(filepath.Join("scripts", "currency", "currency_data.csv"))
 	if err != nil {
-		panic(fmt.Errorf("error reading CSV file: %v", err))
+		panic(fmt.Errorf("error opening CSV file: %w", err))
 	}
+	defer func() { _ = in.Close() }()
 
-	// Convert the CSV records to a list of Currency objects
-	currs := convertDataToCurrencies(data)
-
-	// Generate Go code from the Currency objects using a template
-	code, err := generateGoCode(filepath.Join("scripts", "currency", "currency_data.tmpl"), currs)
+	// Convert the CSV records to a list of currencies
+	currs, err := moneygen.ReadCSV(in)
 	if err != nil {
-		panic(fmt.Errorf("error generating Go code: %v", err))
+		panic(fmt.Errorf("error reading CSV file: %w", err))
 	}
-
-	// Write the generated Go code to a file
-	err = writeToFile("currency_data.go", code)
+	currs, err = sortCurrencies(currs)
 	if err != nil {
-		panic(fmt.Errorf("error writing to file: %v", err))
+		panic(fmt.Errorf("error sorting currencies: %w", err))
 	}
-}
 
-func readCsvFile(filename string) ([][]string, error) {
-	// Open the CSV file
-	in, err := os.Open(filename)
+	// Generate Go code from the currencies using a template
+	code, err := generateGoCode(filepath.Join("scripts", "currency", "currency_data.tmpl"), currs)
 	if err != nil {
-		return nil, err
+		panic(fmt.Errorf("error generating Go code: %w", err))
 	}
-	defer func() { _ = in.Close() }()
 
-	// Read the CSV records
-	reader := csv.NewReader(in)
-	_, err = reader.Read() // header
-	if err != nil {
-		return nil, err
-	}
-	recs, err := reader.ReadAll()
+	// Write the generated Go code to a file
+	err = os.WriteFile("currency_data.go", code, 0o644)
 	if err != nil {
-		return nil, err
+		panic(fmt.Errorf("error writing to file: %w", err))
 	}
-
-	return recs, nil
 }
 
-func convertDataToCurrencies(data [][]string) []currency {
-	// Sort the CSV records by currency code
-	less := func(i, j int) bool {
-		a := data[i][1]
-		b := data[j][1]
-		switch a {
+// sortCurrencies orders the currencies as in the built-in table:
+// XXX and XTS come first, followed by all other currencies sorted by code.
+func sortCurrencies(currs []moneygen.Currency) ([]moneygen.Currency, error) {
+	rank := func(code string) int {
+		switch code {
 		case "XXX":
-			return true
+			return 0
 		case "XTS":
-			return true
+			return 1
+		default:
+			return 2
+		}
+	}
+	sort.SliceStable(currs, func(i, j int) bool {
+		a, b := currs[i].Code, currs[j].Code
+		if ra, rb := rank(a), rank(b); ra != rb {
+			return ra < rb
 		}
 		return a < b
+	})
+	if len(currs) < 2 || currs[0].Code != "XXX" || currs[1].Code != "XTS" {
+		return nil, fmt.Errorf("currencies XXX and XTS are required")
 	}
-	sort.Slice(data, less)
-
-	// Convert the CSV records to Currency objects
-	currs := []currency{}
-	for _, rec := range data {
-		curr := currency{
-			Name:  rec[0],
-			Code:  rec[1],
-			Num:   rec[2],
-			Scale: rec[3],
+	for _, c := range currs {
+		if c.Num == "" {
+			return nil, fmt.Errorf("currency %v has no numeric code", c.Code)
 		}
-		currs = append(currs, curr)
 	}
-	return currs
+	return currs, nil
 }
 
-func generateGoCode(filename string, currs []currency) ([]byte, error) {
+func generateGoCode(filename string, currs []moneygen.Currency) ([]byte, error) {
 	// Create a new template object from the template file
 	fmap := template.FuncMap{
 		"lower": strings.ToLower,
@@ -112,28 +93,5 @@ func generateGoCode(filename string, currs []currency) ([]byte, error) {
 	}
 
 	// Format the output as Go code
-	formatted, err := format.Source(output.Bytes())
-	if err != nil {
-		return nil, err
-	}
-	return formatted, nil
-}
-
-func writeToFile(filename string, content []byte) error {
-	// Write the content to a file
-	out, err := os.Create(filename)
-	if err != nil {
-		return err
-	}
-	defer func() { _ = out.Close() }()
-	writer := bufio.NewWriter(out)
-	_, err = writer.Write(content)
-	if err != nil {
-		return err
-	}
-	err = writer.Flush()
-	if err != nil {
-		return err
-	}
-	return nil
+	return format.Source(output.Bytes())
 }
@@ -0,0 +1,90 @@
+package money
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// SQLLayout specifies how [SQLAmount] stores an amount in a single column.
+type SQLLayout int8
+
+const (
+	// SQLText stores the amount as the text produced by [Amount.String],
+	// for example, "USD 5.67".
+	SQLText SQLLayout = iota
+	// SQLComposite stores the amount as a Postgres composite type literal,
+	// for example, "(USD,5.67)".
+	SQLComposite
+	// SQLBinary stores the amount as the data produced by [Amount.MarshalBinary].
+	SQLBinary
+)
+
+// String implements the [fmt.Stringer] interface and returns
+// a string representation of the layout.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (l SQLLayout) String() string {
+	switch l {
+	case SQLText:
+		return "text"
+	case SQLComposite:
+		return "composite"
+	case SQLBinary:
+		return "binary"
+	default:
+		return fmt.Sprintf("SQLLayout(%d)", int8(l))
+	}
+}
+
+// SQLAmount is an adapter that lets ORMs and query builders, such as sqlx or
+// sqlc, bind an amount to a single column without custom types in each
+// project.
+// Values are written using the layout and scanned from any of the layouts.
+// The zero value uses the [SQLText] layout.
+// For example:
+//
+//	type Product struct {
+//		Price money.SQLAmount `db:"price"`
+//	}
+type SQLAmount struct {
+	Amount Amount
+	Layout SQLLayout
+}
+
+// Scan implements the [sql.Scanner] interface.
+// It accepts strings and byte slices in any of the layouts regardless of
+// the layout of the adapter.
+// See also method [Amount.Scan].
+//
+// [sql.Scanner]: https://pkg.go.dev/database/sql#Scanner
+func (s *SQLAmount) Scan(value any) error {
+	if b, ok := value.([]byte); ok && len(b) > 0 && b[0] == EncodingVersion {
+		a, err := DecodeAny(b)
+		if err != nil {
+			return err
+		}
+		s.Amount = a
+		return nil
+	}
+	return s.Amount.Scan(value)
+}
+
+// Value implements the [driver.Valuer] interface.
+// It encodes the amount using the layout of the adapter.
+//
+// Value returns an error if the layout is not valid.
+//
+// [driver.Valuer]: https://pkg.go.dev/database/sql/driver#Valuer
+func (s SQLAmount) Value() (driver.Value, error) {
+	a := s.Amount
+	switch s.Layout {
+	case SQLText:
+		return a.String(), nil
+	case SQLComposite:
+		return "(" + a.Curr().Code() + "," + a.Decimal().String() + ")", nil
+	case SQLBinary:
+		return a.MarshalBinary()
+	default:
+		return nil, fmt.Errorf("converting %v: invalid layout %v", a, s.Layout)
+	}
+}
@@ -0,0 +1,124 @@
+package money
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+)
+
+func TestSQLAmount_Interfaces(t *testing.T) {
+	var i any = SQLAmount{}
+	_, ok := i.(driver.Valuer)
+	if !ok {
+		t.Errorf("%T does not implement driver.Valuer", i)
+	}
+
+	i = &SQLAmount{}
+	_, ok = i.(sql.Scanner)
+	if !ok {
+		t.Errorf("%T does not implement sql.Scanner", i)
+	}
+}
+
+func TestSQLLayout_String(t *testing.T) {
+	tests := []struct {
+		layout SQLLayout
+		want   string
+	}{
+		{SQLText, "text"},
+		{SQLComposite, "composite"},
+		{SQLBinary, "binary"},
+		{SQLLayout(-1), "SQLLayout(-1)"},
+	}
+	for _, tt := range tests {
+		got := tt.layout.String()
+		if got != tt.want {
+			t.Errorf("%v.String() = %q, want %q", int8(tt.layout), got, tt.want)
+		}
+	}
+}
+
+func TestSQLAmount_Value(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, amount string
+			layout       SQLLayout
+			want         driver.Value
+		}{
+			{"USD", "5.67", SQLText, "USD 5.67"},
+			{"USD", "5.670", SQLComposite, "(USD,5.670)"},
+			{"JPY", "-5", SQLComposite, "(JPY,-5)"},
+			{"USD", "5.67", SQLBinary, []byte("\x01USD5.67")},
+		}
+		for _, tt := range tests {
+			s := SQLAmount{Amount: MustParseAmount(tt.curr, tt.amount), Layout: tt.layout}
+			got, err := s.Value()
+			if err != nil {
+				t.Errorf("%v.Value() failed: %v", s, err)
+				continue
+			}
+			if b, ok := got.([]byte); ok {
+				got = string(b)
+			}
+			want := tt.want
+			if b, ok := want.([]byte); ok {
+				want = string(b)
+			}
+			if got != want {
+				t.Errorf("%v.Value() = %q, want %q", s, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		s := SQLAmount{Amount: MustParseAmount("USD", "5.67"), Layout: SQLLayout(3)}
+		_, err := s.Value()
+		if err == nil {
+			t.Errorf("%v.Value() did not fail", s)
+		}
+	})
+}
+
+func TestSQLAmount_Scan(t *testing.T) {
+	t.Run("roundtrip", func(t *testing.T) {
+		layouts := []SQLLayout{SQLText, SQLComposite, SQLBinary}
+		amounts := MustParseAmountSlice("USD", []string{"0", "5.67", "-5.670", "99999999999999999.99"})
+		for _, layout := range layouts {
+			for _, a := range amounts {
+				s := SQLAmount{Amount: a, Layout: layout}
+				v, err := s.Value()
+				if err != nil {
+					t.Errorf("%v.Value() failed: %v", s, err)
+					continue
+				}
+				var got SQLAmount
+				err = got.Scan(v)
+				if err != nil {
+					t.Errorf("Scan(%q) failed: %v", v, err)
+					continue
+				}
+				if got.Amount != a {
+					t.Errorf("Scan(%q) = %q, want %q", v, got.Amount, a)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []any{
+			nil,
+			int64(5),
+			"",
+			"USD",
+			[]byte("\x01US"),
+			[]byte("\x02USD5.67"),
+		}
+		for _, value := range tests {
+			var got SQLAmount
+			err := got.Scan(value)
+			if err == nil {
+				t.Errorf("Scan(%q) did not fail", value)
+			}
+		}
+	})
+}
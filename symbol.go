@@ -0,0 +1,240 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/govalues/decimal"
+)
+
+// symbolLookup maps currency symbols to currencies.
+// Symbols shared by several currencies are mapped to the most widely used one,
+// for example, "$" is mapped to US Dollar and "¥" to Japanese Yen.
+var symbolLookup = map[string]Currency{
+	"$":   USD,
+	"US$": USD,
+	"€":   EUR,
+	"£":   GBP,
+	"¥":   JPY,
+	"CN¥": CNY,
+	"₹":   INR,
+	"₩":   KRW,
+	"₽":   RUB,
+	"₺":   TRY,
+	"₪":   ILS,
+	"₫":   VND,
+	"₴":   UAH,
+	"฿":   THB,
+	"₦":   NGN,
+	"₱":   PHP,
+	"zł":  PLN,
+	"R$":  BRL,
+	"CA$": CAD,
+	"A$":  AUD,
+	"MX$": MXN,
+	"Fr.": CHF,
+}
+
+// SymbolOptions specifies how [ParseAmountWithSymbol] interprets its input.
+// The zero value corresponds to a decimal point and the default symbol table.
+type SymbolOptions struct {
+	// DecimalMark is the character separating the integer and the fractional
+	// parts, either '.' or ','.
+	// The other character, spaces, and apostrophes are treated as digit
+	// group separators, which may only appear in the integer part between
+	// groups of three digits.
+	// The zero value means '.'.
+	DecimalMark rune
+	// Symbols overrides or extends the default symbol table,
+	// for example, {"$": CAD} for Canadian input.
+	Symbols map[string]Currency
+}
+
+// ParseAmountWithSymbol converts a human-entered or scraped price string,
+// such as "€1.234,56", "$ 5.00", or "1 234,56 zł", to an amount, inferring
+// the currency from a leading or trailing currency symbol.
+// The minus sign may precede or follow a leading symbol.
+// If the scale of the amount is less than the scale of the currency, the result
+// will be zero-padded to the right.
+// See also constructor [ParseAmount].
+//
+// ParseAmountWithSymbol returns an error if:
+//   - the decimal mark is neither '.' nor ',';
+//   - the string does not start or end with a known currency symbol;
+//   - the string both starts and ends with a currency symbol;
+//   - a digit group separator follows the decimal mark, for example,
+//     "€1.234,56" with the default decimal point;
+//   - a digit group other than the first one does not have exactly 3 digits;
+//   - the remaining string is not a valid decimal;
+//   - the integer part of the result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func ParseAmountWithSymbol(s string, opts SymbolOptions) (Amount, error) {
+	a, err := parseAmountWithSymbol(s, opts)
+	if err != nil {
+		return Amount{}, fmt.Errorf("parsing %q: %w", s, err)
+	}
+	return a, nil
+}
+
+func parseAmountWithSymbol(s string, opts SymbolOptions) (Amount, error) {
+	num, c, err := normalizeAmountString(s, opts)
+	if err != nil {
+		return Amount{}, err
+	}
+	if c == XXX {
+		return Amount{}, fmt.Errorf("unknown currency symbol")
+	}
+	return ParseAmount(c.Code(), num)
+}
+
+// NormalizeAmountString prepares human-entered input, such as a form field
+// value, for parsing by [ParseAmount].
+// It removes a leading or trailing currency symbol, digit group separators,
+// and spaces, and replaces the decimal mark with a decimal point, for example,
+// "€1.234,56" with a decimal comma is normalized to "1234.56" and [EUR].
+// The options are interpreted as in [ParseAmountWithSymbol].
+// If the input has no currency symbol, [XXX] is returned as the currency.
+// Trailing zeros are preserved, and the normalized string is returned
+// unchanged if normalized again with the default options.
+//
+// NormalizeAmountString returns an error if:
+//   - the decimal mark is neither '.' nor ',';
+//   - the input both starts and ends with a currency symbol;
+//   - a digit group separator follows the decimal mark;
+//   - a digit group other than the first one does not have exactly 3 digits;
+//   - the input has more than one sign;
+//   - the normalized string is not a valid decimal.
+func NormalizeAmountString(s string, opts SymbolOptions) (string, Currency, error) {
+	num, c, err := normalizeAmountString(s, opts)
+	if err != nil {
+		return "", XXX, fmt.Errorf("normalizing %q: %w", s, err)
+	}
+	return num, c, nil
+}
+
+func normalizeAmountString(s string, opts SymbolOptions) (string, Currency, error) {
+	// Decimal mark
+	mark := opts.DecimalMark
+	if mark == 0 {
+		mark = '.'
+	}
+	var group rune
+	switch mark {
+	case '.':
+		group = ','
+	case ',':
+		group = '.'
+	default:
+		return "", XXX, fmt.Errorf("invalid decimal mark %q", mark)
+	}
+
+	// Leading sign
+	s = strings.TrimSpace(s)
+	neg := false
+	if strings.HasPrefix(s, "-") {
+		neg = true
+		s = s[1:]
+	}
+
+	// Currency symbol
+	c, t, ok, err := cutSymbol(s, opts.Symbols)
+	if err != nil {
+		return "", XXX, err
+	}
+	if ok {
+		s = t
+	}
+
+	// Digit groups
+	isSep := func(r rune) bool {
+		switch r {
+		case group, ' ', '\u00a0', '\u202f', '\'':
+			return true
+		default:
+			return false
+		}
+	}
+	intPart, frac, hasFrac := strings.Cut(s, string(mark))
+	if i := strings.IndexFunc(frac, isSep); i >= 0 {
+		r, _ := utf8.DecodeRuneInString(frac[i:])
+		if r == group {
+			return "", XXX, fmt.Errorf("group separator %q follows decimal mark %q, check the decimal mark option", r, mark)
+		}
+		return "", XXX, fmt.Errorf("group separator %q follows decimal mark %q", r, mark)
+	}
+	if strings.IndexFunc(intPart, isSep) >= 0 {
+		digits := strings.TrimLeft(intPart, "+-")
+		var groups []string
+		start := 0
+		for i, r := range digits {
+			if isSep(r) {
+				groups = append(groups, digits[start:i])
+				start = i + utf8.RuneLen(r)
+			}
+		}
+		groups = append(groups, digits[start:])
+		for i, g := range groups {
+			if g == "" || len(g) > 3 || i > 0 && len(g) < 3 {
+				return "", XXX, fmt.Errorf("digit group %q must have 3 digits", g)
+			}
+		}
+	}
+
+	// Decimal
+	num := strings.Map(func(r rune) rune {
+		if isSep(r) {
+			return -1
+		}
+		return r
+	}, intPart)
+	if hasFrac {
+		num += "." + frac
+	}
+	if neg {
+		if strings.HasPrefix(num, "-") || strings.HasPrefix(num, "+") {
+			return "", XXX, fmt.Errorf("duplicate sign")
+		}
+		num = "-" + num
+	}
+	if _, err := decimal.Parse(num); err != nil {
+		return "", XXX, err
+	}
+	return num, c, nil
+}
+
+// cutSymbol removes the longest known currency symbol from the beginning or
+// the end of the string and returns the corresponding currency.
+// At most one symbol of each length can match at either end, so the result
+// does not depend on the order of map iteration.
+// It returns an error if symbols match at both ends of the string.
+func cutSymbol(s string, symbols map[string]Currency) (Currency, string, bool, error) {
+	var pre, suf Currency
+	preRest, sufRest, preLen, sufLen := "", "", 0, 0
+	try := func(sym string, c Currency) {
+		if t, ok := strings.CutPrefix(s, sym); ok && len(sym) > preLen {
+			pre, preRest, preLen = c, t, len(sym)
+		}
+		if t, ok := strings.CutSuffix(s, sym); ok && len(sym) > sufLen {
+			suf, sufRest, sufLen = c, t, len(sym)
+		}
+	}
+	for sym, c := range symbolLookup {
+		if _, ok := symbols[sym]; !ok {
+			try(sym, c)
+		}
+	}
+	for sym, c := range symbols {
+		try(sym, c)
+	}
+	switch {
+	case preLen > 0 && sufLen > 0:
+		return XXX, "", false, fmt.Errorf("currency symbols at both the beginning and the end")
+	case preLen > 0:
+		return pre, strings.TrimSpace(preRest), true, nil
+	case sufLen > 0:
+		return suf, strings.TrimSpace(sufRest), true, nil
+	default:
+		return XXX, "", false, nil
+	}
+}
@@ -0,0 +1,148 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestParseAmountWithSymbol(t *testing.T) {
+	comma := SymbolOptions{DecimalMark: ','}
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s          string
+			opts       SymbolOptions
+			curr, want string
+		}{
+			{"$5", SymbolOptions{}, "USD", "5.00"},
+			{"$ 1,234.56", SymbolOptions{}, "USD", "1234.56"},
+			{"US$1.5", SymbolOptions{}, "USD", "1.50"},
+			{"-$5.25", SymbolOptions{}, "USD", "-5.25"},
+			{"$-5.25", SymbolOptions{}, "USD", "-5.25"},
+			{"€1.234,56", comma, "EUR", "1234.56"},
+			{"1.234,56 €", comma, "EUR", "1234.56"},
+			{"1 234,56 zł", comma, "PLN", "1234.56"},
+			{"1\u00a0234,56\u00a0zł", comma, "PLN", "1234.56"},
+			{"£0.99", SymbolOptions{}, "GBP", "0.99"},
+			{"¥1,000", SymbolOptions{}, "JPY", "1000"},
+			{"CN¥1,000", SymbolOptions{}, "CNY", "1000.00"},
+			{"R$ 10,50", comma, "BRL", "10.50"},
+			{"CHF 1'234.50", SymbolOptions{Symbols: map[string]Currency{"CHF": CHF}}, "CHF", "1234.50"},
+			{"$5", SymbolOptions{Symbols: map[string]Currency{"$": CAD}}, "CAD", "5.00"},
+			{"5 CA$", SymbolOptions{}, "CAD", "5.00"},
+		}
+		for _, tt := range tests {
+			got, err := ParseAmountWithSymbol(tt.s, tt.opts)
+			if err != nil {
+				t.Errorf("ParseAmountWithSymbol(%q, %v) failed: %v", tt.s, tt.opts, err)
+				continue
+			}
+			want := MustParseAmount(tt.curr, tt.want)
+			if got != want {
+				t.Errorf("ParseAmountWithSymbol(%q, %v) = %q, want %q", tt.s, tt.opts, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			s    string
+			opts SymbolOptions
+		}{
+			"mark 1":    {"$5", SymbolOptions{DecimalMark: ';'}},
+			"symbol 1":  {"5", SymbolOptions{}},
+			"symbol 2":  {"", SymbolOptions{}},
+			"symbol 3":  {"USD 5", SymbolOptions{}},
+			"decimal 1": {"$", SymbolOptions{}},
+			"decimal 2": {"$5.00.00", SymbolOptions{}},
+			"both 1":    {"€5zł", SymbolOptions{}},
+			"both 2":    {"$5€", SymbolOptions{}},
+			"both 3":    {"X10", SymbolOptions{Symbols: map[string]Currency{"X": CAD, "0": AUD}}},
+			"sign 1":    {"-$-5", SymbolOptions{}},
+			"overflow":  {"$1,000,000,000,000,000,000", SymbolOptions{}},
+			"group 1":   {"€1.234,56", SymbolOptions{}},
+			"group 2":   {"$1,2,3,4.5", SymbolOptions{}},
+			"group 3":   {"$1.234,5", SymbolOptions{}},
+			"group 4":   {"$1,23.45", SymbolOptions{}},
+			"group 5":   {"$1234,567.8", SymbolOptions{}},
+			"group 6":   {"$1,,234", SymbolOptions{}},
+			"group 7":   {"$,234", SymbolOptions{}},
+			"group 8":   {"€1.234.5", comma},
+			"group 9":   {"€1,234.56", comma},
+			"group 10":  {"$1.234 5", SymbolOptions{}},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := ParseAmountWithSymbol(tt.s, tt.opts)
+				if err == nil {
+					t.Errorf("ParseAmountWithSymbol(%q, %v) did not fail", tt.s, tt.opts)
+				}
+			})
+		}
+	})
+}
+
+func TestNormalizeAmountString(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s        string
+			opts     SymbolOptions
+			want     string
+			wantCurr Currency
+		}{
+			{"€1.234,56", SymbolOptions{DecimalMark: ','}, "1234.56", EUR},
+			{"$ 5.00", SymbolOptions{}, "5.00", USD},
+			{"-$5", SymbolOptions{}, "-5", USD},
+			{"1 234,50 zł", SymbolOptions{DecimalMark: ','}, "1234.50", PLN},
+			{"1'234.5", SymbolOptions{}, "1234.5", XXX},
+			{"  -1,234.56 ", SymbolOptions{}, "-1234.56", XXX},
+			{"-1234.56", SymbolOptions{}, "-1234.56", XXX},
+			{"$5", SymbolOptions{Symbols: map[string]Currency{"$": CAD}}, "5", CAD},
+		}
+		for _, tt := range tests {
+			got, gotCurr, err := NormalizeAmountString(tt.s, tt.opts)
+			if err != nil {
+				t.Errorf("NormalizeAmountString(%q, %v) failed: %v", tt.s, tt.opts, err)
+				continue
+			}
+			if got != tt.want || gotCurr != tt.wantCurr {
+				t.Errorf("NormalizeAmountString(%q, %v) = %q, %v, want %q, %v", tt.s, tt.opts, got, gotCurr, tt.want, tt.wantCurr)
+				continue
+			}
+			again, _, err := NormalizeAmountString(got, SymbolOptions{})
+			if err != nil {
+				t.Errorf("NormalizeAmountString(%q, {}) failed: %v", got, err)
+				continue
+			}
+			if again != got {
+				t.Errorf("NormalizeAmountString(%q, {}) = %q, want %q", got, again, got)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			s    string
+			opts SymbolOptions
+		}{
+			"mark 1":    {"5", SymbolOptions{DecimalMark: ';'}},
+			"decimal 1": {"", SymbolOptions{}},
+			"decimal 2": {"$", SymbolOptions{}},
+			"decimal 3": {"5.00.00", SymbolOptions{}},
+			"decimal 4": {"USD 5", SymbolOptions{}},
+			"both 1":    {"€5zł", SymbolOptions{}},
+			"both 2":    {"X10", SymbolOptions{Symbols: map[string]Currency{"X": CAD, "0": AUD}}},
+			"sign 1":    {"-$-5", SymbolOptions{}},
+			"group 1":   {"€1.234,56", SymbolOptions{}},
+			"group 2":   {"$1,2,3,4.5", SymbolOptions{}},
+			"group 3":   {"1,234.5", SymbolOptions{DecimalMark: ','}},
+			"group 4":   {"12'34", SymbolOptions{}},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, _, err := NormalizeAmountString(tt.s, tt.opts)
+				if err == nil {
+					t.Errorf("NormalizeAmountString(%q, %v) did not fail", tt.s, tt.opts)
+				}
+			})
+		}
+	})
+}
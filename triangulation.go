@@ -0,0 +1,96 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// TriangulationScale is the number of decimal places to which the intermediate
+// euro amount is rounded by [ConvertViaEUR].
+// Council Regulation (EC) No 1103/97 requires at least three decimal places.
+const TriangulationScale = 3
+
+// ConvertViaEUR converts an amount from one legacy national currency unit
+// to another following the triangulation rules of Council Regulation (EC)
+// No 1103/97:
+//
+//   - the amount is converted to euros by dividing it by the official
+//     conversion rate, inverse rates are never used;
+//   - the euro amount is rounded half up to [TriangulationScale] decimal places;
+//   - the euro amount is converted to the target currency by multiplying it
+//     by the official conversion rate of the target currency and rounded
+//     half up, only once, to the scale of the target currency.
+//
+// Both rates must be quoted as one euro expressed in the legacy currency,
+// for example, "EUR/DEM 1.95583" and "EUR/FRF 6.55957".
+// Legacy currencies are not included in this package and must be added
+// using [RegisterCurr] before the rates can be constructed.
+// All calculations are exact, so the result does not depend on the magnitude
+// of the amount.
+//
+// ConvertViaEUR returns an error if:
+//   - the base currency of either rate is not EUR;
+//   - the quote currency of either rate is EUR;
+//   - the currency of the amount does not match the quote currency of legacyRate;
+//   - either rate is not positive or has more than 6 significant digits;
+//   - the result exceeds the maximum precision of [decimal.Decimal].
+func ConvertViaEUR(a Amount, legacyRate, targetRate ExchangeRate) (Amount, error) {
+	b, err := convertViaEUR(a, legacyRate, targetRate)
+	if err != nil {
+		return Amount{}, fmt.Errorf("converting %v via %v and %v: %w", a, legacyRate, targetRate, err)
+	}
+	return b, nil
+}
+
+func convertViaEUR(a Amount, legacyRate, targetRate ExchangeRate) (Amount, error) {
+	for _, r := range []ExchangeRate{legacyRate, targetRate} {
+		if r.Base() != EUR {
+			return Amount{}, fmt.Errorf("base currency of %v is not %v", r, EUR)
+		}
+		if r.Quote() == EUR {
+			return Amount{}, fmt.Errorf("quote currency of %v is %v", r, EUR)
+		}
+		if !r.IsPos() {
+			return Amount{}, fmt.Errorf("%v is not positive", r)
+		}
+		if r.Decimal().Trim(0).Prec() > 6 {
+			return Amount{}, fmt.Errorf("%v has more than 6 significant digits", r)
+		}
+	}
+	if a.Curr() != legacyRate.Quote() {
+		return Amount{}, mismatchError(legacyRate.Quote(), a.Curr())
+	}
+
+	// Legacy currency to euro: a / rate, rounded to TriangulationScale
+	ad, ld := a.Decimal(), legacyRate.Decimal()
+	num := new(big.Int).SetUint64(ad.Coef())
+	num.Mul(num, pow10Big(ld.Scale()+TriangulationScale))
+	den := new(big.Int).SetUint64(ld.Coef())
+	den.Mul(den, pow10Big(ad.Scale()))
+	eur := quoHalfUp(num, den)
+
+	// Euro to target currency: eur * rate, rounded to the scale of the target
+	q, td := targetRate.Quote(), targetRate.Decimal()
+	num = eur.Mul(eur, new(big.Int).SetUint64(td.Coef()))
+	den = pow10Big(TriangulationScale + td.Scale() - q.Scale())
+	res := quoHalfUp(num, den)
+	if a.IsNeg() {
+		res.Neg(res)
+	}
+
+	d, err := bigDecimalExact(res, q.Scale())
+	if err != nil {
+		return Amount{}, err
+	}
+	return newAmountSafe(q, d)
+}
+
+// quoHalfUp returns the quotient of non-negative integers x and y rounded
+// to the nearest integer, with ties rounded away from zero.
+func quoHalfUp(x, y *big.Int) *big.Int {
+	quo, rem := new(big.Int).QuoRem(x, y, new(big.Int))
+	if rem.Lsh(rem, 1).Cmp(y) >= 0 {
+		quo.Add(quo, big.NewInt(1))
+	}
+	return quo
+}
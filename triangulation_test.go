@@ -0,0 +1,105 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestConvertViaEUR(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		defer resetCurrencies()
+		legacy := []struct {
+			code  string
+			scale int
+			rate  string
+		}{
+			{"BEF", 0, "40.3399"},
+			{"DEM", 2, "1.95583"},
+			{"ESP", 0, "166.386"},
+			{"FRF", 2, "6.55957"},
+			{"GRD", 0, "340.750"},
+			{"IEP", 2, "0.787564"},
+			{"ITL", 0, "1936.27"},
+			{"NLG", 2, "2.20371"},
+		}
+		rates := make(map[string]ExchangeRate, len(legacy))
+		for _, l := range legacy {
+			if _, err := RegisterCurr(l.code, l.scale); err != nil {
+				t.Fatalf("RegisterCurr(%q, %v) failed: %v", l.code, l.scale, err)
+			}
+			rates[l.code] = MustParseExchRate("EUR", l.code, l.rate)
+		}
+		tests := []struct {
+			from, a, to, want string
+		}{
+			// Golden values, direct cross rates give "335.39" and "212.74"
+			{"DEM", "100.00", "FRF", "335.38"},
+			{"DEM", "63.43", "FRF", "212.73"},
+			{"ITL", "1000000", "DEM", "1010.10"},
+			{"IEP", "1.00", "NLG", "2.80"},
+			{"FRF", "-250.50", "DEM", "-74.69"},
+			{"BEF", "5", "ITL", "240"},
+			{"NLG", "12345.67", "ESP", "932131"},
+			{"DEM", "0.01", "ITL", "10"},
+			{"GRD", "1000", "FRF", "19.25"},
+			{"DEM", "0.00", "FRF", "0.00"},
+			{"DEM", "0.00001", "FRF", "0.00"},
+			{"DEM", "1.95583", "DEM", "1.96"},
+			{"ITL", "9999999999999999999", "FRF", "33877351815604228.75"},
+		}
+		for _, tt := range tests {
+			a := MustParseAmount(tt.from, tt.a)
+			got, err := ConvertViaEUR(a, rates[tt.from], rates[tt.to])
+			if err != nil {
+				t.Errorf("ConvertViaEUR(%q, %q, %q) failed: %v", a, rates[tt.from], rates[tt.to], err)
+				continue
+			}
+			want := MustParseAmount(tt.to, tt.want)
+			if got != want {
+				t.Errorf("ConvertViaEUR(%q, %q, %q) = %q, want %q", a, rates[tt.from], rates[tt.to], got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		defer resetCurrencies()
+		legacy := []struct {
+			code  string
+			scale int
+			rate  string
+		}{
+			{"BEF", 0, "40.3399"},
+			{"DEM", 2, "1.95583"},
+			{"ESP", 0, "166.386"},
+			{"FRF", 2, "6.55957"},
+			{"GRD", 0, "340.750"},
+			{"IEP", 2, "0.787564"},
+			{"ITL", 0, "1936.27"},
+			{"NLG", 2, "2.20371"},
+		}
+		rates := make(map[string]ExchangeRate, len(legacy))
+		for _, l := range legacy {
+			if _, err := RegisterCurr(l.code, l.scale); err != nil {
+				t.Fatalf("RegisterCurr(%q, %v) failed: %v", l.code, l.scale, err)
+			}
+			rates[l.code] = MustParseExchRate("EUR", l.code, l.rate)
+		}
+		tests := map[string]struct {
+			a              Amount
+			legacy, target ExchangeRate
+		}{
+			"base 1":     {MustParseAmount("DEM", "1"), MustParseExchRate("USD", "DEM", "1.95583"), rates["FRF"]},
+			"base 2":     {MustParseAmount("DEM", "1"), rates["DEM"], MustParseExchRate("USD", "FRF", "6.55957")},
+			"quote 1":    {MustParseAmount("EUR", "1"), MustParseExchRate("EUR", "EUR", "1"), rates["FRF"]},
+			"quote 2":    {MustParseAmount("DEM", "1"), rates["DEM"], MustParseExchRate("EUR", "EUR", "1")},
+			"mismatch 1": {MustParseAmount("FRF", "1"), rates["DEM"], rates["FRF"]},
+			"digits 1":   {MustParseAmount("DEM", "1"), MustParseExchRate("EUR", "DEM", "1.955830001"), rates["FRF"]},
+			"digits 2":   {MustParseAmount("DEM", "1"), rates["DEM"], MustParseExchRate("EUR", "FRF", "6.559570001")},
+		}
+		for name, tt := range tests {
+			_, err := ConvertViaEUR(tt.a, tt.legacy, tt.target)
+			if err == nil {
+				t.Errorf("ConvertViaEUR(%q, %q, %q) did not fail: %v", tt.a, tt.legacy, tt.target, name)
+			}
+		}
+	})
+}
@@ -0,0 +1,67 @@
+package money
+
+// unitNames holds the singular and plural English names of the major and
+// minor units of currencies, for example, "dollar", "dollars", "cent", "cents".
+// Currencies without a minor unit have empty minor unit names.
+var unitNames = map[Currency][4]string{
+	AUD: {"dollar", "dollars", "cent", "cents"},
+	BRL: {"real", "reais", "centavo", "centavos"},
+	CAD: {"dollar", "dollars", "cent", "cents"},
+	CHF: {"franc", "francs", "centime", "centimes"},
+	CNY: {"yuan", "yuan", "fen", "fen"},
+	DKK: {"krone", "kroner", "øre", "øre"},
+	EUR: {"euro", "euros", "cent", "cents"},
+	GBP: {"pound", "pounds", "penny", "pence"},
+	HKD: {"dollar", "dollars", "cent", "cents"},
+	ILS: {"shekel", "shekels", "agora", "agorot"},
+	INR: {"rupee", "rupees", "paisa", "paise"},
+	JPY: {"yen", "yen", "", ""},
+	KRW: {"won", "won", "", ""},
+	MXN: {"peso", "pesos", "centavo", "centavos"},
+	NOK: {"krone", "kroner", "øre", "øre"},
+	NZD: {"dollar", "dollars", "cent", "cents"},
+	PLN: {"złoty", "złoty", "grosz", "groszy"},
+	RUB: {"ruble", "rubles", "kopeck", "kopecks"},
+	SEK: {"krona", "kronor", "öre", "öre"},
+	SGD: {"dollar", "dollars", "cent", "cents"},
+	THB: {"baht", "baht", "satang", "satang"},
+	TRY: {"lira", "lira", "kuruş", "kuruş"},
+	USD: {"dollar", "dollars", "cent", "cents"},
+	ZAR: {"rand", "rand", "cent", "cents"},
+}
+
+// UnitName returns the English name of the major unit of the currency in
+// the grammatical number matching n, for example, "dollar" for 1 and
+// "dollars" for 3, so that human-readable sentences such as
+// "You will pay 3 dollars and 5 cents" can be built.
+// The singular is used if n is 1 or -1.
+// If the name of the unit is not known, the alphabetic code is returned.
+// See also method [Currency.MinorUnitName].
+func (c Currency) UnitName(n int64) string {
+	names, ok := unitNames[c]
+	if !ok {
+		return c.Code()
+	}
+	if n == 1 || n == -1 {
+		return names[0]
+	}
+	return names[1]
+}
+
+// MinorUnitName returns the English name of the minor unit of the currency
+// in the grammatical number matching n, for example, "cent" for 1 and
+// "cents" for 5.
+// The singular is used if n is 1 or -1.
+// If the currency has no minor unit or the name of the unit is not known,
+// an empty string is returned.
+// See also method [Currency.UnitName].
+func (c Currency) MinorUnitName(n int64) string {
+	names, ok := unitNames[c]
+	if !ok {
+		return ""
+	}
+	if n == 1 || n == -1 {
+		return names[2]
+	}
+	return names[3]
+}
@@ -0,0 +1,56 @@
+package money
+
+import "testing"
+
+func TestCurrency_UnitName(t *testing.T) {
+	tests := []struct {
+		c    Currency
+		n    int64
+		want string
+	}{
+		{USD, 1, "dollar"},
+		{USD, -1, "dollar"},
+		{USD, 0, "dollars"},
+		{USD, 3, "dollars"},
+		{GBP, 2, "pounds"},
+		{JPY, 1, "yen"},
+		{JPY, 100, "yen"},
+		{BRL, 2, "reais"},
+		{XCD, 2, "XCD"},
+		{XXX, 1, "XXX"},
+	}
+	for _, tt := range tests {
+		if got := tt.c.UnitName(tt.n); got != tt.want {
+			t.Errorf("%v.UnitName(%v) = %q, want %q", tt.c, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestCurrency_MinorUnitName(t *testing.T) {
+	tests := []struct {
+		c    Currency
+		n    int64
+		want string
+	}{
+		{USD, 1, "cent"},
+		{USD, 5, "cents"},
+		{GBP, 1, "penny"},
+		{GBP, 2, "pence"},
+		{INR, 50, "paise"},
+		{JPY, 1, ""},
+		{XCD, 2, ""},
+	}
+	for _, tt := range tests {
+		if got := tt.c.MinorUnitName(tt.n); got != tt.want {
+			t.Errorf("%v.MinorUnitName(%v) = %q, want %q", tt.c, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestCurrency_UnitName_scale(t *testing.T) {
+	for c, names := range unitNames {
+		if hasMinor := names[2] != ""; hasMinor != (c.Scale() > 0) {
+			t.Errorf("%v has scale %v, but minor unit name is %q", c, c.Scale(), names[2])
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package money
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// TestVector represents the canonical encodings of an amount.
+// Test vectors are intended for conformance testing of implementations
+// in other languages that exchange amounts with this package.
+// See also functions [TestVectors] and [Verify].
+type TestVector struct {
+	// Amount is the encoded amount.
+	Amount Amount
+	// String is the text produced by [Amount.String].
+	String string
+	// Binary is the data produced by [Amount.MarshalBinary].
+	Binary []byte
+	// JSON is the data produced by [JSONNumber.MarshalJSON], or empty if
+	// the amount cannot be encoded as a JSON number.
+	JSON string
+}
+
+// testVectorLookup lists representative amounts covering edge cases, such as
+// trailing zeros, the maximum scale, extreme values, and currencies
+// with different scales.
+var testVectorLookup = [...]struct {
+	curr, amount string
+}{
+	{"USD", "0"},
+	{"USD", "0.01"},
+	{"USD", "-0.01"},
+	{"USD", "1.23"},
+	{"USD", "-1.23"},
+	{"USD", "1.230"},
+	{"USD", "1.2300000000000000000"},
+	{"USD", "0.0000000000000000001"},
+	{"USD", "99999999999999999.99"},
+	{"USD", "-99999999999999999.99"},
+	{"JPY", "0"},
+	{"JPY", "100"},
+	{"JPY", "1.5"},
+	{"OMR", "0.001"},
+	{"OMR", "-1"},
+	{"EUR", "9007199254740992"},
+	{"EUR", "9007199254740993"},
+	{"XXX", "1"},
+}
+
+// TestVectors returns the canonical encodings of representative amounts.
+// Implementations in other languages can use them to check that they
+// encode and decode amounts exactly like this package, including the scale
+// and trailing zeros.
+// The returned slice is a new copy on each call.
+// See also function [Verify].
+func TestVectors() []TestVector {
+	res := make([]TestVector, len(testVectorLookup))
+	for i, tt := range testVectorLookup {
+		a := MustParseAmount(tt.curr, tt.amount)
+		v, err := newTestVector(a)
+		if err != nil {
+			panic(fmt.Sprintf("newTestVector(%v) failed: %v", a, err))
+		}
+		res[i] = v
+	}
+	return res
+}
+
+func newTestVector(a Amount) (TestVector, error) {
+	bin, err := a.MarshalBinary()
+	if err != nil {
+		return TestVector{}, err
+	}
+	v := TestVector{
+		Amount: a,
+		String: a.String(),
+		Binary: bin,
+	}
+	if _, lossy := a.Float64Lossy(); !lossy {
+		data, err := json.Marshal(JSONNumber(a))
+		if err != nil {
+			return TestVector{}, err
+		}
+		v.JSON = string(data)
+	}
+	return v, nil
+}
+
+// Verify checks that the amount of the test vector is encoded exactly as
+// specified by the vector, and that each encoding decodes back to the same
+// amount with the same scale.
+// See also function [TestVectors].
+//
+// Verify returns an error describing the first mismatch.
+func Verify(v TestVector) error {
+	err := verify(v)
+	if err != nil {
+		return fmt.Errorf("verifying %v: %w", v.Amount, err)
+	}
+	return nil
+}
+
+func verify(v TestVector) error {
+	want, err := newTestVector(v.Amount)
+	if err != nil {
+		return err
+	}
+
+	// String
+	if v.String != want.String {
+		return fmt.Errorf("string is %q, want %q", v.String, want.String)
+	}
+	a, err := decodeV0([]byte(v.String))
+	if err != nil {
+		return fmt.Errorf("decoding string: %w", err)
+	}
+	if a != v.Amount {
+		return fmt.Errorf("string decodes to %v", a)
+	}
+
+	// Binary
+	if !bytes.Equal(v.Binary, want.Binary) {
+		return fmt.Errorf("binary is %q, want %q", v.Binary, want.Binary)
+	}
+	a, err = decodeAny(v.Binary)
+	if err != nil {
+		return fmt.Errorf("decoding binary: %w", err)
+	}
+	if a != v.Amount {
+		return fmt.Errorf("binary decodes to %v", a)
+	}
+
+	// JSON
+	if v.JSON != want.JSON {
+		return fmt.Errorf("json is %q, want %q", v.JSON, want.JSON)
+	}
+	if v.JSON != "" {
+		var n JSONNumber
+		if err := json.Unmarshal([]byte(v.JSON), &n); err != nil {
+			return fmt.Errorf("decoding json: %w", err)
+		}
+		if a = Amount(n); a != v.Amount {
+			return fmt.Errorf("json decodes to %v", a)
+		}
+	}
+	return nil
+}
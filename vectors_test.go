@@ -0,0 +1,58 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestTestVectors(t *testing.T) {
+	vectors := TestVectors()
+	if len(vectors) == 0 {
+		t.Fatalf("TestVectors() returned no vectors")
+	}
+	for _, v := range vectors {
+		if err := Verify(v); err != nil {
+			t.Errorf("Verify(%v) failed: %v", v.Amount, err)
+		}
+	}
+
+	// Copy
+	vectors[0].String = "modified"
+	if TestVectors()[0].String == "modified" {
+		t.Errorf("TestVectors() returned shared vectors")
+	}
+}
+
+func TestTestVectors_Golden(t *testing.T) {
+	tests := []struct {
+		curr, amount       string
+		str, binary, jsonv string
+	}{
+		{"USD", "1.230", "USD 1.230", "\x01USD1.230", `{"currency":"USD","amount":1.230}`},
+		{"USD", "0", "USD 0.00", "\x01USD0.00", `{"currency":"USD","amount":0.00}`},
+		{"JPY", "1.5", "JPY 1.5", "\x01JPY1.5", `{"currency":"JPY","amount":1.5}`},
+		{"USD", "99999999999999999.99", "USD 99999999999999999.99", "\x01USD99999999999999999.99", ""},
+	}
+	for _, tt := range tests {
+		a := MustParseAmount(tt.curr, tt.amount)
+		v := TestVector{Amount: a, String: tt.str, Binary: []byte(tt.binary), JSON: tt.jsonv}
+		if err := Verify(v); err != nil {
+			t.Errorf("Verify(%v) failed: %v", a, err)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	a := MustParseAmount("USD", "1.230")
+	tests := map[string]TestVector{
+		"string 1": {Amount: a, String: "USD 1.23", Binary: []byte("\x01USD1.230"), JSON: `{"currency":"USD","amount":1.230}`},
+		"binary 1": {Amount: a, String: "USD 1.230", Binary: []byte("\x01USD1.23"), JSON: `{"currency":"USD","amount":1.230}`},
+		"binary 2": {Amount: a, String: "USD 1.230", Binary: nil, JSON: `{"currency":"USD","amount":1.230}`},
+		"json 1":   {Amount: a, String: "USD 1.230", Binary: []byte("\x01USD1.230"), JSON: `{"currency":"USD","amount":1.23}`},
+		"json 2":   {Amount: a, String: "USD 1.230", Binary: []byte("\x01USD1.230"), JSON: ""},
+	}
+	for name, v := range tests {
+		if err := Verify(v); err == nil {
+			t.Errorf("%v: Verify(%v) did not fail", name, v.Amount)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package money
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// VersionedRate represents an exchange rate together with a sequence number
+// assigned by the publisher, such as a rate distribution service writing to
+// a message stream.
+// Sequence numbers of a currency pair increase monotonically, so consumers
+// can discard updates that arrive out of order.
+// See also method [VersionedRate.Supersedes].
+type VersionedRate struct {
+	Rate ExchangeRate
+	Seq  uint64
+}
+
+// Supersedes returns true if v and w are rates for the same currency pair
+// and v has a greater sequence number than w, that is, if a consumer that
+// has already applied w should apply v.
+// Rates for different currency pairs never supersede each other.
+func (v VersionedRate) Supersedes(w VersionedRate) bool {
+	return v.Rate.SameCurr(w.Rate) && v.Seq > w.Seq
+}
+
+// String implements the [fmt.Stringer] interface and returns the text form
+// of the versioned rate, which consists of the text produced by
+// [ExchangeRate.String] followed by a space and the sequence number,
+// for example, "USD/EUR 0.9123 42".
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (v VersionedRate) String() string {
+	return v.Rate.String() + " " + strconv.FormatUint(v.Seq, 10)
+}
+
+// ParseVersionedRate converts the text form produced by [VersionedRate.String]
+// to a versioned rate.
+//
+// ParseVersionedRate returns an error if:
+//   - the string does not consist of a currency pair, a rate, and a sequence
+//     number separated by single spaces;
+//   - the currency codes or the rate are not valid;
+//   - the sequence number is not a non-negative integer.
+func ParseVersionedRate(s string) (VersionedRate, error) {
+	v, err := parseVersionedRate(s)
+	if err != nil {
+		return VersionedRate{}, fmt.Errorf("parsing versioned rate %q: %w", s, err)
+	}
+	return v, nil
+}
+
+func parseVersionedRate(s string) (VersionedRate, error) {
+	parts := strings.Split(s, " ")
+	if len(parts) != 3 {
+		return VersionedRate{}, fmt.Errorf("expected 3 parts separated by spaces, got %v", len(parts))
+	}
+	r, err := parseExchRatePair(parts[0], parts[1])
+	if err != nil {
+		return VersionedRate{}, err
+	}
+	seq, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return VersionedRate{}, fmt.Errorf("parsing sequence number: %w", err)
+	}
+	return VersionedRate{Rate: r, Seq: seq}, nil
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// See also method [VersionedRate.String].
+//
+// [encoding.TextMarshaler]: https://pkg.go.dev/encoding#TextMarshaler
+func (v VersionedRate) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// See also function [ParseVersionedRate].
+//
+// [encoding.TextUnmarshaler]: https://pkg.go.dev/encoding#TextUnmarshaler
+func (v *VersionedRate) UnmarshalText(text []byte) error {
+	var err error
+	*v, err = ParseVersionedRate(string(text))
+	return err
+}
+
+// versionedRate is the wire layout of [VersionedRate] used for JSON encoding.
+// The sequence number is encoded as a string, because JavaScript decoders
+// lose precision on integers greater than 2^53.
+type versionedRate struct {
+	Base  Currency `json:"base"`
+	Quote Currency `json:"quote"`
+	Rate  string   `json:"rate"`
+	Seq   string   `json:"seq"`
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The versioned rate is encoded as an object, for example:
+//
+//	{"base":"USD","quote":"EUR","rate":"0.9123","seq":"42"}
+//
+// [json.Marshaler]: https://pkg.go.dev/encoding/json#Marshaler
+func (v VersionedRate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(versionedRate{
+		Base:  v.Rate.Base(),
+		Quote: v.Rate.Quote(),
+		Rate:  v.Rate.Decimal().String(),
+		Seq:   strconv.FormatUint(v.Seq, 10),
+	})
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// It accepts the object produced by [VersionedRate.MarshalJSON].
+//
+// [json.Unmarshaler]: https://pkg.go.dev/encoding/json#Unmarshaler
+func (v *VersionedRate) UnmarshalJSON(data []byte) error {
+	var w versionedRate
+	if err := json.Unmarshal(data, &w); err != nil {
+		return fmt.Errorf("unmarshaling %s: %w", data, err)
+	}
+	r, err := ParseExchRate(w.Base.Code(), w.Quote.Code(), w.Rate)
+	if err != nil {
+		return fmt.Errorf("unmarshaling %s: %w", data, err)
+	}
+	seq, err := strconv.ParseUint(w.Seq, 10, 64)
+	if err != nil {
+		return fmt.Errorf("unmarshaling %s: parsing sequence number: %w", data, err)
+	}
+	*v = VersionedRate{Rate: r, Seq: seq}
+	return nil
+}
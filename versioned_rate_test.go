@@ -0,0 +1,146 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestVersionedRate_Supersedes(t *testing.T) {
+	tests := []struct {
+		v, w VersionedRate
+		want bool
+	}{
+		{VersionedRate{MustParseExchRate("USD", "EUR", "0.9123"), 2}, VersionedRate{MustParseExchRate("USD", "EUR", "0.9100"), 1}, true},
+		{VersionedRate{MustParseExchRate("USD", "EUR", "0.9123"), 1}, VersionedRate{MustParseExchRate("USD", "EUR", "0.9100"), 1}, false},
+		{VersionedRate{MustParseExchRate("USD", "EUR", "0.9123"), 1}, VersionedRate{MustParseExchRate("USD", "EUR", "0.9100"), 2}, false},
+		{VersionedRate{MustParseExchRate("USD", "EUR", "0.9123"), 2}, VersionedRate{MustParseExchRate("USD", "JPY", "150"), 1}, false},
+		{VersionedRate{MustParseExchRate("USD", "EUR", "0.9123"), 2}, VersionedRate{MustParseExchRate("EUR", "USD", "1.09"), 1}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.v.Supersedes(tt.w); got != tt.want {
+			t.Errorf("%v.Supersedes(%v) = %v, want %v", tt.v, tt.w, got, tt.want)
+		}
+	}
+}
+
+func TestVersionedRate_String(t *testing.T) {
+	tests := []struct {
+		v    VersionedRate
+		want string
+	}{
+		{VersionedRate{MustParseExchRate("USD", "EUR", "0.9123"), 42}, "USD/EUR 0.9123 42"},
+		{VersionedRate{MustParseExchRate("EUR", "JPY", "160"), 0}, "EUR/JPY 160 0"},
+		{VersionedRate{MustParseExchRate("USD", "EUR", "1"), 18446744073709551615}, "USD/EUR 1.00 18446744073709551615"},
+	}
+	for _, tt := range tests {
+		if got := tt.v.String(); got != tt.want {
+			t.Errorf("%+v.String() = %q, want %q", tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestParseVersionedRate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s    string
+			want VersionedRate
+		}{
+			{"USD/EUR 0.9123 42", VersionedRate{MustParseExchRate("USD", "EUR", "0.9123"), 42}},
+			{"EUR/JPY 160 0", VersionedRate{MustParseExchRate("EUR", "JPY", "160"), 0}},
+			{"USD/EUR 1.00 18446744073709551615", VersionedRate{MustParseExchRate("USD", "EUR", "1"), 18446744073709551615}},
+		}
+		for _, tt := range tests {
+			got, err := ParseVersionedRate(tt.s)
+			if err != nil {
+				t.Errorf("ParseVersionedRate(%q) failed: %v", tt.s, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("ParseVersionedRate(%q) = %v, want %v", tt.s, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			"",
+			"USD/EUR 0.9123",
+			"USD/EUR  0.9123 42",
+			"USD/EUR 0.9123 42 1",
+			"USDEUR 0.9123 42",
+			"USD/ABC 0.9123 42",
+			"USD/EUR 0 42",
+			"USD/EUR -0.9123 42",
+			"USD/EUR 0.9123 -1",
+			"USD/EUR 0.9123 18446744073709551616",
+			"USD/EUR 0.9123 x",
+		}
+		for _, s := range tests {
+			_, err := ParseVersionedRate(s)
+			if err == nil {
+				t.Errorf("ParseVersionedRate(%q) did not fail", s)
+			}
+		}
+	})
+}
+
+func TestVersionedRate_MarshalText(t *testing.T) {
+	v := VersionedRate{MustParseExchRate("USD", "EUR", "0.9123"), 42}
+	data, err := v.MarshalText()
+	if err != nil {
+		t.Fatalf("%v.MarshalText() failed: %v", v, err)
+	}
+	var got VersionedRate
+	if err := got.UnmarshalText(data); err != nil {
+		t.Fatalf("UnmarshalText(%q) failed: %v", data, err)
+	}
+	if got != v {
+		t.Errorf("UnmarshalText(%q) = %v, want %v", data, got, v)
+	}
+}
+
+func TestVersionedRate_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		v    VersionedRate
+		want string
+	}{
+		{VersionedRate{MustParseExchRate("USD", "EUR", "0.9123"), 42}, `{"base":"USD","quote":"EUR","rate":"0.9123","seq":"42"}`},
+		{VersionedRate{MustParseExchRate("USD", "EUR", "1"), 18446744073709551615}, `{"base":"USD","quote":"EUR","rate":"1.00","seq":"18446744073709551615"}`},
+	}
+	for _, tt := range tests {
+		data, err := json.Marshal(tt.v)
+		if err != nil {
+			t.Errorf("json.Marshal(%v) failed: %v", tt.v, err)
+			continue
+		}
+		if got := string(data); got != tt.want {
+			t.Errorf("json.Marshal(%v) = %s, want %s", tt.v, got, tt.want)
+		}
+		var got VersionedRate
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Errorf("json.Unmarshal(%s) failed: %v", data, err)
+			continue
+		}
+		if got != tt.v {
+			t.Errorf("json.Unmarshal(%s) = %v, want %v", data, got, tt.v)
+		}
+	}
+}
+
+func TestVersionedRate_UnmarshalJSON(t *testing.T) {
+	tests := []string{
+		`[]`,
+		`{"base":"USD","quote":"EUR","rate":"0.9123"}`,
+		`{"base":"USD","quote":"EUR","seq":"42"}`,
+		`{"base":"ABC","quote":"EUR","rate":"0.9123","seq":"42"}`,
+		`{"base":"USD","quote":"EUR","rate":"0","seq":"42"}`,
+		`{"base":"USD","quote":"EUR","rate":"0.9123","seq":42}`,
+		`{"base":"USD","quote":"EUR","rate":"0.9123","seq":"-1"}`,
+	}
+	for _, data := range tests {
+		var v VersionedRate
+		if err := json.Unmarshal([]byte(data), &v); err == nil {
+			t.Errorf("json.Unmarshal(%s) did not fail", data)
+		}
+	}
+}
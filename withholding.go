@@ -0,0 +1,177 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/govalues/decimal"
+)
+
+// Withhold returns the gross amount, the net amount paid out after
+// withholding the tax at the specified rate, and the withheld tax.
+// The rate is expressed as a fraction, for example, 0.30 for 30%.
+// The exact tax is rounded half to even to the scale of the currency only
+// once, and the net amount is the gross amount minus the tax, so
+// gross = net + tax always holds.
+// See also function [GrossUp].
+//
+// Withhold returns an error if:
+//   - the rate is negative or not less than 1;
+//   - the gross amount has more digits after the decimal point than
+//     the currency;
+//   - the integer part of any result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func Withhold(gross Amount, rate decimal.Decimal) (g, net, tax Amount, err error) {
+	g, net, tax, err = withhold(gross, rate)
+	if err != nil {
+		return Amount{}, Amount{}, Amount{}, fmt.Errorf("withholding %v from %v: %w", rate, gross, err)
+	}
+	return g, net, tax, nil
+}
+
+func withhold(gross Amount, rate decimal.Decimal) (g, net, tax Amount, err error) {
+	if err := checkWithholding(gross, rate); err != nil {
+		return Amount{}, Amount{}, Amount{}, err
+	}
+	g = gross.TrimToCurr()
+	tax, err = withholdingTax(g, rate)
+	if err != nil {
+		return Amount{}, Amount{}, Amount{}, err
+	}
+	net, err = g.sub(tax)
+	if err != nil {
+		return Amount{}, Amount{}, Amount{}, err
+	}
+	return g, net, tax, nil
+}
+
+// GrossUp returns the gross amount which, after withholding the tax at
+// the specified rate using [Withhold], results exactly in the specified net
+// amount, along with the net amount and the withheld tax.
+// The rate is expressed as a fraction, for example, 0.30 for 30%.
+// Naive division of the net amount by (1 - rate) may produce a gross amount
+// that results in a different net amount after the tax is rounded, so
+// the gross amount is found by a binary search around the result of
+// the division.
+// If several gross amounts result in the same net amount, the smallest one
+// is returned.
+//
+// GrossUp returns an error if:
+//   - the rate is negative or not less than 1;
+//   - the net amount has more digits after the decimal point than
+//     the currency;
+//   - the integer part of any result has more than
+//     ([decimal.MaxPrec] - [Currency.Scale]) digits.
+func GrossUp(net Amount, rate decimal.Decimal) (gross, n, tax Amount, err error) {
+	gross, n, tax, err = grossUp(net, rate)
+	if err != nil {
+		return Amount{}, Amount{}, Amount{}, fmt.Errorf("grossing up %v at %v: %w", net, rate, err)
+	}
+	return gross, n, tax, nil
+}
+
+func grossUp(net Amount, rate decimal.Decimal) (gross, n, tax Amount, err error) {
+	if err := checkWithholding(net, rate); err != nil {
+		return Amount{}, Amount{}, Amount{}, err
+	}
+	net = net.TrimToCurr()
+
+	// Bounds
+	// The net amount does not decrease as the gross amount increases, and
+	// changes by at most one minor unit per minor unit of the gross amount,
+	// so the smallest gross amount with a net amount not less than the
+	// specified one results exactly in the specified net amount.
+	// Since the tax is rounded by at most half a minor unit, the net amount
+	// is less than the specified one at the lower bound and not less than
+	// the specified one at the upper bound.
+	f, err := decimal.One.Sub(rate)
+	if err != nil {
+		return Amount{}, Amount{}, Amount{}, err
+	}
+	ulp := net.ULP()
+	lo, err := net.sub(ulp)
+	if err != nil {
+		return Amount{}, Amount{}, Amount{}, err
+	}
+	lo, err = lo.quo(f)
+	if err != nil {
+		return Amount{}, Amount{}, Amount{}, err
+	}
+	lo, err = lo.Floor(net.Curr().Scale()).sub(ulp)
+	if err != nil {
+		return Amount{}, Amount{}, Amount{}, err
+	}
+	hi, err := net.quo(f)
+	if err != nil {
+		return Amount{}, Amount{}, Amount{}, err
+	}
+	hi, err = hi.Ceil(net.Curr().Scale()).add(ulp)
+	if err != nil {
+		return Amount{}, Amount{}, Amount{}, err
+	}
+
+	// Binary search
+	for lo.Decimal().Cmp(hi.Decimal()) < 0 {
+		d, err := hi.Decimal().Sub(lo.Decimal())
+		if err != nil {
+			return Amount{}, Amount{}, Amount{}, err
+		}
+		d, err = d.Quo(decimal.Two)
+		if err != nil {
+			return Amount{}, Amount{}, Amount{}, err
+		}
+		mid, err := lo.add(newAmountUnsafe(lo.Curr(), d.Trunc(lo.Curr().Scale())))
+		if err != nil {
+			return Amount{}, Amount{}, Amount{}, err
+		}
+		_, n, _, err = withhold(mid, rate)
+		if err != nil {
+			return Amount{}, Amount{}, Amount{}, err
+		}
+		if n.Decimal().Cmp(net.Decimal()) >= 0 {
+			hi = mid
+		} else {
+			lo, err = mid.add(ulp)
+			if err != nil {
+				return Amount{}, Amount{}, Amount{}, err
+			}
+		}
+	}
+	return withhold(hi, rate)
+}
+
+// withholdingTax returns the exact product of the gross amount and the rate
+// rounded half to even to the scale of the currency.
+// Rounding only once keeps the net amount non-decreasing as the gross amount
+// increases, which [GrossUp] relies on.
+func withholdingTax(g Amount, rate decimal.Decimal) (Amount, error) {
+	x := new(big.Int).SetUint64(g.Decimal().Coef())
+	x.Mul(x, new(big.Int).SetUint64(rate.Coef()))
+	scale := g.Curr().Scale()
+	den := pow10Big(g.Scale() + rate.Scale() - scale)
+	quo, rem := new(big.Int).QuoRem(x, den, new(big.Int))
+	switch c := rem.Lsh(rem, 1).Cmp(den); {
+	case c > 0, c == 0 && quo.Bit(0) != 0:
+		quo.Add(quo, big.NewInt(1))
+	}
+	if g.IsNeg() {
+		quo.Neg(quo)
+	}
+	d, err := bigDecimalExact(quo, scale)
+	if err != nil {
+		return Amount{}, err
+	}
+	return newAmountSafe(g.Curr(), d)
+}
+
+// checkWithholding returns an error if the amount or the rate cannot be used
+// for withholding.
+func checkWithholding(a Amount, rate decimal.Decimal) error {
+	if rate.IsNeg() || rate.Cmp(decimal.One) >= 0 {
+		return fmt.Errorf("rate must be in the range [0, 1)")
+	}
+	if a.MinScale() > a.Curr().Scale() {
+		return fmt.Errorf("amount must be a multiple of the minor unit")
+	}
+	return nil
+}
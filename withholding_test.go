@@ -0,0 +1,148 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/govalues/decimal"
+)
+
+func TestWithhold(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, gross, rate           string
+			wantGross, wantNet, wantTax string
+		}{
+			{"USD", "100", "0.30", "100.00", "70.00", "30.00"},
+			{"USD", "100", "0", "100.00", "100.00", "0.00"},
+			{"USD", "0.05", "0.30", "0.05", "0.03", "0.02"},
+			{"USD", "0.15", "0.30", "0.15", "0.11", "0.04"},
+			{"USD", "-100", "0.15", "-100.00", "-85.00", "-15.00"},
+			{"JPY", "1001", "0.2042", "1001", "797", "204"},
+			{"USD", "9950000001.61", "0.9999999999", "9950000001.61", "1.00", "9950000000.61"},
+		}
+		for _, tt := range tests {
+			gross := MustParseAmount(tt.curr, tt.gross)
+			rate := decimal.MustParse(tt.rate)
+			gotGross, gotNet, gotTax, err := Withhold(gross, rate)
+			if err != nil {
+				t.Errorf("Withhold(%q, %v) failed: %v", gross, rate, err)
+				continue
+			}
+			wantGross := MustParseAmount(tt.curr, tt.wantGross)
+			wantNet := MustParseAmount(tt.curr, tt.wantNet)
+			wantTax := MustParseAmount(tt.curr, tt.wantTax)
+			if gotGross != wantGross || gotNet != wantNet || gotTax != wantTax {
+				t.Errorf("Withhold(%q, %v) = %q, %q, %q, want %q, %q, %q", gross, rate, gotGross, gotNet, gotTax, wantGross, wantNet, wantTax)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr, gross, rate string
+		}{
+			"rate 1":  {"USD", "100", "-0.01"},
+			"rate 2":  {"USD", "100", "1"},
+			"rate 3":  {"USD", "100", "1.5"},
+			"scale 1": {"USD", "100.001", "0.30"},
+		}
+		for name, tt := range tests {
+			gross := MustParseAmount(tt.curr, tt.gross)
+			rate := decimal.MustParse(tt.rate)
+			_, _, _, err := Withhold(gross, rate)
+			if err == nil {
+				t.Errorf("Withhold(%q, %v) did not fail: %v", gross, rate, name)
+			}
+		}
+	})
+}
+
+func TestGrossUp(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr, net, rate             string
+			wantGross, wantNet, wantTax string
+		}{
+			{"USD", "70", "0.30", "100.00", "70.00", "30.00"},
+			{"USD", "100", "0", "100.00", "100.00", "0.00"},
+			{"USD", "100", "0.30", "142.86", "100.00", "42.86"},
+			{"USD", "0.03", "0.30", "0.04", "0.03", "0.01"},
+			{"USD", "0.11", "0.30", "0.15", "0.11", "0.04"},
+			{"USD", "-85", "0.15", "-100.00", "-85.00", "-15.00"},
+			{"USD", "1", "0.99", "99.50", "1.00", "98.50"},
+			{"JPY", "797", "0.2042", "1001", "797", "204"},
+			{"JPY", "1000", "0.2042", "1256", "1000", "256"},
+			{"USD", "1", "0.999999", "995000.00", "1.00", "994999.00"},
+			{"USD", "1", "0.9999999999", "9950000000.00", "1.00", "9949999999.00"},
+			{"USD", "-1", "0.9999999999", "-10050000000.00", "-1.00", "-10049999999.00"},
+		}
+		for _, tt := range tests {
+			net := MustParseAmount(tt.curr, tt.net)
+			rate := decimal.MustParse(tt.rate)
+			gotGross, gotNet, gotTax, err := GrossUp(net, rate)
+			if err != nil {
+				t.Errorf("GrossUp(%q, %v) failed: %v", net, rate, err)
+				continue
+			}
+			wantGross := MustParseAmount(tt.curr, tt.wantGross)
+			wantNet := MustParseAmount(tt.curr, tt.wantNet)
+			wantTax := MustParseAmount(tt.curr, tt.wantTax)
+			if gotGross != wantGross || gotNet != wantNet || gotTax != wantTax {
+				t.Errorf("GrossUp(%q, %v) = %q, %q, %q, want %q, %q, %q", net, rate, gotGross, gotNet, gotTax, wantGross, wantNet, wantTax)
+			}
+		}
+	})
+
+	t.Run("roundtrip", func(t *testing.T) {
+		rates := []string{"0", "0.05", "0.15", "0.2042", "0.30", "0.333", "0.5", "0.75", "0.99", "0.999999", "0.9999999999"}
+		for _, r := range rates {
+			rate := decimal.MustParse(r)
+			for i := int64(-250); i < 250; i++ {
+				net := MustNewAmount("USD", i, 2)
+				gross, got, _, err := GrossUp(net, rate)
+				if err != nil {
+					t.Fatalf("GrossUp(%q, %v) failed: %v", net, rate, err)
+				}
+				if got != net {
+					t.Errorf("GrossUp(%q, %v) net = %q, want %q", net, rate, got, net)
+				}
+				_, got, _, err = Withhold(gross, rate)
+				if err != nil {
+					t.Fatalf("Withhold(%q, %v) failed: %v", gross, rate, err)
+				}
+				if got != net {
+					t.Errorf("Withhold(GrossUp(%q, %v)) net = %q, want %q", net, rate, got, net)
+				}
+				prev, err := gross.Sub(net.ULP())
+				if err != nil {
+					t.Fatalf("%q.Sub(%q) failed: %v", gross, net.ULP(), err)
+				}
+				_, got, _, err = Withhold(prev, rate)
+				if err != nil {
+					t.Fatalf("Withhold(%q, %v) failed: %v", prev, rate, err)
+				}
+				if got == net {
+					t.Errorf("GrossUp(%q, %v) = %q, want %q", net, rate, gross, prev)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr, net, rate string
+		}{
+			"rate 1":  {"USD", "100", "-0.01"},
+			"rate 2":  {"USD", "100", "1"},
+			"scale 1": {"USD", "100.001", "0.30"},
+		}
+		for name, tt := range tests {
+			net := MustParseAmount(tt.curr, tt.net)
+			rate := decimal.MustParse(tt.rate)
+			_, _, _, err := GrossUp(net, rate)
+			if err == nil {
+				t.Errorf("GrossUp(%q, %v) did not fail: %v", net, rate, name)
+			}
+		}
+	})
+}